@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
@@ -19,20 +22,35 @@ const (
 	getUsernamePath = "/:address/username"
 	getKeysPath     = "/:address/keys"
 	getKeyPath      = "/:address/key/:key"
+	getProofPath    = "/:address/proof"
+	getKeyProofPath = "/:address/key/:key/proof"
 	getESDTTokens   = "/:address/esdt"
 	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+
+	getTransactionsPath = "/:address/transactions"
+)
+
+const (
+	queryParamBlockNonce = "blockNonce"
+	queryParamBlockHash  = "blockHash"
+	queryParamDirection  = "direction"
+	queryParamStatus     = "status"
+	queryParamPage       = "page"
+	queryParamPageSize   = "pageSize"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error)
 	GetUsername(address string) (string, error)
 	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
-	GetCode(account state.UserAccountHandler) []byte
+	GetAccountWithCode(address string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error)
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error)
+	GetTransactionsForAddress(query api.AddressTransactionsQuery) (*api.AddressTransactions, error)
+	GetAccountProof(address string, options api.AccountQueryOptions) (*api.AccountProof, error)
+	GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
 	IsInterfaceNil() bool
 }
 
@@ -59,8 +77,11 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getUsernamePath, GetUsername)
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 	router.RegisterHandler(http.MethodGet, getKeysPath, GetKeyValuePairs)
+	router.RegisterHandler(http.MethodGet, getProofPath, GetAccountProof)
+	router.RegisterHandler(http.MethodGet, getKeyProofPath, GetAccountKeyProof)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getTransactionsPath, GetTransactions)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -94,15 +115,28 @@ func getFacade(c *gin.Context) (FacadeHandler, bool) {
 }
 
 // GetAccount returns an accountResponse containing information
-//  about the account correlated with provided address
+//  about the account correlated with provided address, optionally at an older block
 func GetAccount(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
 		return
 	}
 
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	addr := c.Param("address")
-	acc, err := facade.GetAccount(addr)
+	acc, code, err := facade.GetAccountWithCode(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -115,7 +149,6 @@ func GetAccount(c *gin.Context) {
 		return
 	}
 
-	code := facade.GetCode(acc)
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
@@ -126,7 +159,7 @@ func GetAccount(c *gin.Context) {
 	)
 }
 
-// GetBalance returns the balance for the address parameter
+// GetBalance returns the balance for the address parameter, optionally at an older block
 func GetBalance(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
@@ -146,7 +179,20 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := facade.GetBalance(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, err := facade.GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -266,7 +312,7 @@ func GetValueForKey(c *gin.Context) {
 	)
 }
 
-// GetKeyValuePairs returns all the key-value pairs for the given address
+// GetKeyValuePairs returns all the key-value pairs for the given address, optionally at an older block
 func GetKeyValuePairs(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
@@ -286,7 +332,20 @@ func GetKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetKeyValuePairs(addr)
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := facade.GetKeyValuePairs(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -309,6 +368,133 @@ func GetKeyValuePairs(c *gin.Context) {
 	)
 }
 
+// GetAccountProof returns the Merkle proof of the account in the accounts trie of the current block or, optionally,
+// of an older block
+func GetAccountProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	proof, err := facade.GetAccountProof(addr, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetAccountKeyProof returns the Merkle proof of the account in the accounts trie of the current block or, optionally,
+// of an older block, together with the Merkle proof of the given key in the data trie of the account
+func GetAccountKeyProof(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	options, err := parseAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), errors.ErrEmptyKey.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	proof, err := facade.GetAccountKeyProof(addr, key, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // GetESDTBalance returns the balance for the given address and esdt token
 func GetESDTBalance(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -414,6 +600,129 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
+// GetTransactions returns a page of the transactions sent and/or received by the address parameter, most recent first.
+// It requires the address transactions index of the db lookup extensions.
+func GetTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	query, err := parseAddressTransactionsQuery(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	transactions, err := facade.GetTransactionsForAddress(query)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAddressTransactions.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"history": transactions},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func parseAddressTransactionsQuery(c *gin.Context) (api.AddressTransactionsQuery, error) {
+	urlQuery := c.Request.URL.Query()
+	query := api.AddressTransactionsQuery{
+		Address:   c.Param("address"),
+		Direction: urlQuery.Get(queryParamDirection),
+		Status:    urlQuery.Get(queryParamStatus),
+	}
+	if query.Address == "" {
+		return query, errors.ErrEmptyAddress
+	}
+
+	switch query.Direction {
+	case "", api.AddressTransactionsSent, api.AddressTransactionsReceived:
+	default:
+		return query, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamDirection)
+	}
+
+	switch transaction.TxStatus(query.Status) {
+	case "", transaction.TxStatusPending, transaction.TxStatusSuccess, transaction.TxStatusFail,
+		transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+	default:
+		return query, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamStatus)
+	}
+
+	var err error
+	query.Page, err = getQueryParamUint32(c, queryParamPage)
+	if err != nil {
+		return query, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamPage)
+	}
+
+	query.PageSize, err = getQueryParamUint32(c, queryParamPageSize)
+	if err != nil {
+		return query, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamPageSize)
+	}
+
+	return query, nil
+}
+
+func getQueryParamUint32(c *gin.Context, name string) (uint32, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 32)
+	return uint32(value), err
+}
+
+func parseAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
+	options := api.AccountQueryOptions{}
+
+	blockNonceStr := c.Request.URL.Query().Get(queryParamBlockNonce)
+	blockHashStr := c.Request.URL.Query().Get(queryParamBlockHash)
+	if blockNonceStr != "" && blockHashStr != "" {
+		return options, errors.ErrValidationBlockNonceAndHash
+	}
+
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return options, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamBlockNonce)
+		}
+
+		options.BlockNonce = blockNonce
+		options.HasBlockNonce = true
+	}
+
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil {
+			return options, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, queryParamBlockHash)
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	return options, nil
+}
+
 func accountResponseFromBaseAccount(address string, code []byte, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,7 +19,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -85,6 +88,16 @@ type keyValuePairsResponse struct {
 	Code  string
 }
 
+type accountProofResponseData struct {
+	Proof api.AccountKeyProof `json:"proof"`
+}
+
+type accountProofResponse struct {
+	Data  accountProofResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -138,7 +151,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -164,7 +177,7 @@ func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -186,7 +199,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -206,7 +219,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 func TestGetBalance_WithEmptyAddressShoudReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -227,6 +240,52 @@ func TestGetBalance_WithEmptyAddressShoudReturnError(t *testing.T) {
 	))
 }
 
+func TestGetBalance_WithBlockNonceShouldForwardQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	var receivedOptions api.AccountQueryOptions
+	facade := mock.Facade{
+		BalanceHandler: func(_ string, options api.AccountQueryOptions) (*big.Int, error) {
+			receivedOptions = options
+			return big.NewInt(37), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=100", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "37", getValueForKey(response.Data, "balance"))
+	assert.Equal(t, api.AccountQueryOptions{BlockNonce: 100, HasBlockNonce: true}, receivedOptions)
+}
+
+func TestGetBalance_InvalidBlockNonceShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BalanceHandler: func(_ string, _ api.AccountQueryOptions) (*big.Int, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=-1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
 func TestGetBalance_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	t.Parallel()
 
@@ -393,8 +452,8 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	t.Parallel()
 	returnedError := "i am an error"
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
-			return nil, errors.New(returnedError)
+		GetAccountWithCodeHandler: func(address string, _ api.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+			return nil, nil, errors.New(returnedError)
 		},
 	}
 	ws := startNodeServer(&facade)
@@ -411,15 +470,59 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	assert.True(t, strings.Contains(response.Error, fmt.Sprintf("%s: %s", apiErrors.ErrCouldNotGetAccount.Error(), returnedError)))
 }
 
+func TestGetAccount_InvalidBlockHashShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAccountWithCodeHandler: func(_ string, _ api.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockHash=not-hex", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrCouldNotGetAccount.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetAccount_WithBlockHashShouldForwardQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	var accountOptions api.AccountQueryOptions
+	facade := mock.Facade{
+		GetAccountWithCodeHandler: func(_ string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+			accountOptions = options
+			acc, _ := state.NewUserAccount([]byte("1234"))
+			return acc, nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockHash=abcd", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	expectedOptions := api.AccountQueryOptions{BlockHash: []byte{0xab, 0xcd}}
+	assert.Equal(t, expectedOptions, accountOptions)
+}
+
 func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountWithCodeHandler: func(address string, _ api.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
 
-			return acc, nil
+			return acc, []byte("code"), nil
 		},
 	}
 	ws := startNodeServer(&facade)
@@ -441,6 +544,7 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, accountResponse.Account.Address, reqAddress)
 	assert.Equal(t, accountResponse.Account.Nonce, uint64(1))
 	assert.Equal(t, accountResponse.Account.Balance, "100")
+	assert.Equal(t, hex.EncodeToString([]byte("code")), accountResponse.Account.Code)
 	assert.Empty(t, response.Error)
 }
 
@@ -646,7 +750,7 @@ func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -672,7 +776,7 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.Facade{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, error) {
 			return pairs, nil
 		},
 	}
@@ -689,6 +793,249 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	assert.Equal(t, pairs, response.Data.Pairs)
 }
 
+func TestGetKeyValuePairs_BlockNonceAndBlockHashShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?blockNonce=7&blockHash=abcd", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationBlockNonceAndHash.Error()))
+}
+
+type addressTransactionsResponseData struct {
+	History api.AddressTransactions `json:"history"`
+}
+
+type addressTransactionsResponse struct {
+	Data  addressTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+func TestGetTransactions_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/address/erd1alice/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetTransactions_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsForAddressCalled: func(_ api.AddressTransactionsQuery) (*api.AddressTransactions, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"direction=both", "status=unknown", "page=-1", "pageSize=a"} {
+		req, _ := http.NewRequest("GET", "/address/erd1alice/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
+func TestGetTransactions_FacadeErrorsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsForAddressCalled: func(_ api.AddressTransactionsQuery) (*api.AddressTransactions, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/erd1alice/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAddressTransactions.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedQuery := api.AddressTransactionsQuery{
+		Address:   "erd1alice",
+		Direction: api.AddressTransactionsSent,
+		Status:    string(transaction.TxStatusSuccess),
+		Page:      2,
+		PageSize:  10,
+	}
+	facade := mock.Facade{
+		GetTransactionsForAddressCalled: func(query api.AddressTransactionsQuery) (*api.AddressTransactions, error) {
+			assert.Equal(t, expectedQuery, query)
+			return &api.AddressTransactions{
+				Address:   query.Address,
+				Direction: query.Direction,
+				Status:    query.Status,
+				Page:      query.Page,
+				PageSize:  query.PageSize,
+				Transactions: []*transaction.ApiTransactionResult{
+					{Hash: "aaaa", Nonce: 3, Status: transaction.TxStatusSuccess},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/erd1alice/transactions?direction=sent&status=success&page=2&pageSize=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "erd1alice", response.Data.History.Address)
+	assert.Equal(t, uint32(2), response.Data.History.Page)
+	assert.Len(t, response.Data.History.Transactions, 1)
+	assert.Equal(t, "aaaa", response.Data.History.Transactions[0].Hash)
+	assert.Equal(t, transaction.TxStatusSuccess, response.Data.History.Transactions[0].Status)
+}
+
+func TestGetAccountProof_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/address/test/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetAccountProof_FacadeErrorsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAccountProofCalled: func(_ string, _ api.AccountQueryOptions) (*api.AccountProof, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAccountProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedAddress string
+	var receivedOptions api.AccountQueryOptions
+	facade := mock.Facade{
+		GetAccountProofCalled: func(address string, options api.AccountQueryOptions) (*api.AccountProof, error) {
+			receivedAddress = address
+			receivedOptions = options
+			return &api.AccountProof{
+				Address:    address,
+				BlockNonce: 5,
+				Account:    &api.MerkleProof{RootHash: "aa", Proof: []string{"bb", "cc"}},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/proof?blockNonce=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "test", receivedAddress)
+	assert.Equal(t, api.AccountQueryOptions{BlockNonce: 5, HasBlockNonce: true}, receivedOptions)
+	assert.Equal(t, uint64(5), response.Data.Proof.BlockNonce)
+	assert.Equal(t, []string{"bb", "cc"}, response.Data.Proof.Account.Proof)
+}
+
+func TestGetAccountKeyProof_InvalidQueryOptionsShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/key/aa/proof?blockNonce=5&blockHash=abcd", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationBlockNonceAndHash.Error()))
+}
+
+func TestGetAccountKeyProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var receivedKey string
+	facade := mock.Facade{
+		GetAccountKeyProofCalled: func(address string, key string, _ api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+			receivedKey = key
+			return &api.AccountKeyProof{
+				AccountProof: api.AccountProof{Address: address},
+				DataTrie:     &api.MerkleProof{Key: key, Value: "dd"},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/key/aa/proof", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountProofResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aa", receivedKey)
+	assert.Equal(t, "test", response.Data.Proof.Address)
+	assert.Equal(t, "dd", response.Data.Proof.DataTrie.Value)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -699,8 +1046,11 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/username", Open: true},
 					{Name: "/:address/keys", Open: true},
 					{Name: "/:address/key/:key", Open: true},
+					{Name: "/:address/proof", Open: true},
+					{Name: "/:address/key/:key/proof", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
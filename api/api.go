@@ -8,11 +8,14 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/subscribe"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -143,6 +146,12 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		hardfork.Routes(wrappedHardforkRouter)
 	}
 
+	eventsRoutes := ws.Group("")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
 	blockRoutes := ws.Group("/block")
 	wrappedBlockRouter, err := wrapper.NewRouterWrapper("block", blockRoutes, routesConfig)
 	if err == nil {
@@ -158,6 +167,10 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 		registerLoggerWsRoute(ws, marshalizerForLogs)
 	}
+
+	if isSubscribeRouteEnabled(routesConfig) {
+		registerSubscribeWsRoute(ws, elrondFacade)
+	}
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
@@ -175,6 +188,21 @@ func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	return false
 }
 
+func isSubscribeRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	subscribeConfig, ok := routesConfig.APIPackages["subscribe"]
+	if !ok {
+		return false
+	}
+
+	for _, cfg := range subscribeConfig.Routes {
+		if cfg.Name == "/subscribe" && cfg.Open {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RegisterDefaultValidators will call register validation on all validator functions
 func RegisterDefaultValidators() error {
 	validators := []validatorInput{
@@ -215,6 +243,39 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 	})
 }
 
+func registerSubscribeWsRoute(ws *gin.Engine, elrondFacade middleware.Handler) {
+	subscribeFacade, ok := elrondFacade.(subscribe.FacadeHandler)
+	if !ok {
+		log.Warn("the /subscribe route is not available", "error", errors.ErrInvalidAppContext.Error())
+		return
+	}
+
+	hub, err := subscribe.NewHub(subscribeFacade)
+	if err != nil {
+		log.Warn("the /subscribe route is not available", "error", err.Error())
+		return
+	}
+
+	upgrader := websocket.Upgrader{}
+
+	ws.GET("/subscribe", func(c *gin.Context) {
+		upgrader.CheckOrigin = func(r *http.Request) bool {
+			return true
+		}
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			log.Error(err.Error())
+			return
+		}
+
+		err = hub.ServeConnection(conn)
+		if err != nil {
+			log.Error(err.Error())
+		}
+	})
+}
+
 // skValidator validates a secret key from user input for correctness
 func skValidator(
 	_ *validator.Validate,
@@ -17,6 +17,8 @@ import (
 const (
 	getBlockByNoncePath = "/by-nonce/:nonce"
 	getBlockByHashPath  = "/by-hash/:hash"
+	getBlocksRangePath  = "/range"
+	getLatestBlockPath  = "/latest"
 )
 
 var log = logger.GetOrCreate("api/block")
@@ -25,12 +27,16 @@ var log = logger.GetOrCreate("api/block")
 type BlockService interface {
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
+	GetLatestBlock(withTxs bool) (*api.Block, error)
 }
 
 // Routes defines block related routes
 func Routes(routes *wrapper.RouterWrapper) {
 	routes.RegisterHandler(http.MethodGet, getBlockByNoncePath, getBlockByNonce)
 	routes.RegisterHandler(http.MethodGet, getBlockByHashPath, getBlockByHash)
+	routes.RegisterHandler(http.MethodGet, getBlocksRangePath, getBlocksRange)
+	routes.RegisterHandler(http.MethodGet, getLatestBlockPath, getLatestBlock)
 }
 
 func getBlockByNonce(c *gin.Context) {
@@ -112,6 +118,92 @@ func getBlockByHash(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
+// getBlocksRange returns the blocks having the nonces between the "from" and the "to" query parameters, bounds included
+func getBlocksRange(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	from, to, err := getQueryParamsBlocksRange(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationBlocksRange.Error()),
+		)
+		return
+	}
+
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	blocks, err := ef.GetBlocksByNonceRange(from, to, withTxs)
+	log.Debug(fmt.Sprintf("GetBlocksByNonceRange took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"blocks": blocks}, "", shared.ReturnCodeSuccess)
+}
+
+// getLatestBlock returns the current block of the node
+func getLatestBlock(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	block, err := ef.GetLatestBlock(withTxs)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamsBlocksRange(c *gin.Context) (uint64, uint64, error) {
+	urlQuery := c.Request.URL.Query()
+
+	from, err := strconv.ParseUint(urlQuery.Get("from"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	to, err := strconv.ParseUint(urlQuery.Get("to"), 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return from, to, nil
+}
+
 func getQueryParamWithTxs(c *gin.Context) (bool, error) {
 	withTxsStr := c.Request.URL.Query().Get("withTxs")
 	if withTxsStr == "" {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -27,6 +28,16 @@ type blockResponseData struct {
 	Block api.Block `json:"block"`
 }
 
+type blocksResponseData struct {
+	Blocks []*api.Block `json:"blocks"`
+}
+
+type blocksResponse struct {
+	Data  blocksResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
 type blockResponse struct {
 	Data  blockResponseData `json:"data"`
 	Error string            `json:"error"`
@@ -253,6 +264,128 @@ func TestGetBlockByHash_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedBlock, response.Data.Block)
 }
 
+func TestGetBlocksRange_MissingBoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(_ uint64, _ uint64, _ bool) ([]*api.Block, error) {
+			return make([]*api.Block, 0), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/range?from=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationBlocksRange.Error()))
+}
+
+func TestGetBlocksRange_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(_ uint64, _ uint64, _ bool) ([]*api.Block, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/range?from=10&to=12", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlocksRange_MaxUint64UpperBoundShouldBeForwarded(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("blocks range too large")
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(from uint64, to uint64, _ bool) ([]*api.Block, error) {
+			assert.Equal(t, uint64(0), from)
+			assert.Equal(t, uint64(math.MaxUint64), to)
+
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/block/range?from=0&to=%d", uint64(math.MaxUint64)), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	assert.Nil(t, response.Data.Blocks)
+}
+
+func TestGetBlocksRange_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlocks := []*api.Block{{Nonce: 10}, {Nonce: 11}, {Nonce: 12}}
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+			assert.Equal(t, uint64(10), from)
+			assert.Equal(t, uint64(12), to)
+			assert.True(t, withTxs)
+
+			return expectedBlocks, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/range?from=10&to=12&withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBlocks, response.Data.Blocks)
+}
+
+func TestGetLatestBlock_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlock := api.Block{
+		Nonce: 37,
+		Round: 39,
+	}
+	facade := mock.Facade{
+		GetLatestBlockCalled: func(withTxs bool) (*api.Block, error) {
+			assert.False(t, withTxs)
+
+			return &expectedBlock, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/block/latest", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBlock, response.Data.Block)
+}
+
 func startNodeServer(handler block.BlockService) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -272,6 +405,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/by-nonce/:nonce", Open: true},
 					{Name: "/by-hash/:hash", Open: true},
+					{Name: "/range", Open: true},
+					{Name: "/latest", Open: true},
 				},
 			},
 		},
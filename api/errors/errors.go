@@ -25,6 +25,9 @@ var ErrGetUsername = errors.New("get username error")
 // ErrGetValueForKey signals an error in getting the value of a key for an account
 var ErrGetValueForKey = errors.New("get value for key error")
 
+// ErrGetProof signals an error in getting the Merkle proof of an account or of an account data key
+var ErrGetProof = errors.New("get proof error")
+
 // ErrGetKeyValuePairs signals an error in getting the key-value pairs of a key for an account
 var ErrGetKeyValuePairs = errors.New("get key-value pairs error")
 
@@ -34,6 +37,15 @@ var ErrGetESDTTokens = errors.New("get esdt tokens for account error")
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
+// ErrGetESDTSupply signals an error in getting the supply of an esdt token
+var ErrGetESDTSupply = errors.New("get esdt supply error")
+
+// ErrGetESDTHolders signals an error in getting the number of holders of an esdt token
+var ErrGetESDTHolders = errors.New("get esdt holders error")
+
+// ErrEmptyTokenIdentifier signals an empty token identifier was provided
+var ErrEmptyTokenIdentifier = errors.New("token identifier is empty")
+
 // ErrEmptyAddress signals an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -70,5 +82,35 @@ var ErrQueryError = errors.New("query error")
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetTrieStatistics signals that an error occurred while computing the trie statistics
+var ErrGetTrieStatistics = errors.New("error getting the trie statistics")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrGetTransactionsPool signals an error happening when trying to fetch the transactions pool
+var ErrGetTransactionsPool = errors.New("getting transactions pool failed")
+
+// ErrValidationTransactionsPoolFilter signals that an invalid combination of transactions pool filters was provided
+var ErrValidationTransactionsPoolFilter = errors.New("invalid combination of transactions pool filters")
+
+// ErrGetTransactionsPoolDiagnostics signals an error happening when trying to fetch the transactions pool diagnostics
+var ErrGetTransactionsPoolDiagnostics = errors.New("getting transactions pool diagnostics failed")
+
+// ErrGetEvents signals an error happening when trying to fetch events
+var ErrGetEvents = errors.New("getting events failed")
+
+// ErrGetAddressTransactions signals an error happening when trying to fetch the transactions of an address
+var ErrGetAddressTransactions = errors.New("getting the transactions of the address failed")
+
+// ErrValidationEventsFilter signals that the address or the identifier of an events query is missing
+var ErrValidationEventsFilter = errors.New("both the address and the identifier of the events have to be provided")
+
+// ErrValidationEventsFromBlock signals that the first block of an events query is missing
+var ErrValidationEventsFromBlock = errors.New("the first block of the events query has to be provided")
+
+// ErrValidationBlockNonceAndHash signals that both a block nonce and a block hash were provided
+var ErrValidationBlockNonceAndHash = errors.New("only one of blockNonce and blockHash can be provided")
+
+// ErrValidationBlocksRange signals that the first or the last nonce of a blocks range is missing or invalid
+var ErrValidationBlocksRange = errors.New("both the from and the to block nonces have to be provided")
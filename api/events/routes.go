@@ -0,0 +1,152 @@
+package events
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getEventsPath = "/events"
+
+	queryParamAddress    = "address"
+	queryParamIdentifier = "identifier"
+	queryParamFromBlock  = "fromBlock"
+	queryParamToBlock    = "toBlock"
+	queryParamPage       = "page"
+	queryParamPageSize   = "pageSize"
+)
+
+// EventsService interface defines methods that can be used from `elrondFacade` context variable
+type EventsService interface {
+	GetEvents(query api.EventsQuery) (*api.Events, error)
+}
+
+// Routes defines events related routes
+func Routes(routes *wrapper.RouterWrapper) {
+	routes.RegisterHandler(http.MethodGet, getEventsPath, getEvents)
+}
+
+// getEvents returns a page of the events emitted by a smart contract (the "address" query parameter), having a
+// given identifier (the "identifier" query parameter), within a range of blocks starting with "fromBlock" and ending
+// with the optional "toBlock"
+func getEvents(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	query, err := parseEventsQuery(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	events, err := ef.GetEvents(query)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEvents.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"events": events}, "", shared.ReturnCodeSuccess)
+}
+
+func parseEventsQuery(c *gin.Context) (api.EventsQuery, error) {
+	urlQuery := c.Request.URL.Query()
+	query := api.EventsQuery{
+		Address:    urlQuery.Get(queryParamAddress),
+		Identifier: urlQuery.Get(queryParamIdentifier),
+	}
+	if query.Address == "" || query.Identifier == "" {
+		return api.EventsQuery{}, errors.ErrValidationEventsFilter
+	}
+
+	var err error
+	query.FromBlock, query.HasFromBlock, err = getQueryParamUint(urlQuery, queryParamFromBlock, 64)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+
+	query.ToBlock, query.HasToBlock, err = getQueryParamUint(urlQuery, queryParamToBlock, 64)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+
+	page, _, err := getQueryParamUint(urlQuery, queryParamPage, 32)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+
+	pageSize, _, err := getQueryParamUint(urlQuery, queryParamPageSize, 32)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+
+	if !query.HasFromBlock {
+		return api.EventsQuery{}, errors.ErrValidationEventsFromBlock
+	}
+
+	query.Page = uint32(page)
+	query.PageSize = uint32(pageSize)
+
+	return query, nil
+}
+
+func getQueryParamUint(urlQuery url.Values, name string, bitSize int) (uint64, bool, error) {
+	valueStr := urlQuery.Get(name)
+	if valueStr == "" {
+		return 0, false, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, bitSize)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return value, true, nil
+}
+
+func getFacade(c *gin.Context) (EventsService, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(EventsService)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
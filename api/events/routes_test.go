@@ -0,0 +1,240 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type eventsResponseData struct {
+	Events api.Events `json:"events"`
+}
+
+type eventsResponse struct {
+	Data  eventsResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestGetEvents_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/events?address=erd1&identifier=deposit", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetEvents_MissingFiltersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetEventsCalled: func(_ api.EventsQuery) (*api.Events, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	for _, url := range []string{"/events", "/events?address=erd1", "/events?identifier=deposit"} {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEventsFilter.Error()))
+	}
+}
+
+func TestGetEvents_MissingFromBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetEventsCalled: func(_ api.EventsQuery) (*api.Events, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/events?address=erd1&identifier=deposit&toBlock=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEventsFromBlock.Error()))
+}
+
+func TestGetEvents_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetEventsCalled: func(_ api.EventsQuery) (*api.Events, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	for _, param := range []string{"fromBlock=a", "toBlock=-1", "page=x", "pageSize=5000000000"} {
+		req, _ := http.NewRequest("GET", "/events?address=erd1&identifier=deposit&"+param, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
+func TestGetEvents_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetEventsCalled: func(_ api.EventsQuery) (*api.Events, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/events?address=erd1&identifier=deposit&fromBlock=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetEvents.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetEvents_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var providedQuery api.EventsQuery
+	expectedEvents := &api.Events{
+		Address:    "erd1",
+		Identifier: "deposit",
+		FromBlock:  5,
+		ToBlock:    10,
+		NumEvents:  1,
+		Page:       1,
+		PageSize:   20,
+		Events: []*api.Event{
+			{Address: "erd1", Identifier: "deposit", Topics: [][]byte{[]byte("topic")}, TxHash: "aa", BlockNonce: 7},
+		},
+	}
+	facade := &mock.Facade{
+		GetEventsCalled: func(query api.EventsQuery) (*api.Events, error) {
+			providedQuery = query
+			return expectedEvents, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/events?address=erd1&identifier=deposit&fromBlock=5&toBlock=10&page=1&pageSize=20", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, *expectedEvents, response.Data.Events)
+	assert.Equal(t, api.EventsQuery{
+		Address:      "erd1",
+		Identifier:   "deposit",
+		FromBlock:    5,
+		HasFromBlock: true,
+		ToBlock:      10,
+		HasToBlock:   true,
+		Page:         1,
+		PageSize:     20,
+	}, providedQuery)
+}
+
+func TestGetEvents_ExplicitZeroToBlockShouldBeForwarded(t *testing.T) {
+	t.Parallel()
+
+	var providedQuery api.EventsQuery
+	facade := &mock.Facade{
+		GetEventsCalled: func(query api.EventsQuery) (*api.Events, error) {
+			providedQuery = query
+			return &api.Events{}, nil
+		},
+	}
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/events?address=erd1&identifier=deposit&fromBlock=0&toBlock=0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, providedQuery.HasFromBlock)
+	assert.True(t, providedQuery.HasToBlock)
+	assert.Equal(t, uint64(0), providedQuery.ToBlock)
+}
+
+func startNodeServer(handler events.EventsService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	eventsRoutes := ws.Group("")
+	if handler != nil {
+		eventsRoutes.Use(middleware.WithFacade(handler))
+	}
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/events", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -66,7 +67,7 @@ func TestCreateEndpointThrottler_NoThrottlerShouldExecute(t *testing.T) {
 
 	numCalls := uint32(0)
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			atomic.AddUint32(&numCalls, 1)
 
 			return big.NewInt(10), nil
@@ -90,7 +91,7 @@ func TestCreateEndpointThrottler_ThrottlerCanNotProcessShouldNotExecute(t *testi
 
 	numCalls := uint32(0)
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			atomic.AddUint32(&numCalls, 1)
 
 			return big.NewInt(10), nil
@@ -123,7 +124,7 @@ func TestCreateEndpointThrottler_ThrottlerStartShouldExecute(t *testing.T) {
 	numStart := uint32(0)
 	numEnd := uint32(0)
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			atomic.AddUint32(&numCalls, 1)
 
 			return big.NewInt(10), nil
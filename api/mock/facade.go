@@ -6,7 +6,9 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	chainData "github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
@@ -22,35 +24,48 @@ type Facade struct {
 	ShouldErrorStop            bool
 	TpsBenchmarkHandler        func() *statistics.TpsBenchmark
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (state.UserAccountHandler, error)
-	GetCodeCalled              func(state.AccountHandler) []byte
+	BalanceHandler             func(address string, options api.AccountQueryOptions) (*big.Int, error)
+	GetAccountWithCodeHandler  func(address string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler              func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction, bypassSignature bool) error
-	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
-	StatusMetricsHandler                    func() external.StatusMetricsHandler
-	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
-	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	NodeConfigCalled                        func() map[string]interface{}
-	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
-	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                       func(address string) (string, error)
-	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
-	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
-	GetNumCheckpointsFromAccountStateCalled func() uint32
-	GetNumCheckpointsFromPeerStateCalled    func() uint32
-	GetESDTBalanceCalled                    func(address string, key string) (string, string, error)
-	GetAllESDTTokensCalled                  func(address string) ([]string, error)
-	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
-	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
-	GetTotalStakedValueHandler              func() (*big.Int, error)
+	ValidateTransactionHandler                  func(tx *transaction.Transaction) error
+	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
+	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	StatusMetricsHandler                        func() external.StatusMetricsHandler
+	ValidatorStatisticsHandler                  func() (map[string]*state.ValidatorApiResponse, error)
+	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	NodeConfigCalled                            func() map[string]interface{}
+	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
+	GetValueForKeyCalled                        func(address string, key string) (string, error)
+	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
+	GetUsernameCalled                           func(address string) (string, error)
+	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, error)
+	GetAccountProofCalled                       func(address string, options api.AccountQueryOptions) (*api.AccountProof, error)
+	GetAccountKeyProofCalled                    func(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	GetNumCheckpointsFromAccountStateCalled     func() uint32
+	GetNumCheckpointsFromPeerStateCalled        func() uint32
+	GetESDTBalanceCalled                        func(address string, key string) (string, string, error)
+	GetAllESDTTokensCalled                      func(address string) ([]string, error)
+	GetBlockByHashCalled                        func(hash string, withTxs bool) (*api.Block, error)
+	GetBlockByNonceCalled                       func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRangeCalled                 func(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
+	GetLatestBlockCalled                        func(withTxs bool) (*api.Block, error)
+	GetTotalStakedValueHandler                  func() (*big.Int, error)
+	GetESDTSupplyCalled                         func(tokenIdentifier string) (*esdt.ESDTSupply, error)
+	GetESDTHoldersCalled                        func(tokenIdentifier string) (uint64, error)
+	GetTransactionsPoolForSenderCalled          func(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolForCacheCalled           func(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolSenderDiagnosticsCalled  func(sender string) (*api.SenderDiagnostics, error)
+	GetTransactionsPoolSendersDiagnosticsCalled func(page uint32, pageSize uint32) (*api.SendersDiagnostics, error)
+	GetEventsCalled                             func(query api.EventsQuery) (*api.Events, error)
+	GetTrieStatisticsCalled                     func(numTopDataTries uint32) (*api.TrieStatistics, error)
+	GetTransactionsForAddressCalled             func(query api.AddressTransactionsQuery) (*api.AddressTransactions, error)
+	RegisterHeadersHandlersCalled               func(newHeadersHandler, finalizedHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte)) error
 }
 
 // GetUsername -
@@ -100,8 +115,8 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *Facade) GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
@@ -114,9 +129,27 @@ func (f *Facade) GetValueForKey(address string, key string) (string, error) {
 }
 
 // GetKeyValuePairs -
-func (f *Facade) GetKeyValuePairs(address string) (map[string]string, error) {
+func (f *Facade) GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error) {
 	if f.GetKeyValuePairsCalled != nil {
-		return f.GetKeyValuePairsCalled(address)
+		return f.GetKeyValuePairsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetAccountProof -
+func (f *Facade) GetAccountProof(address string, options api.AccountQueryOptions) (*api.AccountProof, error) {
+	if f.GetAccountProofCalled != nil {
+		return f.GetAccountProofCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetAccountKeyProof -
+func (f *Facade) GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+	if f.GetAccountKeyProofCalled != nil {
+		return f.GetAccountKeyProofCalled(address, key, options)
 	}
 
 	return nil, nil
@@ -140,18 +173,9 @@ func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
-// GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return f.GetAccountHandler(address)
-}
-
-// GetCode -
-func (f *Facade) GetCode(account state.UserAccountHandler) []byte {
-	if f.GetCodeCalled != nil {
-		f.GetCodeCalled(account)
-	}
-
-	return nil
+// GetAccountWithCode is the mock implementation of a handler's GetAccountWithCode method
+func (f *Facade) GetAccountWithCode(address string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+	return f.GetAccountWithCodeHandler(address, options)
 }
 
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
@@ -218,6 +242,16 @@ func (f *Facade) GetTotalStakedValue() (*big.Int, error) {
 	return f.GetTotalStakedValueHandler()
 }
 
+// GetESDTSupply -
+func (f *Facade) GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+	return f.GetESDTSupplyCalled(tokenIdentifier)
+}
+
+// GetESDTHolders -
+func (f *Facade) GetESDTHolders(tokenIdentifier string) (uint64, error) {
+	return f.GetESDTHoldersCalled(tokenIdentifier)
+}
+
 // ComputeTransactionGasLimit --
 func (f *Facade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -276,6 +310,63 @@ func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByHashCalled(hash, withTxs)
 }
 
+// GetBlocksByNonceRange -
+func (f *Facade) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	return f.GetBlocksByNonceRangeCalled(from, to, withTxs)
+}
+
+// GetLatestBlock -
+func (f *Facade) GetLatestBlock(withTxs bool) (*api.Block, error) {
+	return f.GetLatestBlockCalled(withTxs)
+}
+
+// GetTransactionsPoolForSender -
+func (f *Facade) GetTransactionsPoolForSender(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+	return f.GetTransactionsPoolForSenderCalled(sender, page, pageSize)
+}
+
+// GetTransactionsPoolForCache -
+func (f *Facade) GetTransactionsPoolForCache(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+	return f.GetTransactionsPoolForCacheCalled(cacheID, page, pageSize)
+}
+
+// GetTransactionsPoolSenderDiagnostics -
+func (f *Facade) GetTransactionsPoolSenderDiagnostics(sender string) (*api.SenderDiagnostics, error) {
+	return f.GetTransactionsPoolSenderDiagnosticsCalled(sender)
+}
+
+// GetTransactionsPoolSendersDiagnostics -
+func (f *Facade) GetTransactionsPoolSendersDiagnostics(page uint32, pageSize uint32) (*api.SendersDiagnostics, error) {
+	return f.GetTransactionsPoolSendersDiagnosticsCalled(page, pageSize)
+}
+
+// GetEvents -
+func (f *Facade) GetEvents(query api.EventsQuery) (*api.Events, error) {
+	return f.GetEventsCalled(query)
+}
+
+// GetTransactionsForAddress -
+func (f *Facade) GetTransactionsForAddress(query api.AddressTransactionsQuery) (*api.AddressTransactions, error) {
+	return f.GetTransactionsForAddressCalled(query)
+}
+
+// GetTrieStatistics -
+func (f *Facade) GetTrieStatistics(numTopDataTries uint32) (*api.TrieStatistics, error) {
+	return f.GetTrieStatisticsCalled(numTopDataTries)
+}
+
+// RegisterHeadersHandlers -
+func (f *Facade) RegisterHeadersHandlers(
+	newHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+	finalizedHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+) error {
+	if f.RegisterHeadersHandlersCalled != nil {
+		return f.RegisterHeadersHandlersCalled(newHeadersHandler, finalizedHeadersHandler)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	return f == nil
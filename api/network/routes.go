@@ -1,12 +1,14 @@
 package network
 
 import (
+	"fmt"
 	"math/big"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
@@ -16,11 +18,15 @@ const (
 	getStatusPath   = "/status"
 	economicsPath   = "/economics"
 	totalStakedPath = "/total-staked"
+	esdtSupplyPath  = "/esdt/:token/supply"
+	esdtHoldersPath = "/esdt/:token/holders"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
+	GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error)
+	GetESDTHolders(tokenIdentifier string) (uint64, error)
 	StatusMetrics() external.StatusMetricsHandler
 	IsInterfaceNil() bool
 }
@@ -31,6 +37,8 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getStatusPath, GetNetworkStatus)
 	router.RegisterHandler(http.MethodGet, economicsPath, EconomicsMetrics)
 	router.RegisterHandler(http.MethodGet, totalStakedPath, GetTotalStaked)
+	router.RegisterHandler(http.MethodGet, esdtSupplyPath, GetESDTSupply)
+	router.RegisterHandler(http.MethodGet, esdtHoldersPath, GetESDTHolders)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -151,3 +159,123 @@ func GetTotalStaked(c *gin.Context) {
 		},
 	)
 }
+
+// esdtSupplyNote states the limitation of the returned supply, as the shards only notify the ESDT system smart
+// contract about the supply changes after the activation of the supply tracking
+const esdtSupplyNote = "the minted, burnt and wiped values do not include the local mints, local burns and wipes " +
+	"executed before the activation of the supply tracking"
+
+type esdtSupplyData struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Minted          string `json:"minted"`
+	Burnt           string `json:"burnt"`
+	Wiped           string `json:"wiped"`
+	Supply          string `json:"supply"`
+	Note            string `json:"note"`
+}
+
+// GetESDTSupply is the endpoint that will return the minted, burnt and wiped values of an esdt token, together with
+// its supply. It can only be served by a metachain node and the response states the limitation of the values
+func GetESDTSupply(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	tokenIdentifier, ok := getTokenIdentifier(c, errors.ErrGetESDTSupply)
+	if !ok {
+		return
+	}
+
+	supply, err := facade.GetESDTSupply(tokenIdentifier)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTSupply.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	supplyData := esdtSupplyData{
+		TokenIdentifier: tokenIdentifier,
+		Minted:          bigIntToString(supply.Minted),
+		Burnt:           bigIntToString(supply.Burnt),
+		Wiped:           bigIntToString(supply.Wiped),
+		Supply:          bigIntToString(supply.Supply),
+		Note:            esdtSupplyNote,
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"supply": supplyData},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetESDTHolders is the endpoint that will return the number of accounts holding an esdt token. It can only be
+// served by a shard node and counts the holders from that shard
+func GetESDTHolders(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	tokenIdentifier, ok := getTokenIdentifier(c, errors.ErrGetESDTHolders)
+	if !ok {
+		return
+	}
+
+	numHolders, err := facade.GetESDTHolders(tokenIdentifier)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTHolders.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"tokenIdentifier": tokenIdentifier, "holders": numHolders},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getTokenIdentifier(c *gin.Context, endpointErr error) (string, bool) {
+	tokenIdentifier := c.Param("token")
+	if tokenIdentifier == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", endpointErr.Error(), errors.ErrEmptyTokenIdentifier.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return "", false
+	}
+
+	return tokenIdentifier, true
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
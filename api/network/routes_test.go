@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/gin-contrib/cors"
@@ -210,6 +211,92 @@ func TestTotalStaked_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestGetESDTSupply_ShouldWork(t *testing.T) {
+	facade := &mock.Facade{}
+	facade.GetESDTSupplyCalled = func(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+		assert.Equal(t, "TKN-abcdef", tokenIdentifier)
+		return &esdt.ESDTSupply{
+			Minted: big.NewInt(1000),
+			Burnt:  big.NewInt(100),
+			Wiped:  big.NewInt(50),
+			Supply: big.NewInt(850),
+		}, nil
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/esdt/TKN-abcdef/supply", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.Contains(respStr, `"minted":"1000"`))
+	assert.True(t, strings.Contains(respStr, `"burnt":"100"`))
+	assert.True(t, strings.Contains(respStr, `"wiped":"50"`))
+	assert.True(t, strings.Contains(respStr, `"supply":"850"`))
+	assert.True(t, strings.Contains(respStr, `"note":"the minted, burnt and wiped values do not include`))
+}
+
+func TestGetESDTSupply_FacadeErrorShouldErr(t *testing.T) {
+	expectedErr := errors.ErrGetESDTSupply
+	facade := &mock.Facade{}
+	facade.GetESDTSupplyCalled = func(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+		return nil, expectedErr
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/esdt/TKN-abcdef/supply", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetESDTHolders_ShouldWork(t *testing.T) {
+	facade := &mock.Facade{}
+	facade.GetESDTHoldersCalled = func(tokenIdentifier string) (uint64, error) {
+		assert.Equal(t, "TKN-abcdef", tokenIdentifier)
+		return 37, nil
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/esdt/TKN-abcdef/holders", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := ioutil.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.Contains(respStr, `"holders":37`))
+	assert.True(t, strings.Contains(respStr, `"tokenIdentifier":"TKN-abcdef"`))
+}
+
+func TestGetESDTHolders_FacadeErrorShouldErr(t *testing.T) {
+	expectedErr := errors.ErrGetESDTHolders
+	facade := &mock.Facade{}
+	facade.GetESDTHoldersCalled = func(tokenIdentifier string) (uint64, error) {
+		return 0, expectedErr
+	}
+
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest(http.MethodGet, "/network/esdt/TKN-abcdef/holders", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -260,6 +347,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
 					{Name: "/total-staked", Open: true},
+					{Name: "/esdt/:token/supply", Open: true},
+					{Name: "/esdt/:token/holders", Open: true},
 				},
 			},
 		},
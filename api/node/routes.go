@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -18,6 +20,7 @@ import (
 
 const (
 	pidQueryParam       = "pid"
+	topQueryParam       = "top"
 	debugPath           = "/debug"
 	heartbeatStatusPath = "/heartbeatstatus"
 	metricsPath         = "/metrics"
@@ -25,6 +28,7 @@ const (
 	peerInfoPath        = "/peerinfo"
 	statisticsPath      = "/statistics"
 	statusPath          = "/status"
+	trieStatisticsPath  = "/trie-statistics"
 )
 
 // AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
@@ -42,6 +46,7 @@ type FacadeHandler interface {
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
+	GetTrieStatistics(numTopDataTries uint32) (*api.TrieStatistics, error)
 	IsInterfaceNil() bool
 }
 
@@ -84,6 +89,7 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, trieStatisticsPath, TrieStatistics)
 	// placeholder for custom routes
 }
 
@@ -313,6 +319,54 @@ func PeerInfo(c *gin.Context) {
 	)
 }
 
+// TrieStatistics returns the statistics of the accounts trie and of the largest accounts data tries
+func TrieStatistics(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	numTopDataTries := uint64(0)
+	top := c.Request.URL.Query().Get(topQueryParam)
+	if top != "" {
+		var err error
+		numTopDataTries, err = strconv.ParseUint(top, 10, 32)
+		if err != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+	}
+
+	stats, err := facade.GetTrieStatistics(uint32(numTopDataTries))
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTrieStatistics.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"statistics": stats},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // PrometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func PrometheusMetrics(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -461,6 +462,81 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestTrieStatistics_InvalidTopShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetTrieStatisticsCalled: func(numTopDataTries uint32) (*api.TrieStatistics, error) {
+			assert.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/trie-statistics?top=invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestTrieStatistics_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetTrieStatisticsCalled: func(numTopDataTries uint32) (*api.TrieStatistics, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/trie-statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetTrieStatistics.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestTrieStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	stats := &api.TrieStatistics{
+		RootHash:    "root hash",
+		NumAccounts: 37,
+	}
+	facade := &mock.Facade{
+		GetTrieStatisticsCalled: func(numTopDataTries uint32) (*api.TrieStatistics, error) {
+			assert.Equal(t, uint32(5), numTopDataTries)
+			return stats, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/trie-statistics?top=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	responseStats, ok := responseData["statistics"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, stats.RootHash, responseStats["rootHash"])
+	assert.Equal(t, float64(stats.NumAccounts), responseStats["numAccounts"])
+}
+
 func TestPrometheusMetrics_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/node/metrics", nil)
@@ -559,6 +635,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/trie-statistics", Open: true},
 				},
 			},
 		},
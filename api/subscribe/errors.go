@@ -0,0 +1,30 @@
+package subscribe
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrSubscriberClosed signals that the subscriber is closed and no longer accepts notifications
+var ErrSubscriberClosed = errors.New("subscriber is closed")
+
+// ErrSubscriberBusy signals that the notifications queue of the subscriber is full
+var ErrSubscriberBusy = errors.New("subscriber is busy")
+
+// ErrInvalidAction signals that the action of a subscription request is not known
+var ErrInvalidAction = errors.New("invalid action, expected subscribe or unsubscribe")
+
+// ErrInvalidTopic signals that the topic of a subscription request is not known
+var ErrInvalidTopic = errors.New("invalid topic")
+
+// ErrMissingTxHashes signals that a transactions status subscription request does not provide any transaction hash
+var ErrMissingTxHashes = errors.New("no transaction hash provided")
+
+// ErrMissingEventsFilter signals that an events subscription request does not provide the address and the identifier
+var ErrMissingEventsFilter = errors.New("both the address and the identifier of the events have to be provided")
+
+// ErrTooManySubscriptions signals that a subscriber reached the maximum number of subscriptions of a topic
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
@@ -0,0 +1,331 @@
+package subscribe
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gorilla/websocket"
+)
+
+var log = logger.GetOrCreate("api/subscribe")
+
+const (
+	maxTxHashesPerSubscriber      = 1000
+	maxEventsFiltersPerSubscriber = 100
+	maxEventsPerNotification      = 1000
+)
+
+// hub dispatches the notifications of the block tracker to the web socket subscribers. The new headers trigger the
+// refresh of the watched transactions statuses and of the events filters, which are then notified only on changes.
+type hub struct {
+	facade FacadeHandler
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[*subscriber]struct{}
+
+	// the notifications are processed one at a time, so that each subscriber receives them in order
+	mutProcessing      sync.Mutex
+	lastHeaderNonce    uint64
+	lastFinalizedNonce uint64
+	mutLastHeaderNonce sync.RWMutex
+}
+
+// NewHub creates a subscriptions hub and registers it to the new and to the finalized headers notifications
+func NewHub(facade FacadeHandler) (*hub, error) {
+	if check.IfNil(facade) {
+		return nil, ErrNilFacade
+	}
+
+	h := &hub{
+		facade:      facade,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+
+	err := facade.RegisterHeadersHandlers(h.OnNewHeaders, h.OnFinalizedHeaders)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// ServeConnection handles the subscription requests of a web socket connection and sends the notifications on it,
+// until the connection is closed. It blocks.
+func (h *hub) ServeConnection(conn wsConn) error {
+	if conn == nil {
+		return ErrNilWsConn
+	}
+
+	s := newSubscriber(conn)
+	h.addSubscriber(s)
+	defer func() {
+		h.removeSubscriber(s)
+		s.close()
+	}()
+
+	go s.doSendContinuously()
+
+	for {
+		mt, message, err := conn.ReadMessage()
+		if err != nil || mt == websocket.CloseMessage {
+			return nil
+		}
+
+		request := &SubscriptionRequest{}
+		err = json.Unmarshal(message, request)
+		if err != nil {
+			s.notifyError(err)
+			continue
+		}
+
+		err = h.handleRequest(s, request)
+		if err != nil {
+			s.notifyError(fmt.Errorf("%w for %s %s", err, request.Action, request.Topic))
+		}
+	}
+}
+
+func (h *hub) addSubscriber(s *subscriber) {
+	h.mutSubscribers.Lock()
+	h.subscribers[s] = struct{}{}
+	h.mutSubscribers.Unlock()
+}
+
+func (h *hub) removeSubscriber(s *subscriber) {
+	h.mutSubscribers.Lock()
+	delete(h.subscribers, s)
+	h.mutSubscribers.Unlock()
+}
+
+func (h *hub) getSubscribers() []*subscriber {
+	h.mutSubscribers.RLock()
+	defer h.mutSubscribers.RUnlock()
+
+	subscribers := make([]*subscriber, 0, len(h.subscribers))
+	for s := range h.subscribers {
+		subscribers = append(subscribers, s)
+	}
+
+	return subscribers
+}
+
+func (h *hub) handleRequest(s *subscriber, request *SubscriptionRequest) error {
+	if request.Action != ActionSubscribe && request.Action != ActionUnsubscribe {
+		return ErrInvalidAction
+	}
+	isSubscribe := request.Action == ActionSubscribe
+
+	switch request.Topic {
+	case TopicHeaders, TopicFinalizedHeaders:
+		if isSubscribe {
+			s.subscribeToTopic(request.Topic)
+		} else {
+			s.unsubscribeFromTopic(request.Topic)
+		}
+		return nil
+	case TopicTxStatus:
+		if len(request.TxHashes) == 0 {
+			return ErrMissingTxHashes
+		}
+		if !isSubscribe {
+			s.removeTxHashes(request.TxHashes)
+			return nil
+		}
+
+		err := s.addTxHashes(request.TxHashes)
+		if err != nil {
+			return err
+		}
+
+		// the current status is notified right away
+		h.refreshTxStatuses(s, request.TxHashes, make(map[string]transaction.TxStatus))
+		return nil
+	case TopicEvents:
+		if request.Address == "" || request.Identifier == "" {
+			return ErrMissingEventsFilter
+		}
+		if !isSubscribe {
+			s.removeEventsFilter(request.Address, request.Identifier)
+			return nil
+		}
+
+		// only the events of the blocks that follow the subscription are notified
+		return s.addEventsFilter(request.Address, request.Identifier, h.getLastHeaderNonce())
+	default:
+		return ErrInvalidTopic
+	}
+}
+
+// OnNewHeaders notifies the subscribers about the new headers of the node's shard, then refreshes the watched
+// transactions statuses and the events filters. The headers already notified (by nonce) are skipped.
+func (h *hub) OnNewHeaders(_ uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
+	h.mutProcessing.Lock()
+	defer h.mutProcessing.Unlock()
+
+	notifications, lastNonce := createHeaderNotifications(headers, headersHashes, h.getLastHeaderNonce())
+	if len(notifications) == 0 {
+		return
+	}
+	h.setLastHeaderNonce(lastNonce)
+
+	subscribers := h.getSubscribers()
+	h.notifySubscribers(subscribers, TopicHeaders, notifications)
+
+	txStatusesCache := make(map[string]transaction.TxStatus)
+	for _, s := range subscribers {
+		h.refreshTxStatuses(s, s.getTxHashes(), txStatusesCache)
+		h.refreshEvents(s, lastNonce)
+	}
+}
+
+// OnFinalizedHeaders notifies the subscribers about the finalized headers, then refreshes the watched transactions
+// statuses, as a cross shard transaction becomes final once notarized
+func (h *hub) OnFinalizedHeaders(_ uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
+	h.mutProcessing.Lock()
+	defer h.mutProcessing.Unlock()
+
+	notifications, lastNonce := createHeaderNotifications(headers, headersHashes, h.lastFinalizedNonce)
+	if len(notifications) == 0 {
+		return
+	}
+	h.lastFinalizedNonce = lastNonce
+
+	subscribers := h.getSubscribers()
+	h.notifySubscribers(subscribers, TopicFinalizedHeaders, notifications)
+
+	txStatusesCache := make(map[string]transaction.TxStatus)
+	for _, s := range subscribers {
+		h.refreshTxStatuses(s, s.getTxHashes(), txStatusesCache)
+	}
+}
+
+func (h *hub) notifySubscribers(subscribers []*subscriber, topic string, notifications []*HeaderNotification) {
+	for _, s := range subscribers {
+		if !s.isSubscribedToTopic(topic) {
+			continue
+		}
+
+		for _, notification := range notifications {
+			h.notify(s, &Notification{Topic: topic, Data: notification})
+		}
+	}
+}
+
+// refreshTxStatuses notifies the status of the given transactions when changed. The cache avoids fetching the same
+// transaction for more subscribers.
+func (h *hub) refreshTxStatuses(s *subscriber, txHashes []string, cache map[string]transaction.TxStatus) {
+	for _, txHash := range txHashes {
+		status, ok := cache[txHash]
+		if !ok {
+			tx, err := h.facade.GetTransaction(txHash, false)
+			if err != nil {
+				// not yet known by the node
+				continue
+			}
+
+			status = tx.Status
+			cache[txHash] = status
+		}
+
+		if !s.setTxStatus(txHash, status) {
+			continue
+		}
+
+		h.notify(s, &Notification{
+			Topic: TopicTxStatus,
+			Data:  &TxStatusNotification{Hash: txHash, Status: status},
+		})
+	}
+}
+
+// refreshEvents notifies the events emitted in the blocks following the last notified one. If the blocks are not
+// yet available in the storage, the events will be fetched on the next notification.
+func (h *hub) refreshEvents(s *subscriber, toNonce uint64) {
+	for _, filter := range s.getEventsFilters() {
+		if filter.lastNonce >= toNonce {
+			continue
+		}
+
+		fromNonce := filter.lastNonce + 1
+		if filter.lastNonce == 0 {
+			// subscribed before any header was notified: only the newest block is considered
+			fromNonce = toNonce
+		}
+
+		events, err := h.facade.GetEvents(api.EventsQuery{
+			Address:      filter.address,
+			Identifier:   filter.identifier,
+			FromBlock:    fromNonce,
+			HasFromBlock: true,
+			ToBlock:      toNonce,
+			HasToBlock:   true,
+			PageSize:     maxEventsPerNotification,
+		})
+		if err != nil {
+			log.Trace("hub.refreshEvents", "address", filter.address, "identifier", filter.identifier, "error", err.Error())
+			continue
+		}
+
+		s.setEventsFilterLastNonce(filter.address, filter.identifier, toNonce)
+		if len(events.Events) == 0 {
+			continue
+		}
+
+		h.notify(s, &Notification{Topic: TopicEvents, Data: events})
+	}
+}
+
+func (h *hub) notify(s *subscriber, notification *Notification) {
+	err := s.notify(notification)
+	if err == ErrSubscriberBusy {
+		log.Debug("subscriber too slow, closing the connection")
+		s.close()
+	}
+}
+
+func (h *hub) getLastHeaderNonce() uint64 {
+	h.mutLastHeaderNonce.RLock()
+	defer h.mutLastHeaderNonce.RUnlock()
+
+	return h.lastHeaderNonce
+}
+
+func (h *hub) setLastHeaderNonce(nonce uint64) {
+	h.mutLastHeaderNonce.Lock()
+	h.lastHeaderNonce = nonce
+	h.mutLastHeaderNonce.Unlock()
+}
+
+func createHeaderNotifications(headers []data.HeaderHandler, headersHashes [][]byte, lastNonce uint64) ([]*HeaderNotification, uint64) {
+	notifications := make([]*HeaderNotification, 0, len(headers))
+	for i, header := range headers {
+		if check.IfNil(header) || i >= len(headersHashes) || header.GetNonce() <= lastNonce {
+			continue
+		}
+
+		notifications = append(notifications, &HeaderNotification{
+			ShardID:   header.GetShardID(),
+			Nonce:     header.GetNonce(),
+			Round:     header.GetRound(),
+			Epoch:     header.GetEpoch(),
+			Hash:      hex.EncodeToString(headersHashes[i]),
+			PrevHash:  hex.EncodeToString(header.GetPrevHash()),
+			TimeStamp: header.GetTimeStamp(),
+		})
+		lastNonce = header.GetNonce()
+	}
+
+	return notifications, lastNonce
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (h *hub) IsInterfaceNil() bool {
+	return h == nil
+}
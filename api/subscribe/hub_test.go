@@ -0,0 +1,260 @@
+package subscribe
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFacadeForHub() *mock.Facade {
+	return &mock.Facade{
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return nil, errors.New("transaction not found")
+		},
+		GetEventsCalled: func(query api.EventsQuery) (*api.Events, error) {
+			return &api.Events{}, nil
+		},
+	}
+}
+
+func createSubscriberForHub(h *hub) *subscriber {
+	conn := &mock.WsConnStub{}
+	conn.SetCloseHandler(func() error {
+		return nil
+	})
+	s := newSubscriber(conn)
+	h.addSubscriber(s)
+
+	return s
+}
+
+func getQueuedNotifications(s *subscriber) []*Notification {
+	notifications := make([]*Notification, 0)
+	for {
+		select {
+		case buff := <-s.queue:
+			notification := &Notification{}
+			_ = json.Unmarshal(buff, notification)
+			notifications = append(notifications, notification)
+		default:
+			return notifications
+		}
+	}
+}
+
+func TestNewHub_NilFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewHub(nil)
+	assert.Nil(t, h)
+	assert.Equal(t, ErrNilFacade, err)
+}
+
+func TestNewHub_RegisterHeadersHandlersFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := createFacadeForHub()
+	facade.RegisterHeadersHandlersCalled = func(_, _ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) error {
+		return expectedErr
+	}
+
+	h, err := NewHub(facade)
+	assert.Nil(t, h)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNewHub_ShouldRegisterTheHeadersHandlers(t *testing.T) {
+	t.Parallel()
+
+	registered := false
+	facade := createFacadeForHub()
+	facade.RegisterHeadersHandlersCalled = func(newHeadersHandler, finalizedHeadersHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) error {
+		registered = newHeadersHandler != nil && finalizedHeadersHandler != nil
+		return nil
+	}
+
+	h, err := NewHub(facade)
+	assert.Nil(t, err)
+	assert.False(t, h.IsInterfaceNil())
+	assert.True(t, registered)
+}
+
+func TestHub_HandleRequestInvalidRequestsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	h, _ := NewHub(createFacadeForHub())
+	s := createSubscriberForHub(h)
+
+	err := h.handleRequest(s, &SubscriptionRequest{Action: "listen", Topic: TopicHeaders})
+	assert.Equal(t, ErrInvalidAction, err)
+
+	err = h.handleRequest(s, &SubscriptionRequest{Action: ActionSubscribe, Topic: "blocks"})
+	assert.Equal(t, ErrInvalidTopic, err)
+
+	err = h.handleRequest(s, &SubscriptionRequest{Action: ActionSubscribe, Topic: TopicTxStatus})
+	assert.Equal(t, ErrMissingTxHashes, err)
+
+	err = h.handleRequest(s, &SubscriptionRequest{Action: ActionSubscribe, Topic: TopicEvents, Address: "erd1"})
+	assert.Equal(t, ErrMissingEventsFilter, err)
+}
+
+func TestHub_OnNewHeadersShouldNotifyOnlyTheSubscribersOfTheTopic(t *testing.T) {
+	t.Parallel()
+
+	h, _ := NewHub(createFacadeForHub())
+	subscribed := createSubscriberForHub(h)
+	notSubscribed := createSubscriberForHub(h)
+	_ = h.handleRequest(subscribed, &SubscriptionRequest{Action: ActionSubscribe, Topic: TopicHeaders})
+
+	h.OnNewHeaders(0, []data.HeaderHandler{&block.Header{Nonce: 7, Round: 8}}, [][]byte{[]byte("hash")})
+	// already notified
+	h.OnNewHeaders(0, []data.HeaderHandler{&block.Header{Nonce: 7, Round: 8}}, [][]byte{[]byte("hash")})
+
+	notifications := getQueuedNotifications(subscribed)
+	require.Equal(t, 1, len(notifications))
+	assert.Equal(t, TopicHeaders, notifications[0].Topic)
+	headerNotification := notifications[0].Data.(map[string]interface{})
+	assert.Equal(t, float64(7), headerNotification["nonce"])
+	assert.Equal(t, "68617368", headerNotification["hash"])
+
+	assert.Equal(t, 0, len(getQueuedNotifications(notSubscribed)))
+}
+
+func TestHub_TxStatusShouldBeNotifiedOnlyOnChanges(t *testing.T) {
+	t.Parallel()
+
+	mutStatus := sync.Mutex{}
+	status := transaction.TxStatusPending
+	facade := createFacadeForHub()
+	facade.GetTransactionHandler = func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+		mutStatus.Lock()
+		defer mutStatus.Unlock()
+
+		return &transaction.ApiTransactionResult{Status: status}, nil
+	}
+	h, _ := NewHub(facade)
+	s := createSubscriberForHub(h)
+
+	err := h.handleRequest(s, &SubscriptionRequest{Action: ActionSubscribe, Topic: TopicTxStatus, TxHashes: []string{"aa"}})
+	require.Nil(t, err)
+	notifications := getQueuedNotifications(s)
+	require.Equal(t, 1, len(notifications))
+	assert.Equal(t, TopicTxStatus, notifications[0].Topic)
+
+	h.OnNewHeaders(0, []data.HeaderHandler{&block.Header{Nonce: 1}}, [][]byte{[]byte("h1")})
+	assert.Equal(t, 0, len(getQueuedNotifications(s)))
+
+	mutStatus.Lock()
+	status = transaction.TxStatusSuccess
+	mutStatus.Unlock()
+	h.OnFinalizedHeaders(0, []data.HeaderHandler{&block.Header{Nonce: 1}}, [][]byte{[]byte("h1")})
+	notifications = getQueuedNotifications(s)
+	require.Equal(t, 1, len(notifications))
+	txStatusNotification := notifications[0].Data.(map[string]interface{})
+	assert.Equal(t, "aa", txStatusNotification["hash"])
+	assert.Equal(t, string(transaction.TxStatusSuccess), txStatusNotification["status"])
+
+	_ = h.handleRequest(s, &SubscriptionRequest{Action: ActionUnsubscribe, Topic: TopicTxStatus, TxHashes: []string{"aa"}})
+	assert.Equal(t, 0, len(s.getTxHashes()))
+}
+
+func TestHub_EventsShouldBeFetchedForTheBlocksFollowingTheSubscription(t *testing.T) {
+	t.Parallel()
+
+	queries := make([]api.EventsQuery, 0)
+	facade := createFacadeForHub()
+	facade.GetEventsCalled = func(query api.EventsQuery) (*api.Events, error) {
+		queries = append(queries, query)
+		return &api.Events{Events: []*api.Event{{BlockNonce: query.ToBlock}}}, nil
+	}
+	h, _ := NewHub(facade)
+	h.OnNewHeaders(0, []data.HeaderHandler{&block.Header{Nonce: 10}}, [][]byte{[]byte("h10")})
+
+	s := createSubscriberForHub(h)
+	err := h.handleRequest(s, &SubscriptionRequest{Action: ActionSubscribe, Topic: TopicEvents, Address: "erd1", Identifier: "deposit"})
+	require.Nil(t, err)
+
+	h.OnNewHeaders(0, []data.HeaderHandler{&block.Header{Nonce: 11}, &block.Header{Nonce: 12}}, [][]byte{[]byte("h11"), []byte("h12")})
+
+	require.Equal(t, 1, len(queries))
+	assert.Equal(t, uint64(11), queries[0].FromBlock)
+	assert.Equal(t, uint64(12), queries[0].ToBlock)
+	assert.True(t, queries[0].HasToBlock)
+	assert.Equal(t, "deposit", queries[0].Identifier)
+
+	notifications := getQueuedNotifications(s)
+	require.Equal(t, 1, len(notifications))
+	assert.Equal(t, TopicEvents, notifications[0].Topic)
+}
+
+func TestHub_ServeConnectionShouldHandleTheRequestsUntilTheConnectionIsClosed(t *testing.T) {
+	t.Parallel()
+
+	messages := [][]byte{
+		[]byte(`{"action": "subscribe", "topic": "headers"}`),
+		[]byte(`not a json`),
+	}
+	mutWritten := sync.Mutex{}
+	written := make([]*Notification, 0)
+	closed := make(chan struct{})
+
+	conn := &mock.WsConnStub{}
+	conn.SetReadMessageHandler(func() (int, []byte, error) {
+		if len(messages) == 0 {
+			<-closed
+			return websocket.CloseMessage, nil, nil
+		}
+
+		message := messages[0]
+		messages = messages[1:]
+		return websocket.TextMessage, message, nil
+	})
+	conn.SetWriteMessageHandler(func(_ int, buff []byte) error {
+		notification := &Notification{}
+		_ = json.Unmarshal(buff, notification)
+
+		mutWritten.Lock()
+		written = append(written, notification)
+		mutWritten.Unlock()
+
+		return nil
+	})
+	conn.SetCloseHandler(func() error {
+		return nil
+	})
+
+	h, _ := NewHub(createFacadeForHub())
+	done := make(chan error)
+	go func() {
+		done <- h.ServeConnection(conn)
+	}()
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 1, len(h.getSubscribers()))
+	close(closed)
+
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout while waiting for the connection to be released")
+	}
+	assert.Equal(t, 0, len(h.getSubscribers()))
+
+	mutWritten.Lock()
+	defer mutWritten.Unlock()
+	require.Equal(t, 1, len(written))
+	assert.Equal(t, TopicError, written[0].Topic)
+}
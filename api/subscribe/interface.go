@@ -0,0 +1,26 @@
+package subscribe
+
+import (
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+type wsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+}
+
+// FacadeHandler defines the methods of the facade that are used by the subscriptions hub
+type FacadeHandler interface {
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetEvents(query api.EventsQuery) (*api.Events, error)
+	RegisterHeadersHandlers(
+		newHeadersHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte),
+		finalizedHeadersHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte),
+	) error
+	IsInterfaceNil() bool
+}
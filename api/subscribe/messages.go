@@ -0,0 +1,56 @@
+package subscribe
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+const (
+	// ActionSubscribe is the action of a request that adds a subscription
+	ActionSubscribe = "subscribe"
+	// ActionUnsubscribe is the action of a request that removes a subscription
+	ActionUnsubscribe = "unsubscribe"
+
+	// TopicHeaders notifies the new block headers of the node's shard
+	TopicHeaders = "headers"
+	// TopicFinalizedHeaders notifies the block headers of the node's shard that have been notarized by the metachain
+	// (on the metachain, the final metachain headers)
+	TopicFinalizedHeaders = "finalizedHeaders"
+	// TopicTxStatus notifies the status changes of the given transactions
+	TopicTxStatus = "txStatus"
+	// TopicEvents notifies the events emitted by the given address, having the given identifier
+	TopicEvents = "events"
+	// TopicError notifies an invalid request
+	TopicError = "error"
+)
+
+// SubscriptionRequest is the message that a client sends in order to subscribe to (or unsubscribe from) a topic.
+// TxHashes are used by the txStatus topic, while Address and Identifier are used by the events topic.
+type SubscriptionRequest struct {
+	Action     string   `json:"action"`
+	Topic      string   `json:"topic"`
+	TxHashes   []string `json:"txHashes,omitempty"`
+	Address    string   `json:"address,omitempty"`
+	Identifier string   `json:"identifier,omitempty"`
+}
+
+// Notification is the message sent to the clients
+type Notification struct {
+	Topic string      `json:"topic"`
+	Data  interface{} `json:"data,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+// HeaderNotification holds the data of a notified block header
+type HeaderNotification struct {
+	ShardID   uint32 `json:"shardID"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	Hash      string `json:"hash"`
+	PrevHash  string `json:"prevHash"`
+	TimeStamp uint64 `json:"timestamp"`
+}
+
+// TxStatusNotification holds the new status of a transaction
+type TxStatusNotification struct {
+	Hash   string               `json:"hash"`
+	Status transaction.TxStatus `json:"status"`
+}
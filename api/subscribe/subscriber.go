@@ -0,0 +1,225 @@
+package subscribe
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gorilla/websocket"
+)
+
+const notificationsQueueSize = 100
+
+type eventsFilter struct {
+	address    string
+	identifier string
+	lastNonce  uint64
+}
+
+// subscriber holds the subscriptions of a web socket connection. The notifications are queued and sent on a
+// separate go routine, so that a slow client does not block the hub; a client that falls behind more than the
+// queue size is disconnected.
+type subscriber struct {
+	conn wsConn
+
+	mutQueue    sync.RWMutex
+	queueClosed bool
+	queue       chan []byte
+
+	mutSubscriptions sync.RWMutex
+	topics           map[string]struct{}
+	txStatuses       map[string]transaction.TxStatus
+	eventsFilters    map[string]*eventsFilter
+}
+
+func newSubscriber(conn wsConn) *subscriber {
+	return &subscriber{
+		conn:          conn,
+		queue:         make(chan []byte, notificationsQueueSize),
+		topics:        make(map[string]struct{}),
+		txStatuses:    make(map[string]transaction.TxStatus),
+		eventsFilters: make(map[string]*eventsFilter),
+	}
+}
+
+func (s *subscriber) notify(notification *Notification) error {
+	buff, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	s.mutQueue.RLock()
+	defer s.mutQueue.RUnlock()
+
+	if s.queueClosed {
+		return ErrSubscriberClosed
+	}
+
+	select {
+	case s.queue <- buff:
+		return nil
+	default:
+		return ErrSubscriberBusy
+	}
+}
+
+func (s *subscriber) notifyError(err error) {
+	_ = s.notify(&Notification{
+		Topic: TopicError,
+		Error: err.Error(),
+	})
+}
+
+func (s *subscriber) doSendContinuously() {
+	for buff := range s.queue {
+		err := s.conn.WriteMessage(websocket.TextMessage, buff)
+		if err != nil {
+			isConnectionClosed := strings.Contains(err.Error(), "websocket: close sent")
+			if !isConnectionClosed {
+				log.Debug("subscriber web socket error", "error", err.Error())
+			}
+
+			s.close()
+			return
+		}
+	}
+}
+
+func (s *subscriber) close() {
+	s.mutQueue.Lock()
+	defer s.mutQueue.Unlock()
+
+	if s.queueClosed {
+		return
+	}
+
+	s.queueClosed = true
+	close(s.queue)
+	_ = s.conn.Close()
+}
+
+func (s *subscriber) isSubscribedToTopic(topic string) bool {
+	s.mutSubscriptions.RLock()
+	defer s.mutSubscriptions.RUnlock()
+
+	_, ok := s.topics[topic]
+	return ok
+}
+
+func (s *subscriber) getTxHashes() []string {
+	s.mutSubscriptions.RLock()
+	defer s.mutSubscriptions.RUnlock()
+
+	txHashes := make([]string, 0, len(s.txStatuses))
+	for txHash := range s.txStatuses {
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes
+}
+
+// setTxStatus records the new status of a watched transaction and returns true if it has changed
+func (s *subscriber) setTxStatus(txHash string, status transaction.TxStatus) bool {
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	lastStatus, ok := s.txStatuses[txHash]
+	if !ok || lastStatus == status {
+		return false
+	}
+
+	s.txStatuses[txHash] = status
+	return true
+}
+
+func (s *subscriber) getEventsFilters() []eventsFilter {
+	s.mutSubscriptions.RLock()
+	defer s.mutSubscriptions.RUnlock()
+
+	filters := make([]eventsFilter, 0, len(s.eventsFilters))
+	for _, filter := range s.eventsFilters {
+		filters = append(filters, *filter)
+	}
+
+	return filters
+}
+
+func (s *subscriber) setEventsFilterLastNonce(address string, identifier string, lastNonce uint64) {
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	filter, ok := s.eventsFilters[createEventsFilterKey(address, identifier)]
+	if ok {
+		filter.lastNonce = lastNonce
+	}
+}
+
+func createEventsFilterKey(address string, identifier string) string {
+	return address + "/" + identifier
+}
+
+func (s *subscriber) subscribeToTopic(topic string) {
+	s.mutSubscriptions.Lock()
+	s.topics[topic] = struct{}{}
+	s.mutSubscriptions.Unlock()
+}
+
+func (s *subscriber) unsubscribeFromTopic(topic string) {
+	s.mutSubscriptions.Lock()
+	delete(s.topics, topic)
+	s.mutSubscriptions.Unlock()
+}
+
+func (s *subscriber) addTxHashes(txHashes []string) error {
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	for _, txHash := range txHashes {
+		_, exists := s.txStatuses[txHash]
+		if !exists && len(s.txStatuses) >= maxTxHashesPerSubscriber {
+			return ErrTooManySubscriptions
+		}
+
+		// the empty status will be replaced (and notified) by the first known status of the transaction
+		s.txStatuses[txHash] = ""
+	}
+
+	return nil
+}
+
+func (s *subscriber) removeTxHashes(txHashes []string) {
+	s.mutSubscriptions.Lock()
+	for _, txHash := range txHashes {
+		delete(s.txStatuses, txHash)
+	}
+	s.mutSubscriptions.Unlock()
+}
+
+func (s *subscriber) addEventsFilter(address string, identifier string, lastNonce uint64) error {
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	key := createEventsFilterKey(address, identifier)
+	_, exists := s.eventsFilters[key]
+	if exists {
+		return nil
+	}
+	if len(s.eventsFilters) >= maxEventsFiltersPerSubscriber {
+		return ErrTooManySubscriptions
+	}
+
+	s.eventsFilters[key] = &eventsFilter{
+		address:    address,
+		identifier: identifier,
+		lastNonce:  lastNonce,
+	}
+
+	return nil
+}
+
+func (s *subscriber) removeEventsFilter(address string, identifier string) {
+	s.mutSubscriptions.Lock()
+	delete(s.eventsFilters, createEventsFilterKey(address, identifier))
+	s.mutSubscriptions.Unlock()
+}
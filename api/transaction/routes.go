@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
@@ -26,9 +27,18 @@ const (
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	getTransactionsPoolPath          = "/pool"
+
+	// transactionsPoolSegment is the value of the "txhash" parameter for which the transactions pool is served
+	transactionsPoolSegment = "pool"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamSender         = "sender"
+	queryParamCacheID        = "cacheId"
+	queryParamDiagnostics    = "diagnostics"
+	queryParamPage           = "page"
+	queryParamPageSize       = "pageSize"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -41,6 +51,10 @@ type FacadeHandler interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	GetTransactionsPoolForSender(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolForCache(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolSenderDiagnostics(sender string) (*api.SenderDiagnostics, error)
+	GetTransactionsPoolSendersDiagnostics(page uint32, pageSize uint32) (*api.SendersDiagnostics, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
@@ -113,10 +127,23 @@ func Routes(router *wrapper.RouterWrapper) {
 		http.MethodGet,
 		getTransactionPath,
 		middleware.CreateEndpointThrottler(getTransactionEndpoint),
-		GetTransaction,
+		getTransactionOrTransactionsPool(router.IsEndpointActive(getTransactionsPoolPath)),
 	)
 }
 
+// getTransactionOrTransactionsPool serves "/transaction/pool" through the "/:txhash" route, as gin does not allow
+// registering a static segment next to a wildcard one
+func getTransactionOrTransactionsPool(isTransactionsPoolActive bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isTransactionsPoolActive && c.Param("txhash") == transactionsPoolSegment {
+			GetTransactionsPool(c)
+			return
+		}
+
+		GetTransaction(c)
+	}
+}
+
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
 	facadeObj, ok := c.Get("facade")
 	if !ok {
@@ -540,6 +567,130 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 	)
 }
 
+// GetTransactionsPool returns a page of the pending transactions of a sender (the "sender" query parameter) or of
+// a cache of the pool (the "cacheId" query parameter). If the "diagnostics" query parameter is set, it returns the
+// selection diagnostics of the provided sender or, if no sender is provided, of all the senders of the current shard
+func GetTransactionsPool(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	query := c.Request.URL.Query()
+	sender := query.Get(queryParamSender)
+	cacheID := query.Get(queryParamCacheID)
+	withDiagnostics, errDiagnostics := getQueryParamBool(c, queryParamDiagnostics)
+	page, errPage := getQueryParamUint32(c, queryParamPage)
+	pageSize, errPageSize := getQueryParamUint32(c, queryParamPageSize)
+	if errDiagnostics != nil || errPage != nil || errPageSize != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	isFilterValid := (sender == "") != (cacheID == "")
+	if withDiagnostics {
+		isFilterValid = cacheID == ""
+	}
+	if !isFilterValid {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationTransactionsPoolFilter.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	switch {
+	case withDiagnostics && sender != "":
+		diagnostics, err := facade.GetTransactionsPoolSenderDiagnostics(sender)
+		respondWithTransactionsPoolDiagnostics(c, diagnostics, err)
+	case withDiagnostics:
+		diagnostics, err := facade.GetTransactionsPoolSendersDiagnostics(page, pageSize)
+		respondWithTransactionsPoolDiagnostics(c, diagnostics, err)
+	case sender != "":
+		txsPool, err := facade.GetTransactionsPoolForSender(sender, page, pageSize)
+		respondWithTransactionsPool(c, txsPool, err)
+	default:
+		txsPool, err := facade.GetTransactionsPoolForCache(cacheID, page, pageSize)
+		respondWithTransactionsPool(c, txsPool, err)
+	}
+}
+
+func respondWithTransactionsPool(c *gin.Context, txsPool *api.TransactionsPool, err error) {
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPool.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txPool": txsPool},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithTransactionsPoolDiagnostics(c *gin.Context, diagnostics interface{}, err error) {
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsPoolDiagnostics.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"diagnostics": diagnostics},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamBool(c *gin.Context, name string) (bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(valueStr)
+}
+
+func getQueryParamUint32(c *gin.Context, name string) (uint32, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 32)
+	return uint32(value), err
+}
+
 func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	withResultsStr := c.Request.URL.Query().Get(queryParamWithResults)
 	if withResultsStr == "" {
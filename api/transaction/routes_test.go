@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	tr "github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -64,6 +65,36 @@ type sendSingleTxResponse struct {
 	Code  string                   `json:"code"`
 }
 
+type txPoolResponseData struct {
+	TxPool api.TransactionsPool `json:"txPool"`
+}
+
+type txPoolResponse struct {
+	Data  txPoolResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+type senderDiagnosticsResponseData struct {
+	Diagnostics api.SenderDiagnostics `json:"diagnostics"`
+}
+
+type senderDiagnosticsResponse struct {
+	Data  senderDiagnosticsResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
+type sendersDiagnosticsResponseData struct {
+	Diagnostics api.SendersDiagnostics `json:"diagnostics"`
+}
+
+type sendersDiagnosticsResponse struct {
+	Data  sendersDiagnosticsResponseData `json:"data"`
+	Error string                         `json:"error"`
+	Code  string                         `json:"code"`
+}
+
 type transactionCostResponseData struct {
 	Cost uint64 `json:"txGasUnits"`
 }
@@ -840,6 +871,287 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestGetTransactionsPool_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetTransactionsPool_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetTransactionsPool_InvalidPaginationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(_ string, _ uint32, _ uint32) (*api.TransactionsPool, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice&page=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetTransactionsPool_InvalidFiltersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	urls := []string{
+		"/transaction/pool",
+		"/transaction/pool?sender=alice&cacheId=0",
+		"/transaction/pool?cacheId=0&diagnostics=true",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := txPoolResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationTransactionsPoolFilter.Error()), url)
+	}
+}
+
+func TestGetTransactionsPool_BySenderFacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(_ string, _ uint32, _ uint32) (*api.TransactionsPool, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPool.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPool_BySenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPool := api.TransactionsPool{
+		CacheID:      "0",
+		Sender:       "alice",
+		NumTxs:       2,
+		NumNonceGaps: 1,
+		Page:         1,
+		PageSize:     10,
+		Transactions: []*api.PoolTransaction{
+			{Hash: "aa", Nonce: 5, NonceGap: 2},
+		},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolForSenderCalled: func(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+			assert.Equal(t, "alice", sender)
+			assert.Equal(t, uint32(1), page)
+			assert.Equal(t, uint32(10), pageSize)
+			return &expectedPool, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice&page=1&pageSize=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedPool, response.Data.TxPool)
+}
+
+func TestGetTransactionsPool_ByCacheShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPool := api.TransactionsPool{
+		CacheID:      "1_0",
+		NumTxs:       1,
+		PageSize:     100,
+		Transactions: []*api.PoolTransaction{{Hash: "bb", Nonce: 7}},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolForCacheCalled: func(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+			assert.Equal(t, "1_0", cacheID)
+			assert.Equal(t, uint32(0), page)
+			assert.Equal(t, uint32(0), pageSize)
+			return &expectedPool, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?cacheId=1_0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := txPoolResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedPool, response.Data.TxPool)
+}
+
+func TestGetTransactionsPool_SenderDiagnosticsFacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsPoolSenderDiagnosticsCalled: func(_ string) (*api.SenderDiagnostics, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=erd1alice&diagnostics=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := senderDiagnosticsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsPoolDiagnostics.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsPool_SenderDiagnosticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedDiagnostics := api.SenderDiagnostics{
+		Sender:              "erd1alice",
+		NumTxs:              2,
+		AccountNonce:        3,
+		IsAccountNonceKnown: true,
+		LowestTxNonce:       5,
+		NonceGap:            2,
+		NumFailedSelections: 4,
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolSenderDiagnosticsCalled: func(sender string) (*api.SenderDiagnostics, error) {
+			assert.Equal(t, "erd1alice", sender)
+			return &expectedDiagnostics, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=erd1alice&diagnostics=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := senderDiagnosticsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedDiagnostics, response.Data.Diagnostics)
+}
+
+func TestGetTransactionsPool_SendersDiagnosticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedDiagnostics := api.SendersDiagnostics{
+		CacheID:    "0",
+		NumSenders: 1,
+		Page:       1,
+		PageSize:   10,
+		Senders:    []*api.SenderDiagnostics{{Sender: "erd1bob", NonceGap: 6, NumFailedSelections: 1}},
+	}
+	facade := mock.Facade{
+		GetTransactionsPoolSendersDiagnosticsCalled: func(page uint32, pageSize uint32) (*api.SendersDiagnostics, error) {
+			assert.Equal(t, uint32(1), page)
+			assert.Equal(t, uint32(10), pageSize)
+			return &expectedDiagnostics, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?diagnostics=true&page=1&pageSize=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := sendersDiagnosticsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedDiagnostics, response.Data.Diagnostics)
+}
+
+func TestGetTransactionsPool_ClosedRouteShouldTreatPoolAsTxHash(t *testing.T) {
+	t.Parallel()
+
+	getTransactionCalled := false
+	facade := mock.Facade{
+		GetTransactionHandler: func(hash string, _ bool) (*tr.ApiTransactionResult, error) {
+			getTransactionCalled = true
+			assert.Equal(t, "pool", hash)
+			return nil, errors.New("not found")
+		},
+		GetTransactionsPoolForSenderCalled: func(_ string, _ uint32, _ uint32) (*api.TransactionsPool, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	routesConfig := getRoutesConfig()
+	transactionRoutes := routesConfig.APIPackages["transaction"]
+	transactionRoutes.Routes = []config.RouteConfig{{Name: "/:txhash", Open: true}}
+	routesConfig.APIPackages["transaction"] = transactionRoutes
+
+	ws := gin.New()
+	ginTransactionRoute := ws.Group("/transaction")
+	ginTransactionRoute.Use(middleware.WithFacade(&facade))
+	transactionRoute, _ := wrapper.NewRouterWrapper("transaction", ginTransactionRoute, routesConfig)
+	transaction.Routes(transactionRoute)
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?sender=alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.True(t, getTransactionCalled)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -889,6 +1201,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/pool", Open: true},
 				},
 			},
 		},
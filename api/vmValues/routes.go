@@ -30,12 +30,15 @@ type FacadeHandler interface {
 }
 
 // VMValueRequest represents the structure on which user input for generating a new transaction will validate against
+// The optional block nonce or block hash pin the query to the state at that block
 type VMValueRequest struct {
 	ScAddress  string   `form:"scAddress" json:"scAddress"`
 	FuncName   string   `form:"funcName" json:"funcName"`
 	CallerAddr string   `form:"caller" json:"caller"`
 	CallValue  string   `form:"value" json:"value"`
 	Args       []string `form:"args"  json:"args"`
+	BlockNonce *uint64  `form:"blockNonce" json:"blockNonce,omitempty"`
+	BlockHash  string   `form:"blockHash" json:"blockHash,omitempty"`
 }
 
 // Routes defines address related routes
@@ -163,6 +166,24 @@ func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery,
 		scQuery.CallValue = callValue
 	}
 
+	if request.BlockNonce != nil && len(request.BlockHash) > 0 {
+		return nil, errors.ErrValidationBlockNonceAndHash
+	}
+
+	if request.BlockNonce != nil {
+		scQuery.BlockNonce = *request.BlockNonce
+		scQuery.HasBlockNonce = true
+	}
+
+	if len(request.BlockHash) > 0 {
+		blockHash, err := hex.DecodeString(request.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, err.Error())
+		}
+
+		scQuery.BlockHash = blockHash
+	}
+
 	return scQuery, nil
 }
 
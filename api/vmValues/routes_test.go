@@ -162,6 +162,98 @@ func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	require.Contains(t, err.Error(), "'bad arg' is not a valid hex string")
 }
 
+func TestQuery_WithBlockNonceShouldForwardItToTheQuery(t *testing.T) {
+	t.Parallel()
+
+	var providedQuery *process.SCQuery
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			providedQuery = query
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	blockNonce := uint64(0)
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.True(t, providedQuery.HasBlockNonce)
+	require.Equal(t, blockNonce, providedQuery.BlockNonce)
+	require.Nil(t, providedQuery.BlockHash)
+}
+
+func TestQuery_WithBlockHashShouldForwardItToTheQuery(t *testing.T) {
+	t.Parallel()
+
+	var providedQuery *process.SCQuery
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			providedQuery = query
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	blockHash := []byte("block hash")
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		BlockHash: hex.EncodeToString(blockHash),
+	}
+
+	response := vmOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.False(t, providedQuery.HasBlockNonce)
+	require.Equal(t, blockHash, providedQuery.BlockHash)
+}
+
+func TestAllRoutes_WhenBlockNonceAndBlockHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	blockNonce := uint64(7)
+	request := VMValueRequest{
+		ScAddress:  DummyScAddress,
+		FuncName:   "function",
+		BlockNonce: &blockNonce,
+		BlockHash:  "aabb",
+	}
+
+	requireErrorOnAllRoutes(t, &facade, request, apiErrors.ErrValidationBlockNonceAndHash)
+}
+
+func TestAllRoutes_WhenBadBlockHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("not a valid block hash")
+	facade := mock.Facade{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			return &vm.VMOutputApi{}, nil
+		},
+	}
+
+	request := VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		BlockHash: "ZZ",
+	}
+
+	requireErrorOnAllRoutes(t, &facade, request, errExpected)
+}
+
 func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,517 @@
+package checker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("checker")
+
+// ArgsDBChecker holds the arguments needed for creating a new databases checker
+type ArgsDBChecker struct {
+	GeneralConfig             config.Config
+	DBOpener                  DBOpener
+	LatestStorageDataProvider storage.LatestStorageDataProviderHandler
+	TrieVerifier              TrieVerifier
+	Marshalizer               marshal.Marshalizer
+	Hasher                    hashing.Hasher
+	DefaultEpochString        string
+	DefaultStaticString       string
+	NumTrieEpochs             uint32
+	Compact                   bool
+}
+
+type persisterKey struct {
+	filePath string
+	shardID  uint32
+	epoch    uint32
+}
+
+type dbChecker struct {
+	generalConfig             config.Config
+	dbOpener                  DBOpener
+	latestStorageDataProvider storage.LatestStorageDataProviderHandler
+	trieVerifier              TrieVerifier
+	marshalizer               marshal.Marshalizer
+	hasher                    hashing.Hasher
+	defaultEpochString        string
+	defaultStaticString       string
+	numTrieEpochs             uint32
+	compact                   bool
+	emptyReceiptsHash         []byte
+	persisters                map[persisterKey]storage.Persister
+}
+
+// NewDBChecker creates a component which walks the node databases, epoch by epoch, and checks their consistency
+func NewDBChecker(args ArgsDBChecker) (*dbChecker, error) {
+	if check.IfNil(args.DBOpener) {
+		return nil, ErrNilDBOpener
+	}
+	if check.IfNil(args.LatestStorageDataProvider) {
+		return nil, ErrNilLatestStorageDataProvider
+	}
+	if check.IfNil(args.TrieVerifier) {
+		return nil, ErrNilTrieVerifier
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	emptyReceiptsHash, err := core.CalculateHash(args.Marshalizer, args.Hasher, &batch.Batch{Data: make([][]byte, 0)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbChecker{
+		generalConfig:             args.GeneralConfig,
+		dbOpener:                  args.DBOpener,
+		latestStorageDataProvider: args.LatestStorageDataProvider,
+		trieVerifier:              args.TrieVerifier,
+		marshalizer:               args.Marshalizer,
+		hasher:                    args.Hasher,
+		defaultEpochString:        args.DefaultEpochString,
+		defaultStaticString:       args.DefaultStaticString,
+		numTrieEpochs:             args.NumTrieEpochs,
+		compact:                   args.Compact,
+		emptyReceiptsHash:         emptyReceiptsHash,
+		persisters:                make(map[persisterKey]storage.Persister),
+	}, nil
+}
+
+// Check walks the headers of each epoch and shard found on disk, together with their miniblocks, transactions and
+// receipts, recomputing their hashes. The state tries of the last epochs are checked to be fully loadable and, if
+// enabled, the databases are compacted at the end
+func (dc *dbChecker) Check() (*Report, error) {
+	parentDir, lastEpoch, err := dc.latestStorageDataProvider.GetParentDirAndLastEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Issues: make([]*Issue, 0),
+	}
+	shardsInEpochs := make(map[uint32][]uint32)
+	for epoch := uint32(0); epoch <= lastEpoch; epoch++ {
+		shardIDs := dc.getShardsInEpoch(parentDir, epoch)
+		shardsInEpochs[epoch] = shardIDs
+
+		for _, shardID := range shardIDs {
+			lastHeader := dc.checkHeaders(epoch, shardID, report)
+			if dc.shouldVerifyTries(epoch, lastEpoch) && !check.IfNil(lastHeader) {
+				dc.verifyTries(lastHeader, epoch, shardID, report)
+			}
+		}
+
+		// the next epochs only look back one epoch for the data written around the epoch change
+		dc.closePersisters(func(key persisterKey) bool {
+			return key.epoch+1 < epoch
+		})
+	}
+
+	dc.closePersisters(func(_ persisterKey) bool {
+		return true
+	})
+
+	err = dc.trieVerifier.Close()
+	if err != nil {
+		log.Warn("dbChecker: cannot close the tries", "error", err.Error())
+	}
+
+	if dc.compact {
+		dc.compactDBs(shardsInEpochs, report)
+	}
+
+	return report, nil
+}
+
+func (dc *dbChecker) getShardsInEpoch(parentDir string, epoch uint32) []uint32 {
+	pathWithoutShard := filepath.Join(parentDir, fmt.Sprintf("%s_%d", dc.defaultEpochString, epoch))
+	shardIDsStr, err := dc.latestStorageDataProvider.GetShardsFromDirectory(pathWithoutShard)
+	if err != nil {
+		log.Debug("dbChecker: no databases found for epoch", "epoch", epoch, "error", err.Error())
+		return nil
+	}
+
+	shardIDs := make([]uint32, 0, len(shardIDsStr))
+	for _, shardIDStr := range shardIDsStr {
+		shardID, errConvert := core.ConvertShardIDToUint32(shardIDStr)
+		if errConvert != nil {
+			log.Warn("dbChecker: invalid shard directory", "epoch", epoch, "shard", shardIDStr)
+			continue
+		}
+
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return shardIDs
+}
+
+// checkHeaders checks the self headers stored in the provided epoch and shard and returns the one having the highest
+// nonce, if any
+func (dc *dbChecker) checkHeaders(epoch uint32, shardID uint32, report *Report) data.HeaderHandler {
+	headersConfig := dc.getHeadersDBConfig(shardID)
+	persister, err := dc.getPersister(headersConfig, shardID, epoch)
+	if err != nil {
+		report.addIssue(epoch, shardID, headersConfig.FilePath, nil, err.Error())
+		return nil
+	}
+
+	var lastHeader data.HeaderHandler
+	persister.RangeKeys(func(key []byte, value []byte) bool {
+		report.NumHeaders++
+		if !bytes.Equal(dc.hasher.Compute(string(value)), key) {
+			report.addIssue(epoch, shardID, headersConfig.FilePath, key, "header hash mismatch")
+		}
+
+		header, errUnmarshal := dc.unmarshalHeader(shardID, value)
+		if errUnmarshal != nil {
+			report.addIssue(epoch, shardID, headersConfig.FilePath, key, errUnmarshal.Error())
+			return true
+		}
+		if header.GetShardID() != shardID {
+			return true
+		}
+
+		dc.checkBody(header, epoch, shardID, report)
+		dc.checkReceipts(header, epoch, shardID, report)
+
+		if check.IfNil(lastHeader) || header.GetNonce() > lastHeader.GetNonce() {
+			lastHeader = header
+		}
+
+		return true
+	})
+
+	return lastHeader
+}
+
+func (dc *dbChecker) checkBody(header data.HeaderHandler, epoch uint32, shardID uint32, report *Report) {
+	miniBlocksConfig := dc.generalConfig.MiniBlocksStorage.DB
+	for _, miniBlockHeader := range getMiniBlockHeaders(header) {
+		report.NumMiniBlocks++
+
+		buff, err := dc.getFromEpochs(miniBlocksConfig, shardID, epoch, miniBlockHeader.Hash)
+		if err != nil {
+			report.addIssue(epoch, shardID, miniBlocksConfig.FilePath, miniBlockHeader.Hash, "miniblock not found")
+			continue
+		}
+		if !bytes.Equal(dc.hasher.Compute(string(buff)), miniBlockHeader.Hash) {
+			report.addIssue(epoch, shardID, miniBlocksConfig.FilePath, miniBlockHeader.Hash, "miniblock hash mismatch")
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = dc.marshalizer.Unmarshal(miniBlock, buff)
+		if err != nil {
+			report.addIssue(epoch, shardID, miniBlocksConfig.FilePath, miniBlockHeader.Hash, err.Error())
+			continue
+		}
+		if uint32(len(miniBlock.TxHashes)) != miniBlockHeader.TxCount {
+			report.addIssue(epoch, shardID, miniBlocksConfig.FilePath, miniBlockHeader.Hash,
+				fmt.Sprintf("miniblock holds %d transactions, the header declares %d", len(miniBlock.TxHashes), miniBlockHeader.TxCount))
+		}
+
+		dc.checkTransactions(miniBlock, epoch, shardID, report)
+	}
+}
+
+func (dc *dbChecker) checkTransactions(miniBlock *block.MiniBlock, epoch uint32, shardID uint32, report *Report) {
+	txsConfig, ok := dc.getTransactionsDBConfig(miniBlock.Type)
+	if !ok {
+		return
+	}
+
+	for _, txHash := range miniBlock.TxHashes {
+		report.NumTransactions++
+
+		buff, err := dc.getFromEpochs(txsConfig, shardID, epoch, txHash)
+		if err != nil {
+			report.addIssue(epoch, shardID, txsConfig.FilePath, txHash, "transaction not found")
+			continue
+		}
+		if !bytes.Equal(dc.hasher.Compute(string(buff)), txHash) {
+			report.addIssue(epoch, shardID, txsConfig.FilePath, txHash, "transaction hash mismatch")
+		}
+	}
+}
+
+// checkReceipts recomputes the receipts hash out of the stored receipts miniblocks. Nothing is stored when the block
+// did not produce receipts, case in which the header holds the hash of an empty batch
+func (dc *dbChecker) checkReceipts(header data.HeaderHandler, epoch uint32, shardID uint32, report *Report) {
+	receiptsHash := header.GetReceiptsHash()
+	if len(receiptsHash) == 0 || bytes.Equal(receiptsHash, dc.emptyReceiptsHash) {
+		return
+	}
+
+	receiptsConfig := dc.generalConfig.ReceiptsStorage.DB
+	report.NumReceipts++
+
+	buff, err := dc.getFromEpochs(receiptsConfig, shardID, epoch, receiptsHash)
+	if err != nil {
+		report.addIssue(epoch, shardID, receiptsConfig.FilePath, receiptsHash, "receipts not found")
+		return
+	}
+
+	receipts := &batch.Batch{}
+	err = dc.marshalizer.Unmarshal(receipts, buff)
+	if err != nil {
+		report.addIssue(epoch, shardID, receiptsConfig.FilePath, receiptsHash, err.Error())
+		return
+	}
+
+	miniBlocksHashes := make([][]byte, 0, len(receipts.Data))
+	for _, marshalizedMiniBlock := range receipts.Data {
+		miniBlocksHashes = append(miniBlocksHashes, dc.hasher.Compute(string(marshalizedMiniBlock)))
+	}
+
+	computedHash, err := core.CalculateHash(dc.marshalizer, dc.hasher, &batch.Batch{Data: miniBlocksHashes})
+	if err != nil {
+		report.addIssue(epoch, shardID, receiptsConfig.FilePath, receiptsHash, err.Error())
+		return
+	}
+	if !bytes.Equal(computedHash, receiptsHash) {
+		report.addIssue(epoch, shardID, receiptsConfig.FilePath, receiptsHash, "receipts hash mismatch")
+	}
+}
+
+func (dc *dbChecker) shouldVerifyTries(epoch uint32, lastEpoch uint32) bool {
+	return uint64(epoch)+uint64(dc.numTrieEpochs) > uint64(lastEpoch)
+}
+
+func (dc *dbChecker) verifyTries(header data.HeaderHandler, epoch uint32, shardID uint32, report *Report) {
+	dc.verifyTrie(dc.generalConfig.AccountsTrieStorage, header.GetRootHash(), epoch, shardID, report)
+	if shardID == core.MetachainShardId {
+		dc.verifyTrie(dc.generalConfig.PeerAccountsTrieStorage, header.GetValidatorStatsRootHash(), epoch, shardID, report)
+	}
+}
+
+func (dc *dbChecker) verifyTrie(
+	storageConfig config.StorageConfig,
+	rootHash []byte,
+	epoch uint32,
+	shardID uint32,
+	report *Report,
+) {
+	report.NumTries++
+
+	err := dc.trieVerifier.VerifyTrie(storageConfig, shardID, rootHash)
+	if err != nil {
+		report.addIssue(epoch, shardID, storageConfig.DB.FilePath, rootHash, err.Error())
+	}
+}
+
+func (dc *dbChecker) compactDBs(shardsInEpochs map[uint32][]uint32, report *Report) {
+	staticShards := make(map[uint32]struct{})
+	for epoch := uint32(0); epoch < uint32(len(shardsInEpochs)); epoch++ {
+		for _, shardID := range shardsInEpochs[epoch] {
+			staticShards[shardID] = struct{}{}
+			for _, dbConfig := range dc.getEpochDBConfigs() {
+				persister, err := dc.dbOpener.OpenDB(dbConfig, shardID, epoch)
+				dc.compactPersister(persister, err, dbConfig, epoch, shardID, report)
+			}
+		}
+	}
+
+	for shardID := range staticShards {
+		for _, dbConfig := range dc.getStaticDBConfigs(shardID) {
+			persister, err := dc.dbOpener.OpenStaticDB(dbConfig, shardID, dc.defaultStaticString)
+			dc.compactPersister(persister, err, dbConfig, 0, shardID, report)
+		}
+	}
+}
+
+func (dc *dbChecker) compactPersister(
+	persister storage.Persister,
+	errOpen error,
+	dbConfig config.DBConfig,
+	epoch uint32,
+	shardID uint32,
+	report *Report,
+) {
+	if errors.Is(errOpen, storage.ErrDBNotFound) {
+		return
+	}
+	if errOpen != nil {
+		report.addIssue(epoch, shardID, dbConfig.FilePath, nil, errOpen.Error())
+		return
+	}
+	defer func() {
+		errClose := persister.Close()
+		if errClose != nil {
+			log.Warn("dbChecker: cannot close database", "path", dbConfig.FilePath, "error", errClose.Error())
+		}
+	}()
+
+	compactor, ok := persister.(storage.Compactor)
+	if !ok {
+		log.Debug("dbChecker: database type does not support compaction", "path", dbConfig.FilePath, "type", dbConfig.Type)
+		return
+	}
+
+	err := compactor.Compact()
+	if err != nil {
+		report.addIssue(epoch, shardID, dbConfig.FilePath, nil, fmt.Sprintf("compaction failed: %s", err.Error()))
+		return
+	}
+
+	report.NumCompactedDBs++
+	log.Debug("dbChecker: database compacted", "epoch", epoch, "shard", core.GetShardIDString(shardID), "path", dbConfig.FilePath)
+}
+
+// getFromEpochs reads the key from the provided epoch and, as the data of the blocks around an epoch change might be
+// written in the neighbouring epoch, falls back to the next and to the previous epoch
+func (dc *dbChecker) getFromEpochs(dbConfig config.DBConfig, shardID uint32, epoch uint32, key []byte) ([]byte, error) {
+	epochs := []uint32{epoch, epoch + 1}
+	if epoch > 0 {
+		epochs = append(epochs, epoch-1)
+	}
+
+	var lastErr error
+	for _, e := range epochs {
+		persister, err := dc.getPersister(dbConfig, shardID, e)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		buff, err := persister.Get(key)
+		if err == nil {
+			return buff, nil
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+func (dc *dbChecker) getPersister(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error) {
+	key := persisterKey{
+		filePath: dbConfig.FilePath,
+		shardID:  shardID,
+		epoch:    epoch,
+	}
+	persister, ok := dc.persisters[key]
+	if ok {
+		if persister == nil {
+			return nil, fmt.Errorf("%w: %s", storage.ErrDBNotFound, dbConfig.FilePath)
+		}
+		return persister, nil
+	}
+
+	persister, err := dc.dbOpener.OpenDB(dbConfig, shardID, epoch)
+	if err != nil {
+		// remember the missing databases, too, in order to not retry opening them on each read
+		dc.persisters[key] = nil
+		return nil, err
+	}
+
+	dc.persisters[key] = persister
+
+	return persister, nil
+}
+
+func (dc *dbChecker) closePersisters(shouldClose func(key persisterKey) bool) {
+	for key, persister := range dc.persisters {
+		if !shouldClose(key) {
+			continue
+		}
+
+		delete(dc.persisters, key)
+		if persister == nil {
+			continue
+		}
+
+		err := persister.Close()
+		if err != nil {
+			log.Warn("dbChecker: cannot close database", "path", key.filePath, "epoch", key.epoch, "error", err.Error())
+		}
+	}
+}
+
+func (dc *dbChecker) unmarshalHeader(shardID uint32, buff []byte) (data.HeaderHandler, error) {
+	if shardID == core.MetachainShardId {
+		header := &block.MetaBlock{}
+		err := dc.marshalizer.Unmarshal(header, buff)
+		return header, err
+	}
+
+	header := &block.Header{}
+	err := dc.marshalizer.Unmarshal(header, buff)
+	return header, err
+}
+
+func (dc *dbChecker) getHeadersDBConfig(shardID uint32) config.DBConfig {
+	if shardID == core.MetachainShardId {
+		return dc.generalConfig.MetaBlockStorage.DB
+	}
+
+	return dc.generalConfig.BlockHeaderStorage.DB
+}
+
+func (dc *dbChecker) getTransactionsDBConfig(miniBlockType block.Type) (config.DBConfig, bool) {
+	switch miniBlockType {
+	case block.TxBlock:
+		return dc.generalConfig.TxStorage.DB, true
+	case block.SmartContractResultBlock:
+		return dc.generalConfig.UnsignedTransactionStorage.DB, true
+	case block.RewardsBlock:
+		return dc.generalConfig.RewardTxStorage.DB, true
+	default:
+		return config.DBConfig{}, false
+	}
+}
+
+func (dc *dbChecker) getEpochDBConfigs() []config.DBConfig {
+	return []config.DBConfig{
+		dc.generalConfig.BlockHeaderStorage.DB,
+		dc.generalConfig.MetaBlockStorage.DB,
+		dc.generalConfig.MiniBlocksStorage.DB,
+		dc.generalConfig.PeerBlockBodyStorage.DB,
+		dc.generalConfig.TxStorage.DB,
+		dc.generalConfig.UnsignedTransactionStorage.DB,
+		dc.generalConfig.RewardTxStorage.DB,
+		dc.generalConfig.ReceiptsStorage.DB,
+		dc.generalConfig.MetaHdrNonceHashStorage.DB,
+		dc.generalConfig.BootstrapStorage.DB,
+	}
+}
+
+func (dc *dbChecker) getStaticDBConfigs(shardID uint32) []config.DBConfig {
+	dbConfigs := []config.DBConfig{dc.generalConfig.AccountsTrieStorage.DB}
+	if shardID == core.MetachainShardId {
+		dbConfigs = append(dbConfigs, dc.generalConfig.PeerAccountsTrieStorage.DB)
+	}
+
+	return dbConfigs
+}
+
+func getMiniBlockHeaders(header data.HeaderHandler) []block.MiniBlockHeader {
+	switch h := header.(type) {
+	case *block.Header:
+		return h.MiniBlockHeaders
+	case *block.MetaBlock:
+		return h.MiniBlockHeaders
+	default:
+		return nil
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dc *dbChecker) IsInterfaceNil() bool {
+	return dc == nil
+}
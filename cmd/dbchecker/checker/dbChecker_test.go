@@ -0,0 +1,382 @@
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbchecker/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	storageMock "github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type compactingPersister struct {
+	*memorydb.DB
+	numCompactCalls int
+}
+
+func (cp *compactingPersister) Compact() error {
+	cp.numCompactCalls++
+	return nil
+}
+
+type testDatabases struct {
+	persisters map[string]storage.Persister
+}
+
+func newTestDatabases() *testDatabases {
+	return &testDatabases{
+		persisters: make(map[string]storage.Persister),
+	}
+}
+
+func (td *testDatabases) get(dbConfig config.DBConfig, shardID uint32, epoch uint32) storage.Persister {
+	key := fmt.Sprintf("%s_%d_%d", dbConfig.FilePath, shardID, epoch)
+	persister, ok := td.persisters[key]
+	if !ok {
+		persister = &compactingPersister{DB: memorydb.New()}
+		td.persisters[key] = persister
+	}
+
+	return persister
+}
+
+func (td *testDatabases) open(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error) {
+	persister, ok := td.persisters[fmt.Sprintf("%s_%d_%d", dbConfig.FilePath, shardID, epoch)]
+	if !ok {
+		return nil, storage.ErrDBNotFound
+	}
+
+	return persister, nil
+}
+
+func createTestGeneralConfig() config.Config {
+	return config.Config{
+		BlockHeaderStorage:         config.StorageConfig{DB: config.DBConfig{FilePath: "BlockHeaders"}},
+		MetaBlockStorage:           config.StorageConfig{DB: config.DBConfig{FilePath: "MetaBlock"}},
+		MiniBlocksStorage:          config.StorageConfig{DB: config.DBConfig{FilePath: "MiniBlocks"}},
+		TxStorage:                  config.StorageConfig{DB: config.DBConfig{FilePath: "Transactions"}},
+		UnsignedTransactionStorage: config.StorageConfig{DB: config.DBConfig{FilePath: "UnsignedTransactions"}},
+		RewardTxStorage:            config.StorageConfig{DB: config.DBConfig{FilePath: "RewardTransactions"}},
+		ReceiptsStorage:            config.StorageConfig{DB: config.DBConfig{FilePath: "Receipts"}},
+		AccountsTrieStorage:        config.StorageConfig{DB: config.DBConfig{FilePath: "AccountsTrie"}},
+		PeerAccountsTrieStorage:    config.StorageConfig{DB: config.DBConfig{FilePath: "PeerAccountsTrie"}},
+	}
+}
+
+func createMockArgsDBChecker(databases *testDatabases, lastEpoch uint32) ArgsDBChecker {
+	return ArgsDBChecker{
+		GeneralConfig: createTestGeneralConfig(),
+		DBOpener: &mock.DBOpenerStub{
+			OpenDBCalled: databases.open,
+		},
+		LatestStorageDataProvider: &storageMock.LatestStorageDataProviderStub{
+			GetParentDirAndLastEpochCalled: func() (string, uint32, error) {
+				return "db", lastEpoch, nil
+			},
+			GetShardsFromDirectoryCalled: func(path string) ([]string, error) {
+				return []string{"0"}, nil
+			},
+		},
+		TrieVerifier:        &mock.TrieVerifierStub{},
+		Marshalizer:         &marshal.GogoProtoMarshalizer{},
+		Hasher:              &blake2b.Blake2b{},
+		DefaultEpochString:  "Epoch",
+		DefaultStaticString: "Static",
+		NumTrieEpochs:       1,
+	}
+}
+
+func putMarshalized(t *testing.T, args ArgsDBChecker, persister storage.Persister, obj interface{}) []byte {
+	buff, err := args.Marshalizer.Marshal(obj)
+	require.Nil(t, err)
+
+	hash := args.Hasher.Compute(string(buff))
+	err = persister.Put(hash, buff)
+	require.Nil(t, err)
+
+	return hash
+}
+
+// saveBlock stores, in the provided epoch, a shard 0 block having a transactions miniblock with two transactions
+// and the receipts. It returns the hashes of the miniblock and of the transactions
+func saveBlock(t *testing.T, args ArgsDBChecker, databases *testDatabases, epoch uint32, nonce uint64) ([]byte, [][]byte) {
+	cfg := args.GeneralConfig
+	txsDB := databases.get(cfg.TxStorage.DB, 0, epoch)
+	txHashes := [][]byte{
+		putMarshalized(t, args, txsDB, &transaction.Transaction{Nonce: nonce}),
+		putMarshalized(t, args, txsDB, &transaction.Transaction{Nonce: nonce + 1}),
+	}
+
+	miniBlock := &block.MiniBlock{TxHashes: txHashes, Type: block.TxBlock}
+	miniBlockHash := putMarshalized(t, args, databases.get(cfg.MiniBlocksStorage.DB, 0, epoch), miniBlock)
+
+	receiptsMiniBlock, err := args.Marshalizer.Marshal(&block.MiniBlock{TxHashes: [][]byte{[]byte("receipt")}, Type: block.ReceiptBlock})
+	require.Nil(t, err)
+	receiptsHash, err := core.CalculateHash(args.Marshalizer, args.Hasher, &batch.Batch{Data: [][]byte{args.Hasher.Compute(string(receiptsMiniBlock))}})
+	require.Nil(t, err)
+	receipts, err := args.Marshalizer.Marshal(&batch.Batch{Data: [][]byte{receiptsMiniBlock}})
+	require.Nil(t, err)
+	err = databases.get(cfg.ReceiptsStorage.DB, 0, epoch).Put(receiptsHash, receipts)
+	require.Nil(t, err)
+
+	header := &block.Header{
+		Nonce:        nonce,
+		Epoch:        epoch,
+		RootHash:     []byte(fmt.Sprintf("root hash %d", nonce)),
+		ReceiptsHash: receiptsHash,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: miniBlockHash, TxCount: uint32(len(txHashes)), Type: block.TxBlock},
+		},
+	}
+	_ = putMarshalized(t, args, databases.get(cfg.BlockHeaderStorage.DB, 0, epoch), header)
+
+	return miniBlockHash, txHashes
+}
+
+func TestNewDBChecker_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDBChecker(newTestDatabases(), 0)
+	args.DBOpener = nil
+	dc, err := NewDBChecker(args)
+	assert.True(t, check.IfNil(dc))
+	assert.Equal(t, ErrNilDBOpener, err)
+
+	args = createMockArgsDBChecker(newTestDatabases(), 0)
+	args.LatestStorageDataProvider = nil
+	_, err = NewDBChecker(args)
+	assert.Equal(t, ErrNilLatestStorageDataProvider, err)
+
+	args = createMockArgsDBChecker(newTestDatabases(), 0)
+	args.TrieVerifier = nil
+	_, err = NewDBChecker(args)
+	assert.Equal(t, ErrNilTrieVerifier, err)
+
+	args = createMockArgsDBChecker(newTestDatabases(), 0)
+	args.Marshalizer = nil
+	_, err = NewDBChecker(args)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgsDBChecker(newTestDatabases(), 0)
+	args.Hasher = nil
+	_, err = NewDBChecker(args)
+	assert.Equal(t, ErrNilHasher, err)
+}
+
+func TestNewDBChecker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dc, err := NewDBChecker(createMockArgsDBChecker(newTestDatabases(), 0))
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(dc))
+}
+
+func TestDbChecker_CheckLatestDataProviderErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsDBChecker(newTestDatabases(), 0)
+	args.LatestStorageDataProvider = &storageMock.LatestStorageDataProviderStub{
+		GetParentDirAndLastEpochCalled: func() (string, uint32, error) {
+			return "", 0, expectedErr
+		},
+	}
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	assert.Nil(t, report)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestDbChecker_CheckConsistentDatabasesShouldNotReportIssues(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 1)
+	_, _ = saveBlock(t, args, databases, 0, 1)
+	_, _ = saveBlock(t, args, databases, 1, 10)
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	assert.False(t, report.HasIssues())
+	assert.Equal(t, 2, report.NumHeaders)
+	assert.Equal(t, 2, report.NumMiniBlocks)
+	assert.Equal(t, 4, report.NumTransactions)
+	assert.Equal(t, 2, report.NumReceipts)
+	assert.Equal(t, 1, report.NumTries)
+	assert.Equal(t, 0, report.NumCompactedDBs)
+}
+
+func TestDbChecker_CheckShouldReportTheMissingTransactions(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 0)
+	_, txHashes := saveBlock(t, args, databases, 0, 1)
+
+	txsConfig := args.GeneralConfig.TxStorage.DB
+	_ = databases.get(txsConfig, 0, 0).Remove(txHashes[0])
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Issues))
+	assert.Equal(t, uint32(0), report.Issues[0].Epoch)
+	assert.Equal(t, uint32(0), report.Issues[0].ShardID)
+	assert.Equal(t, txsConfig.FilePath, report.Issues[0].Unit)
+	assert.Equal(t, txHashes[0], report.Issues[0].Key)
+	assert.Equal(t, "transaction not found", report.Issues[0].Message)
+}
+
+func TestDbChecker_CheckShouldReportTheHeaderHashMismatch(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 0)
+	headersConfig := args.GeneralConfig.BlockHeaderStorage.DB
+	headerBuff, err := args.Marshalizer.Marshal(&block.Header{Nonce: 1})
+	require.Nil(t, err)
+	_ = databases.get(headersConfig, 0, 0).Put([]byte("header hash"), headerBuff)
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Issues))
+	assert.Equal(t, headersConfig.FilePath, report.Issues[0].Unit)
+	assert.Equal(t, []byte("header hash"), report.Issues[0].Key)
+	assert.Equal(t, "header hash mismatch", report.Issues[0].Message)
+}
+
+func TestDbChecker_CheckCorruptedMiniBlockShouldReportIssue(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 0)
+	miniBlockHash, _ := saveBlock(t, args, databases, 0, 1)
+
+	miniBlockBuff, err := args.Marshalizer.Marshal(&block.MiniBlock{Type: block.TxBlock})
+	require.Nil(t, err)
+	_ = databases.get(args.GeneralConfig.MiniBlocksStorage.DB, 0, 0).Put(miniBlockHash, miniBlockBuff)
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(report.Issues))
+	assert.Equal(t, "miniblock hash mismatch", report.Issues[0].Message)
+	assert.Equal(t, "miniblock holds 0 transactions, the header declares 2", report.Issues[1].Message)
+}
+
+func TestDbChecker_CheckShouldFindTheDataWrittenInTheNextEpoch(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 1)
+	_, txHashes := saveBlock(t, args, databases, 0, 1)
+
+	txsConfig := args.GeneralConfig.TxStorage.DB
+	buff, _ := databases.get(txsConfig, 0, 0).Get(txHashes[0])
+	_ = databases.get(txsConfig, 0, 0).Remove(txHashes[0])
+	_ = databases.get(txsConfig, 0, 1).Put(txHashes[0], buff)
+	_ = databases.get(args.GeneralConfig.BlockHeaderStorage.DB, 0, 1)
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	assert.False(t, report.HasIssues())
+}
+
+func TestDbChecker_CheckShouldReportTheMissingReceipts(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 0)
+	_, _ = saveBlock(t, args, databases, 0, 1)
+	databases.persisters[fmt.Sprintf("%s_0_0", args.GeneralConfig.ReceiptsStorage.DB.FilePath)] = memorydb.New()
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Issues))
+	assert.Equal(t, args.GeneralConfig.ReceiptsStorage.DB.FilePath, report.Issues[0].Unit)
+	assert.Equal(t, "receipts not found", report.Issues[0].Message)
+}
+
+func TestDbChecker_CheckShouldVerifyTheTrieOfTheLastHeaderInTheLastEpochs(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 2)
+	_, _ = saveBlock(t, args, databases, 0, 1)
+	_, _ = saveBlock(t, args, databases, 1, 10)
+	_, _ = saveBlock(t, args, databases, 1, 11)
+	_, _ = saveBlock(t, args, databases, 2, 20)
+
+	expectedErr := errors.New("missing trie node")
+	verifiedRootHashes := make([]string, 0)
+	args.NumTrieEpochs = 2
+	args.TrieVerifier = &mock.TrieVerifierStub{
+		VerifyTrieCalled: func(storageConfig config.StorageConfig, shardID uint32, rootHash []byte) error {
+			assert.Equal(t, args.GeneralConfig.AccountsTrieStorage, storageConfig)
+			verifiedRootHashes = append(verifiedRootHashes, string(rootHash))
+			if string(rootHash) == "root hash 20" {
+				return expectedErr
+			}
+
+			return nil
+		},
+	}
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"root hash 11", "root hash 20"}, verifiedRootHashes)
+	assert.Equal(t, 2, report.NumTries)
+	require.Equal(t, 1, len(report.Issues))
+	assert.Equal(t, []byte("root hash 20"), report.Issues[0].Key)
+	assert.Equal(t, expectedErr.Error(), report.Issues[0].Message)
+}
+
+func TestDbChecker_CheckWithCompactionShouldCompactTheDatabases(t *testing.T) {
+	t.Parallel()
+
+	databases := newTestDatabases()
+	args := createMockArgsDBChecker(databases, 0)
+	args.Compact = true
+	_, _ = saveBlock(t, args, databases, 0, 1)
+
+	trieDB := &compactingPersister{DB: memorydb.New()}
+	args.DBOpener = &mock.DBOpenerStub{
+		OpenDBCalled: databases.open,
+		OpenStaticDBCalled: func(dbConfig config.DBConfig, shardID uint32, staticString string) (storage.Persister, error) {
+			assert.Equal(t, args.DefaultStaticString, staticString)
+			if dbConfig.FilePath == args.GeneralConfig.AccountsTrieStorage.DB.FilePath {
+				return trieDB, nil
+			}
+
+			return nil, storage.ErrDBNotFound
+		},
+	}
+	dc, _ := NewDBChecker(args)
+
+	report, err := dc.Check()
+	require.Nil(t, err)
+	assert.False(t, report.HasIssues())
+	assert.Equal(t, len(databases.persisters)+1, report.NumCompactedDBs)
+	assert.Equal(t, 1, trieDB.numCompactCalls)
+	for _, persister := range databases.persisters {
+		assert.Equal(t, 1, persister.(*compactingPersister).numCompactCalls)
+	}
+}
@@ -0,0 +1,21 @@
+package checker
+
+import "errors"
+
+// ErrNilDBOpener signals that a nil databases opener has been provided
+var ErrNilDBOpener = errors.New("nil databases opener")
+
+// ErrNilLatestStorageDataProvider signals that a nil latest storage data provider has been provided
+var ErrNilLatestStorageDataProvider = errors.New("nil latest storage data provider")
+
+// ErrNilTrieVerifier signals that a nil trie verifier has been provided
+var ErrNilTrieVerifier = errors.New("nil trie verifier")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPathManager signals that a nil path manager has been provided
+var ErrNilPathManager = errors.New("nil path manager")
@@ -0,0 +1,20 @@
+package checker
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// DBOpener defines the actions of the component opening the existing node databases
+type DBOpener interface {
+	OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error)
+	OpenStaticDB(dbConfig config.DBConfig, shardID uint32, staticString string) (storage.Persister, error)
+	IsInterfaceNil() bool
+}
+
+// TrieVerifier defines the actions of the component checking that a trie can be fully loaded from its storage
+type TrieVerifier interface {
+	VerifyTrie(storageConfig config.StorageConfig, shardID uint32, rootHash []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,57 @@
+package checker
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// Issue describes an inconsistency found in the node databases
+type Issue struct {
+	Epoch   uint32
+	ShardID uint32
+	Unit    string
+	Key     []byte
+	Message string
+}
+
+// String returns the human readable form of the issue
+func (i *Issue) String() string {
+	return fmt.Sprintf("epoch %d, shard %s, unit %s, key %s: %s",
+		i.Epoch,
+		core.GetShardIDString(i.ShardID),
+		i.Unit,
+		hex.EncodeToString(i.Key),
+		i.Message,
+	)
+}
+
+// Report holds the outcome of a databases check
+type Report struct {
+	NumHeaders      int
+	NumMiniBlocks   int
+	NumTransactions int
+	NumReceipts     int
+	NumTries        int
+	NumCompactedDBs int
+	Issues          []*Issue
+}
+
+// HasIssues returns true if any inconsistency was found
+func (r *Report) HasIssues() bool {
+	return len(r.Issues) > 0
+}
+
+func (r *Report) addIssue(epoch uint32, shardID uint32, unit string, key []byte, message string) {
+	issue := &Issue{
+		Epoch:   epoch,
+		ShardID: shardID,
+		Unit:    unit,
+		Key:     key,
+		Message: message,
+	}
+	log.Debug("dbChecker issue", "issue", issue.String())
+
+	r.Issues = append(r.Issues, issue)
+}
@@ -0,0 +1,185 @@
+package checker
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsTrieVerifier holds the arguments needed for creating a new trie verifier
+type ArgsTrieVerifier struct {
+	GeneralConfig config.Config
+	Marshalizer   marshal.Marshalizer
+	Hasher        hashing.Hasher
+	PathManager   storage.PathManagerHandler
+}
+
+type trieVerifier struct {
+	generalConfig config.Config
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+	pathManager   storage.PathManagerHandler
+	tries         map[string]data.Trie
+}
+
+// NewTrieVerifier creates a component which checks that all the nodes of a trie are found in its storage
+func NewTrieVerifier(args ArgsTrieVerifier) (*trieVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.PathManager) {
+		return nil, ErrNilPathManager
+	}
+
+	return &trieVerifier{
+		generalConfig: args.GeneralConfig,
+		marshalizer:   args.Marshalizer,
+		hasher:        args.Hasher,
+		pathManager:   args.PathManager,
+		tries:         make(map[string]data.Trie),
+	}, nil
+}
+
+// VerifyTrie recreates the trie from the provided root hash and loads all its nodes from the storage. For the
+// accounts trie, the data tries of all the accounts, kept in the same storage, are also verified
+func (tv *trieVerifier) VerifyTrie(storageConfig config.StorageConfig, shardID uint32, rootHash []byte) error {
+	tr, err := tv.getTrie(storageConfig, shardID)
+	if err != nil {
+		return err
+	}
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+
+	_, err = recreatedTrie.GetAllHashes()
+	if err != nil {
+		return err
+	}
+
+	if storageConfig.DB.FilePath != tv.generalConfig.AccountsTrieStorage.DB.FilePath {
+		return nil
+	}
+
+	return tv.verifyDataTries(recreatedTrie, rootHash)
+}
+
+func (tv *trieVerifier) verifyDataTries(accountsTrie data.Trie, rootHash []byte) error {
+	chLeaves, err := accountsTrie.GetAllLeavesOnChannel(rootHash, context.Background())
+	if err != nil {
+		return err
+	}
+
+	var errFound error
+	verifiedRootHashes := make(map[string]struct{})
+	for leaf := range chLeaves {
+		if errFound != nil {
+			// the channel has to be drained so that the trie's go routine finishes
+			continue
+		}
+
+		account := state.NewEmptyUserAccount()
+		err = tv.marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			errFound = fmt.Errorf("%w for account %s", err, hex.EncodeToString(leaf.Key()))
+			continue
+		}
+
+		_, verified := verifiedRootHashes[string(account.RootHash)]
+		if len(account.RootHash) == 0 || verified {
+			continue
+		}
+
+		err = tv.verifyDataTrie(accountsTrie, account.RootHash)
+		if err != nil {
+			errFound = fmt.Errorf("%w for the data trie of account %s", err, hex.EncodeToString(leaf.Key()))
+			continue
+		}
+
+		verifiedRootHashes[string(account.RootHash)] = struct{}{}
+	}
+
+	return errFound
+}
+
+func (tv *trieVerifier) verifyDataTrie(accountsTrie data.Trie, dataTrieRootHash []byte) error {
+	dataTrie, err := accountsTrie.Recreate(dataTrieRootHash)
+	if err != nil {
+		return err
+	}
+
+	_, err = dataTrie.GetAllHashes()
+
+	return err
+}
+
+func (tv *trieVerifier) getTrie(storageConfig config.StorageConfig, shardID uint32) (data.Trie, error) {
+	shardIDString := core.GetShardIDString(shardID)
+	trieKey := fmt.Sprintf("%s_%s", storageConfig.DB.FilePath, shardIDString)
+	tr, ok := tv.tries[trieKey]
+	if ok {
+		return tr, nil
+	}
+
+	triePath := tv.pathManager.PathForStatic(shardIDString, storageConfig.DB.FilePath)
+	if !core.DoesFileExist(triePath) {
+		return nil, fmt.Errorf("%w: %s", storage.ErrDBNotFound, triePath)
+	}
+
+	trieFactoryArgs := factory.TrieFactoryArgs{
+		EvictionWaitingListCfg:   tv.generalConfig.EvictionWaitingList,
+		SnapshotDbCfg:            tv.generalConfig.TrieSnapshotDB,
+		Marshalizer:              tv.marshalizer,
+		Hasher:                   tv.hasher,
+		PathManager:              tv.pathManager,
+		TrieStorageManagerConfig: tv.generalConfig.TrieStorageManagerConfig,
+	}
+	trieFactory, err := factory.NewTrieFactory(trieFactoryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pruning is disabled so that no eviction waiting list or snapshot databases are created next to the checked one
+	_, tr, err = trieFactory.Create(storageConfig, shardIDString, false, tv.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	tv.tries[trieKey] = tr
+
+	return tr, nil
+}
+
+// Close closes the storage of all the opened tries. They are opened again on the next verification
+func (tv *trieVerifier) Close() error {
+	var lastErr error
+	for trieKey, tr := range tv.tries {
+		err := tr.ClosePersister()
+		if err != nil {
+			lastErr = err
+		}
+
+		delete(tv.tries, trieKey)
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tv *trieVerifier) IsInterfaceNil() bool {
+	return tv == nil
+}
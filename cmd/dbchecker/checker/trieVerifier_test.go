@@ -0,0 +1,161 @@
+package checker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataMock "github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageMock "github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsTrieVerifier() ArgsTrieVerifier {
+	return ArgsTrieVerifier{
+		GeneralConfig: config.Config{},
+		Marshalizer:   &marshal.GogoProtoMarshalizer{},
+		Hasher:        &blake2b.Blake2b{},
+		PathManager:   &storageMock.PathManagerStub{},
+	}
+}
+
+func TestNewTrieVerifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTrieVerifier()
+	args.Marshalizer = nil
+	tv, err := NewTrieVerifier(args)
+	assert.True(t, check.IfNil(tv))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgsTrieVerifier()
+	args.Hasher = nil
+	_, err = NewTrieVerifier(args)
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgsTrieVerifier()
+	args.PathManager = nil
+	_, err = NewTrieVerifier(args)
+	assert.Equal(t, ErrNilPathManager, err)
+}
+
+func TestNewTrieVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tv, err := NewTrieVerifier(createMockArgsTrieVerifier())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(tv))
+}
+
+func TestTrieVerifier_VerifyTrieMissingDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTrieVerifier()
+	args.PathManager = &storageMock.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return "missing"
+		},
+		PathForStaticCalled: func(shardId string, identifier string) string {
+			return "missing"
+		},
+	}
+	tv, _ := NewTrieVerifier(args)
+
+	err := tv.VerifyTrie(config.StorageConfig{DB: config.DBConfig{FilePath: "AccountsTrie"}}, 0, []byte("root hash"))
+	assert.True(t, errors.Is(err, storage.ErrDBNotFound))
+	assert.Nil(t, tv.Close())
+}
+
+func createAccountsTrieStub(t *testing.T, accounts map[string][]byte, dataTries map[string]data.Trie) *dataMock.TrieStub {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	leaves := make([]core.KeyValueHolder, 0, len(accounts))
+	for address, dataTrieRootHash := range accounts {
+		account := state.NewEmptyUserAccount()
+		account.RootHash = dataTrieRootHash
+		accountBytes, err := marshalizer.Marshal(account)
+		assert.Nil(t, err)
+
+		leaves = append(leaves, keyValStorage.NewKeyValStorage([]byte(address), accountBytes))
+	}
+
+	accountsTrie := &dataMock.TrieStub{
+		GetAllLeavesOnChannelCalled: func(_ []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder, len(leaves))
+			for _, leaf := range leaves {
+				ch <- leaf
+			}
+			close(ch)
+
+			return ch, nil
+		},
+		GetAllHashesCalled: func() ([][]byte, error) {
+			return make([][]byte, 0), nil
+		},
+	}
+	accountsTrie.RecreateCalled = func(root []byte) (data.Trie, error) {
+		dataTrie, ok := dataTries[string(root)]
+		if ok {
+			return dataTrie, nil
+		}
+
+		return accountsTrie, nil
+	}
+
+	return accountsTrie
+}
+
+func TestTrieVerifier_VerifyTrieShouldVerifyTheDataTries(t *testing.T) {
+	t.Parallel()
+
+	numVerifiedDataTries := 0
+	dataTrie := &dataMock.TrieStub{
+		GetAllHashesCalled: func() ([][]byte, error) {
+			numVerifiedDataTries++
+			return make([][]byte, 0), nil
+		},
+	}
+	accounts := map[string][]byte{
+		"address1": []byte("data trie root hash"),
+		"address2": []byte("data trie root hash"),
+		"address3": nil,
+	}
+
+	args := createMockArgsTrieVerifier()
+	args.GeneralConfig.AccountsTrieStorage.DB.FilePath = "AccountsTrie"
+	tv, _ := NewTrieVerifier(args)
+	tv.tries["AccountsTrie_0"] = createAccountsTrieStub(t, accounts, map[string]data.Trie{"data trie root hash": dataTrie})
+
+	err := tv.VerifyTrie(config.StorageConfig{DB: config.DBConfig{FilePath: "AccountsTrie"}}, 0, []byte("root hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numVerifiedDataTries)
+}
+
+func TestTrieVerifier_VerifyTrieMissingDataTrieNodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("missing node")
+	dataTrie := &dataMock.TrieStub{
+		GetAllHashesCalled: func() ([][]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	accounts := map[string][]byte{
+		"address1": []byte("data trie root hash"),
+	}
+
+	args := createMockArgsTrieVerifier()
+	args.GeneralConfig.AccountsTrieStorage.DB.FilePath = "AccountsTrie"
+	tv, _ := NewTrieVerifier(args)
+	tv.tries["AccountsTrie_0"] = createAccountsTrieStub(t, accounts, map[string]data.Trie{"data trie root hash": dataTrie})
+
+	err := tv.VerifyTrie(config.StorageConfig{DB: config.DBConfig{FilePath: "AccountsTrie"}}, 0, []byte("root hash"))
+	assert.True(t, errors.Is(err, expectedErr))
+}
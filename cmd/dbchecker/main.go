@@ -0,0 +1,276 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbchecker/checker"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	nodeConfigFilePath string
+	workingDirectory   string
+	chainID            string
+	trieEpochs         uint
+	compact            bool
+	logLevel           string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// workingDirectoryFlag defines a flag for the node's working directory, the one holding the db directory
+	workingDirectoryFlag = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "This string flag specifies the `directory` where the node stores its db directory",
+		Value:       ".",
+		Destination: &flagsValues.workingDirectory,
+	}
+
+	// chainIDFlag defines a flag for the chain ID, the name of the directory holding the databases
+	chainIDFlag = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "This string flag specifies the chain ID, the name of the directory holding the epochs databases",
+		Value:       "",
+		Destination: &flagsValues.chainID,
+	}
+
+	// trieEpochsFlag defines a flag for the number of last epochs whose state tries are checked
+	trieEpochsFlag = cli.UintFlag{
+		Name:        "trie-epochs",
+		Usage:       "This uint flag specifies for how many of the last epochs the state tries are checked",
+		Value:       1,
+		Destination: &flagsValues.trieEpochs,
+	}
+
+	// compactFlag defines a flag which enables the compaction of the databases after the check
+	compactFlag = cli.BoolFlag{
+		Name:        "compact",
+		Usage:       "Boolean option for compacting the databases after the check",
+		Destination: &flagsValues.compact,
+	}
+
+	// logLevelFlag defines the logger level
+	logLevelFlag = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,checker:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the checker package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &flagsValues.logLevel,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("dbchecker")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(_ *cli.Context) error {
+		return startDBChecker()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond databases checker"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond dbchecker verifies, offline, the consistency of the node databases and can compact them"
+	cliApp.Flags = []cli.Flag{
+		nodeConfigFilePathFlag,
+		workingDirectoryFlag,
+		chainIDFlag,
+		trieEpochsFlag,
+		compactFlag,
+		logLevelFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func startDBChecker() error {
+	err := logger.SetLogLevel(flagsValues.logLevel)
+	if err != nil {
+		return err
+	}
+
+	log.Info("dbchecker application started", "version", cliApp.Version)
+
+	if len(flagsValues.chainID) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", chainIDFlag.Name)
+	}
+
+	dbPathWithChainID := filepath.Join(flagsValues.workingDirectory, nodeFactory.DefaultDBPath, flagsValues.chainID)
+	if !core.DoesFileExist(dbPathWithChainID) {
+		return fmt.Errorf("no db directory found for the chain ID. Path: %s, chain id: %s", dbPathWithChainID, flagsValues.chainID)
+	}
+
+	generalConfig := config.Config{}
+	err = core.LoadTomlFile(&generalConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	bootstrapDataProvider, err := factory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return err
+	}
+
+	latestStorageDataProvider, err := nodeFactory.CreateLatestStorageDataProvider(
+		bootstrapDataProvider,
+		marshalizer,
+		hasher,
+		generalConfig,
+		flagsValues.chainID,
+		flagsValues.workingDirectory,
+		nodeFactory.DefaultDBPath,
+		nodeFactory.DefaultEpochString,
+		nodeFactory.DefaultShardString,
+	)
+	if err != nil {
+		return err
+	}
+
+	dbOpener, err := factory.NewStorageUnitOpenHandler(factory.ArgsNewOpenStorageUnits{
+		GeneralConfig:             generalConfig,
+		Marshalizer:               marshalizer,
+		BootstrapDataProvider:     bootstrapDataProvider,
+		LatestStorageDataProvider: latestStorageDataProvider,
+		WorkingDir:                flagsValues.workingDirectory,
+		ChainID:                   flagsValues.chainID,
+		DefaultDBPath:             nodeFactory.DefaultDBPath,
+		DefaultEpochString:        nodeFactory.DefaultEpochString,
+		DefaultShardString:        nodeFactory.DefaultShardString,
+	})
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := createPathManager(dbPathWithChainID)
+	if err != nil {
+		return err
+	}
+
+	trieVerifier, err := checker.NewTrieVerifier(checker.ArgsTrieVerifier{
+		GeneralConfig: generalConfig,
+		Marshalizer:   marshalizer,
+		Hasher:        hasher,
+		PathManager:   pathManager,
+	})
+	if err != nil {
+		return err
+	}
+
+	dbChecker, err := checker.NewDBChecker(checker.ArgsDBChecker{
+		GeneralConfig:             generalConfig,
+		DBOpener:                  dbOpener,
+		LatestStorageDataProvider: latestStorageDataProvider,
+		TrieVerifier:              trieVerifier,
+		Marshalizer:               marshalizer,
+		Hasher:                    hasher,
+		DefaultEpochString:        nodeFactory.DefaultEpochString,
+		DefaultStaticString:       nodeFactory.DefaultStaticDbString,
+		NumTrieEpochs:             uint32(flagsValues.trieEpochs),
+		Compact:                   flagsValues.compact,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := dbChecker.Check()
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range report.Issues {
+		log.Warn("inconsistency found", "issue", issue.String())
+	}
+
+	log.Info("databases checked",
+		"headers", report.NumHeaders,
+		"miniblocks", report.NumMiniBlocks,
+		"transactions", report.NumTransactions,
+		"receipts", report.NumReceipts,
+		"tries", report.NumTries,
+		"compacted databases", report.NumCompactedDBs,
+		"issues", len(report.Issues),
+	)
+
+	if report.HasIssues() {
+		return fmt.Errorf("%d inconsistencies found in the databases", len(report.Issues))
+	}
+
+	return nil
+}
+
+func createPathManager(dbPathWithChainID string) (*pathmanager.PathManager, error) {
+	pathTemplateForPruningStorer := filepath.Join(
+		dbPathWithChainID,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		dbPathWithChainID,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// DBOpenerStub -
+type DBOpenerStub struct {
+	OpenDBCalled       func(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error)
+	OpenStaticDBCalled func(dbConfig config.DBConfig, shardID uint32, staticString string) (storage.Persister, error)
+}
+
+// OpenDB -
+func (dos *DBOpenerStub) OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error) {
+	if dos.OpenDBCalled != nil {
+		return dos.OpenDBCalled(dbConfig, shardID, epoch)
+	}
+
+	return nil, storage.ErrDBNotFound
+}
+
+// OpenStaticDB -
+func (dos *DBOpenerStub) OpenStaticDB(dbConfig config.DBConfig, shardID uint32, staticString string) (storage.Persister, error) {
+	if dos.OpenStaticDBCalled != nil {
+		return dos.OpenStaticDBCalled(dbConfig, shardID, staticString)
+	}
+
+	return nil, storage.ErrDBNotFound
+}
+
+// IsInterfaceNil -
+func (dos *DBOpenerStub) IsInterfaceNil() bool {
+	return dos == nil
+}
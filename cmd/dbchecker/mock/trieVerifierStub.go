@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/config"
+
+// TrieVerifierStub -
+type TrieVerifierStub struct {
+	VerifyTrieCalled func(storageConfig config.StorageConfig, shardID uint32, rootHash []byte) error
+	CloseCalled      func() error
+}
+
+// VerifyTrie -
+func (tvs *TrieVerifierStub) VerifyTrie(storageConfig config.StorageConfig, shardID uint32, rootHash []byte) error {
+	if tvs.VerifyTrieCalled != nil {
+		return tvs.VerifyTrieCalled(storageConfig, shardID, rootHash)
+	}
+
+	return nil
+}
+
+// Close -
+func (tvs *TrieVerifierStub) Close() error {
+	if tvs.CloseCalled != nil {
+		return tvs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (tvs *TrieVerifierStub) IsInterfaceNil() bool {
+	return tvs == nil
+}
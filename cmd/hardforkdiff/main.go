@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/update/jsonlines"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	leftFolder  string
+	rightFolder string
+	showAll     bool
+	logLevel    string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// leftFolderFlag defines a flag for the folder holding the first JSON Lines export
+	leftFolderFlag = cli.StringFlag{
+		Name:        "left",
+		Usage:       "This string flag specifies the `directory` holding the first JSON Lines hardfork export",
+		Value:       "",
+		Destination: &flagsValues.leftFolder,
+	}
+
+	// rightFolderFlag defines a flag for the folder holding the second JSON Lines export
+	rightFolderFlag = cli.StringFlag{
+		Name:        "right",
+		Usage:       "This string flag specifies the `directory` holding the second JSON Lines hardfork export",
+		Value:       "",
+		Destination: &flagsValues.rightFolder,
+	}
+
+	// showAllFlag defines a flag which also reports the export files without differences
+	showAllFlag = cli.BoolFlag{
+		Name:        "show-all",
+		Usage:       "Boolean option for also reporting the export files that hold the same records in both exports",
+		Destination: &flagsValues.showAll,
+	}
+
+	// logLevelFlag defines the logger level
+	logLevelFlag = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,trie:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the trie package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &flagsValues.logLevel,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("hardforkdiff")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(_ *cli.Context) error {
+		return startHardforkDiff()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond hardfork diff"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond hardforkdiff compares, record by record, two JSON Lines hardfork exports"
+	cliApp.Flags = []cli.Flag{
+		leftFolderFlag,
+		rightFolderFlag,
+		showAllFlag,
+		logLevelFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func startHardforkDiff() error {
+	err := logger.SetLogLevel(flagsValues.logLevel)
+	if err != nil {
+		return err
+	}
+
+	log.Info("hardforkdiff application started", "version", cliApp.Version)
+
+	if len(flagsValues.leftFolder) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", leftFolderFlag.Name)
+	}
+	if len(flagsValues.rightFolder) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", rightFolderFlag.Name)
+	}
+
+	exportsDiff, err := jsonlines.DiffFolders(flagsValues.leftFolder, flagsValues.rightFolder)
+	if err != nil {
+		return err
+	}
+
+	differingFiles := onlyDifferingFiles(exportsDiff)
+	reported := differingFiles
+	if flagsValues.showAll {
+		reported = exportsDiff
+	}
+
+	output, err := json.MarshalIndent(reported, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+
+	if len(differingFiles.Files) > 0 {
+		return fmt.Errorf("the exports differ in %d file(s)", len(differingFiles.Files))
+	}
+
+	log.Info("the exports hold the same records")
+
+	return nil
+}
+
+func onlyDifferingFiles(exportsDiff *jsonlines.ExportsDiff) *jsonlines.ExportsDiff {
+	filtered := &jsonlines.ExportsDiff{
+		Files: make([]*jsonlines.FileDiff, 0, len(exportsDiff.Files)),
+	}
+	for _, fileDiff := range exportsDiff.Files {
+		if fileDiff.HasDifferences() {
+			filtered.Files = append(filtered.Files, fileDiff)
+		}
+	}
+
+	return filtered
+}
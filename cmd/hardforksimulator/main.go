@@ -0,0 +1,604 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/ElrondNetwork/elrond-go/update/dryrun"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	nodeConfigFilePath string
+	nodesSetupFilePath string
+	workingDirectories cli.StringSlice
+	chainID            string
+	epoch              uint
+	exportFolder       string
+	logLevel           string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// nodesSetupFilePathFlag defines a flag which holds the nodes setup file path
+	nodesSetupFilePathFlag = cli.StringFlag{
+		Name:        "nodes-setup",
+		Usage:       "This string flag specifies the `filepath` for the nodes setup json file of the network",
+		Value:       "../node/config/nodesSetup.json",
+		Destination: &flagsValues.nodesSetupFilePath,
+	}
+
+	// workingDirectoriesFlag defines a flag for the working directories of the nodes whose databases are read
+	workingDirectoriesFlag = cli.StringSliceFlag{
+		Name: "working-directory",
+		Usage: "This string slice flag specifies the `directory` where a node stores its db directory. It can be " +
+			"repeated, once for each node, as the databases must hold the state of all the shards",
+		Value: &flagsValues.workingDirectories,
+	}
+
+	// chainIDFlag defines a flag for the chain ID, the name of the directory holding the databases
+	chainIDFlag = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "This string flag specifies the chain ID, the name of the directory holding the epochs databases",
+		Value:       "",
+		Destination: &flagsValues.chainID,
+	}
+
+	// epochFlag defines a flag for the epoch at whose start the hardfork is simulated
+	epochFlag = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "This uint flag specifies the epoch at whose start the hardfork is simulated",
+		Value:       0,
+		Destination: &flagsValues.epoch,
+	}
+
+	// exportFolderFlag defines a flag for the folder where the state is exported and imported
+	exportFolderFlag = cli.StringFlag{
+		Name:        "export-folder",
+		Usage:       "This string flag specifies the `directory` where the state is exported and imported. It must not exist or be empty",
+		Value:       "./hardfork-simulation",
+		Destination: &flagsValues.exportFolder,
+	}
+
+	// logLevelFlag defines the logger level
+	logLevelFlag = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,trie:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the trie package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &flagsValues.logLevel,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("hardforksimulator")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(_ *cli.Context) error {
+		return startHardforkSimulator()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond hardfork simulator"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond hardforksimulator runs, offline, the hardfork export, import and genesis blocks creation over copies of the nodes databases"
+	cliApp.Flags = []cli.Flag{
+		nodeConfigFilePathFlag,
+		nodesSetupFilePathFlag,
+		workingDirectoriesFlag,
+		chainIDFlag,
+		epochFlag,
+		exportFolderFlag,
+		logLevelFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func startHardforkSimulator() error {
+	err := logger.SetLogLevel(flagsValues.logLevel)
+	if err != nil {
+		return err
+	}
+
+	log.Info("hardforksimulator application started", "version", cliApp.Version)
+
+	if len(flagsValues.chainID) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", chainIDFlag.Name)
+	}
+	if len(flagsValues.workingDirectories) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", workingDirectoriesFlag.Name)
+	}
+	err = checkExportFolder(flagsValues.exportFolder)
+	if err != nil {
+		return err
+	}
+
+	generalConfig := config.Config{}
+	err = core.LoadTomlFile(&generalConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	addressPubKeyConverter, err := stateFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+	validatorPubKeyConverter, err := stateFactory.NewPubkeyConverter(generalConfig.ValidatorPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	nodesSetup, err := sharding.NewNodesSetup(
+		flagsValues.nodesSetupFilePath,
+		addressPubKeyConverter,
+		validatorPubKeyConverter,
+		generalConfig.GeneralSettings.GenesisMaxNumberOfShards,
+	)
+	if err != nil {
+		return err
+	}
+	shardIDs := make([]uint32, 0, nodesSetup.NumberOfShards()+1)
+	for shardID := uint32(0); shardID < nodesSetup.NumberOfShards(); shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	loader := &localStateLoader{
+		generalConfig: generalConfig,
+		marshalizer:   marshalizer,
+		hasher:        hasher,
+		storageReader: &localStorageReader{
+			generalConfig: generalConfig,
+			epoch:         uint32(flagsValues.epoch),
+			persisters:    make(map[string]storage.Persister),
+		},
+		accountsTries: make(map[uint32]data.Trie),
+	}
+	defer loader.close()
+
+	for _, workingDirectory := range flagsValues.workingDirectories {
+		err = loader.loadWorkingDirectory(workingDirectory, shardIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	stateSyncer, err := dryrun.NewLocalStateSyncer(dryrun.ArgsLocalStateSyncer{
+		StorageReader:    loader.storageReader,
+		AccountsTries:    loader.accountsTries,
+		PeerAccountsTrie: loader.peerAccountsTrie,
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+	})
+	if err != nil {
+		return err
+	}
+
+	trieStorageManagers, err := loader.createImportTrieStorageManagers(flagsValues.exportFolder)
+	if err != nil {
+		return err
+	}
+
+	simulator, err := dryrun.NewHardforkSimulator(dryrun.ArgsHardforkSimulator{
+		StateSyncer:              stateSyncer,
+		NumOfShards:              nodesSetup.NumberOfShards(),
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		AddressPubKeyConverter:   addressPubKeyConverter,
+		ValidatorPubKeyConverter: validatorPubKeyConverter,
+		GenesisNodesSetupHandler: nodesSetup,
+		TrieStorageManagers:      trieStorageManagers,
+		HardforkConfig:           generalConfig.Hardfork,
+		ExportFolder:             flagsValues.exportFolder,
+		ChainID:                  flagsValues.chainID,
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := simulator.Simulate(uint32(flagsValues.epoch))
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+
+	if result.HasMismatches() {
+		return errors.New("the simulated hardfork does not reproduce the state committed in the epoch start block")
+	}
+
+	log.Info("hardfork simulation succeeded",
+		"epoch", flagsValues.epoch,
+		"folder", flagsValues.exportFolder,
+		"partial", result.Partial,
+	)
+
+	return nil
+}
+
+// checkExportFolder does not allow a folder already holding files as the exported data would be mixed with them
+func checkExportFolder(exportFolder string) error {
+	file, err := os.Open(exportFolder)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	names, err := file.Readdirnames(1)
+	if err != nil || len(names) > 0 {
+		return fmt.Errorf("the export folder %s is not an empty directory", exportFolder)
+	}
+
+	return nil
+}
+
+type dbOpener interface {
+	OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error)
+}
+
+type storageLocation struct {
+	workingDirectory string
+	dbOpener         dbOpener
+	shardID          uint32
+}
+
+type localStateLoader struct {
+	generalConfig    config.Config
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	storageReader    *localStorageReader
+	accountsTries    map[uint32]data.Trie
+	peerAccountsTrie data.Trie
+	openedTries      []data.Trie
+}
+
+// loadWorkingDirectory opens the accounts tries of all the shards found in the working directory. When the same shard
+// is found in more working directories, the first one is used
+func (l *localStateLoader) loadWorkingDirectory(workingDirectory string, shardIDs []uint32) error {
+	dbPathWithChainID := filepath.Join(workingDirectory, nodeFactory.DefaultDBPath, flagsValues.chainID)
+	if !core.DoesFileExist(dbPathWithChainID) {
+		return fmt.Errorf("no db directory found for the chain ID. Path: %s, chain id: %s", dbPathWithChainID, flagsValues.chainID)
+	}
+
+	opener, err := l.createDBOpener(workingDirectory)
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := createPathManager(dbPathWithChainID)
+	if err != nil {
+		return err
+	}
+	factoryArgs := l.createTrieFactoryArgs(pathManager)
+
+	for _, shardID := range shardIDs {
+		if _, ok := l.accountsTries[shardID]; ok {
+			continue
+		}
+
+		accountsTrie, errCreate := l.createTrie(factoryArgs, l.generalConfig.AccountsTrieStorage, shardID, l.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory)
+		if errors.Is(errCreate, storage.ErrDBNotFound) {
+			continue
+		}
+		if errCreate != nil {
+			return errCreate
+		}
+
+		log.Info("found the state of shard", "shard", shardID, "working directory", workingDirectory)
+		l.accountsTries[shardID] = accountsTrie
+		l.storageReader.locations = append(l.storageReader.locations, storageLocation{
+			workingDirectory: workingDirectory,
+			dbOpener:         opener,
+			shardID:          shardID,
+		})
+
+		if shardID != core.MetachainShardId {
+			continue
+		}
+
+		l.peerAccountsTrie, err = l.createTrie(factoryArgs, l.generalConfig.PeerAccountsTrieStorage, shardID, l.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *localStateLoader) createDBOpener(workingDirectory string) (dbOpener, error) {
+	bootstrapDataProvider, err := factory.NewBootstrapDataProvider(l.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	latestStorageDataProvider, err := nodeFactory.CreateLatestStorageDataProvider(
+		bootstrapDataProvider,
+		l.marshalizer,
+		l.hasher,
+		l.generalConfig,
+		flagsValues.chainID,
+		workingDirectory,
+		nodeFactory.DefaultDBPath,
+		nodeFactory.DefaultEpochString,
+		nodeFactory.DefaultShardString,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.NewStorageUnitOpenHandler(factory.ArgsNewOpenStorageUnits{
+		GeneralConfig:             l.generalConfig,
+		Marshalizer:               l.marshalizer,
+		BootstrapDataProvider:     bootstrapDataProvider,
+		LatestStorageDataProvider: latestStorageDataProvider,
+		WorkingDir:                workingDirectory,
+		ChainID:                   flagsValues.chainID,
+		DefaultDBPath:             nodeFactory.DefaultDBPath,
+		DefaultEpochString:        nodeFactory.DefaultEpochString,
+		DefaultShardString:        nodeFactory.DefaultShardString,
+	})
+}
+
+func (l *localStateLoader) createTrieFactoryArgs(pathManager storage.PathManagerHandler) trieFactory.TrieFactoryArgs {
+	return trieFactory.TrieFactoryArgs{
+		EvictionWaitingListCfg:   l.generalConfig.EvictionWaitingList,
+		SnapshotDbCfg:            l.generalConfig.TrieSnapshotDB,
+		Marshalizer:              l.marshalizer,
+		Hasher:                   l.hasher,
+		PathManager:              pathManager,
+		TrieStorageManagerConfig: l.generalConfig.TrieStorageManagerConfig,
+	}
+}
+
+func (l *localStateLoader) createTrie(factoryArgs trieFactory.TrieFactoryArgs, storageConfig config.StorageConfig, shardID uint32, maxTrieLevelInMemory uint) (data.Trie, error) {
+	shardIDString := core.GetShardIDString(shardID)
+	triePath := factoryArgs.PathManager.PathForStatic(shardIDString, storageConfig.DB.FilePath)
+	if !core.DoesFileExist(triePath) {
+		return nil, fmt.Errorf("%w: %s", storage.ErrDBNotFound, triePath)
+	}
+
+	trieCreator, err := trieFactory.NewTrieFactory(factoryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pruning is disabled so that no eviction waiting list or snapshot databases are created next to the read one
+	_, tr, err := trieCreator.Create(storageConfig, shardIDString, false, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+	l.openedTries = append(l.openedTries, tr)
+
+	return tr, nil
+}
+
+// createImportTrieStorageManagers creates, inside the export folder, the databases where the state is imported
+func (l *localStateLoader) createImportTrieStorageManagers(exportFolder string) (map[string]data.StorageManager, error) {
+	pathManager, err := createPathManager(filepath.Join(exportFolder, nodeFactory.DefaultDBPath))
+	if err != nil {
+		return nil, err
+	}
+
+	trieCreator, err := trieFactory.NewTrieFactory(l.createTrieFactoryArgs(pathManager))
+	if err != nil {
+		return nil, err
+	}
+
+	shardIDString := core.GetShardIDString(core.MetachainShardId)
+	userAccountsStorageManager, userAccountsTrie, err := trieCreator.Create(
+		l.generalConfig.AccountsTrieStorage,
+		shardIDString,
+		false,
+		l.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	)
+	if err != nil {
+		return nil, err
+	}
+	l.openedTries = append(l.openedTries, userAccountsTrie)
+
+	peerAccountsStorageManager, peerAccountsTrie, err := trieCreator.Create(
+		l.generalConfig.PeerAccountsTrieStorage,
+		shardIDString,
+		false,
+		l.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+	)
+	if err != nil {
+		return nil, err
+	}
+	l.openedTries = append(l.openedTries, peerAccountsTrie)
+
+	return map[string]data.StorageManager{
+		trieFactory.UserAccountTrie: userAccountsStorageManager,
+		trieFactory.PeerAccountTrie: peerAccountsStorageManager,
+	}, nil
+}
+
+func (l *localStateLoader) close() {
+	for _, tr := range l.openedTries {
+		err := tr.ClosePersister()
+		log.LogIfError(err)
+	}
+	l.storageReader.close()
+}
+
+// localStorageReader reads the blocks and the transactions from the epochs databases of all the found shards
+type localStorageReader struct {
+	generalConfig config.Config
+	epoch         uint32
+	locations     []storageLocation
+	persisters    map[string]storage.Persister
+}
+
+// Get searches the key in the databases of the simulated epoch and, as the data written around an epoch change might
+// be found in the previous epoch, in the databases of the previous epoch
+func (r *localStorageReader) Get(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+	dbConfig, err := r.dbConfigForUnit(unitType)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := []uint32{r.epoch}
+	if r.epoch > 0 {
+		epochs = append(epochs, r.epoch-1)
+	}
+
+	lastErr := storage.ErrKeyNotFound
+	for _, epoch := range epochs {
+		for _, location := range r.locations {
+			persister, errOpen := r.getPersister(location, dbConfig, epoch)
+			if errOpen != nil {
+				lastErr = errOpen
+				continue
+			}
+
+			buff, errGet := persister.Get(key)
+			if errGet == nil {
+				return buff, nil
+			}
+			lastErr = errGet
+		}
+	}
+
+	return nil, lastErr
+}
+
+func (r *localStorageReader) dbConfigForUnit(unitType dataRetriever.UnitType) (config.DBConfig, error) {
+	switch unitType {
+	case dataRetriever.MetaBlockUnit:
+		return r.generalConfig.MetaBlockStorage.DB, nil
+	case dataRetriever.MiniBlockUnit:
+		return r.generalConfig.MiniBlocksStorage.DB, nil
+	case dataRetriever.TransactionUnit:
+		return r.generalConfig.TxStorage.DB, nil
+	case dataRetriever.UnsignedTransactionUnit:
+		return r.generalConfig.UnsignedTransactionStorage.DB, nil
+	case dataRetriever.RewardTransactionUnit:
+		return r.generalConfig.RewardTxStorage.DB, nil
+	default:
+		return config.DBConfig{}, fmt.Errorf("%w: %s", storage.ErrKeyNotFound, unitType.String())
+	}
+}
+
+// getPersister opens each database once and keeps it opened until the simulation ends
+func (r *localStorageReader) getPersister(location storageLocation, dbConfig config.DBConfig, epoch uint32) (storage.Persister, error) {
+	identifier := fmt.Sprintf("%s_%d_%d_%s", location.workingDirectory, location.shardID, epoch, dbConfig.FilePath)
+	persister, ok := r.persisters[identifier]
+	if ok {
+		return persister, nil
+	}
+
+	persister, err := location.dbOpener.OpenDB(dbConfig, location.shardID, epoch)
+	if err != nil {
+		return nil, err
+	}
+	r.persisters[identifier] = persister
+
+	return persister, nil
+}
+
+func (r *localStorageReader) close() {
+	for _, persister := range r.persisters {
+		err := persister.Close()
+		log.LogIfError(err)
+	}
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (r *localStorageReader) IsInterfaceNil() bool {
+	return r == nil
+}
+
+func createPathManager(dbPathWithChainID string) (*pathmanager.PathManager, error) {
+	pathTemplateForPruningStorer := filepath.Join(
+		dbPathWithChainID,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		dbPathWithChainID,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
@@ -1528,10 +1528,16 @@ func newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                   gasSchedule,
+		MapDNSAddresses:               mapDNSAddresses,
+		Marshalizer:                   core.InternalMarshalizer,
+		Accounts:                      stateComponents.AccountsAdapter,
+		ShardCoordinator:              shardCoordinator,
+		EpochNotifier:                 epochNotifier,
+		ESDTNFTEnableEpoch:            generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   generalConfig.GeneralSettings.ESDTSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: generalConfig.GeneralSettings.ESDTSupplyTrackingEnableEpoch,
+		ESDTMultiTransferEnableEpoch:  generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1616,7 +1622,7 @@ func newShardBlockProcessor(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -1660,6 +1666,7 @@ func newShardBlockProcessor(
 		BuiltinEnableEpoch:             config.GeneralSettings.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		RepairCallbackEnableEpoch:      config.GeneralSettings.RepairCallbackEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch:  config.GeneralSettings.ESDTSupplyTrackingEnableEpoch,
 		BadTxForwarder:                 badTxInterim,
 		EpochNotifier:                  epochNotifier,
 		StakingV2EnableEpoch:           stakingV2EnableEpoch,
@@ -1694,6 +1701,7 @@ func newShardBlockProcessor(
 		ArgsParser:                     argsParser,
 		ScrForwarder:                   scForwarder,
 		RelayedTxEnableEpoch:           config.GeneralSettings.RelayedTransactionsEnableEpoch,
+		RelayedTxV2EnableEpoch:         config.GeneralSettings.RelayedTransactionsV2EnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      config.GeneralSettings.MetaProtectionEnableEpoch,
 		EpochNotifier:                  epochNotifier,
@@ -1857,10 +1865,16 @@ func newMetaBlockProcessor(
 ) (process.BlockProcessor, error) {
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}), // no dns for meta
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasSchedule:                   gasSchedule,
+		MapDNSAddresses:               make(map[string]struct{}), // no dns for meta
+		Marshalizer:                   core.InternalMarshalizer,
+		Accounts:                      stateComponents.AccountsAdapter,
+		ShardCoordinator:              shardCoordinator,
+		EpochNotifier:                 epochNotifier,
+		ESDTNFTEnableEpoch:            generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   generalConfig.GeneralSettings.ESDTSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: generalConfig.GeneralSettings.ESDTSupplyTrackingEnableEpoch,
+		ESDTMultiTransferEnableEpoch:  generalConfig.GeneralSettings.ESDTMultiTransferEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -1886,17 +1900,20 @@ func newMetaBlockProcessor(
 		NilCompiledSCStore: false,
 	}
 	argsNewVMContainer := metachain.ArgsNewVMContainerFactory{
-		ArgBlockChainHook:   argsHook,
-		Economics:           economicsData,
-		MessageSignVerifier: messageSignVerifier,
-		GasSchedule:         gasSchedule,
-		NodesConfigProvider: nodesSetup,
-		Hasher:              core.Hasher,
-		Marshalizer:         core.InternalMarshalizer,
-		SystemSCConfig:      systemSCConfig,
-		ValidatorAccountsDB: stateComponents.PeerAccounts,
-		ChanceComputer:      rater,
-		EpochNotifier:       epochNotifier,
+		ArgBlockChainHook:             argsHook,
+		Economics:                     economicsData,
+		MessageSignVerifier:           messageSignVerifier,
+		GasSchedule:                   gasSchedule,
+		NodesConfigProvider:           nodesSetup,
+		Hasher:                        core.Hasher,
+		Marshalizer:                   core.InternalMarshalizer,
+		SystemSCConfig:                systemSCConfig,
+		ValidatorAccountsDB:           stateComponents.PeerAccounts,
+		ChanceComputer:                rater,
+		EpochNotifier:                 epochNotifier,
+		ESDTNFTEnableEpoch:            generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   generalConfig.GeneralSettings.ESDTSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: generalConfig.GeneralSettings.ESDTSupplyTrackingEnableEpoch,
 	}
 	vmFactory, err := metachain.NewVMContainerFactory(argsNewVMContainer)
 	if err != nil {
@@ -1940,7 +1957,7 @@ func newMetaBlockProcessor(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -1984,6 +2001,7 @@ func newMetaBlockProcessor(
 		BuiltinEnableEpoch:             generalConfig.GeneralSettings.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		RepairCallbackEnableEpoch:      generalConfig.GeneralSettings.RepairCallbackEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch:  generalConfig.GeneralSettings.ESDTSupplyTrackingEnableEpoch,
 		BadTxForwarder:                 badTxForwarder,
 		EpochNotifier:                  epochNotifier,
 		StakingV2EnableEpoch:           systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
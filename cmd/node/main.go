@@ -45,6 +45,7 @@ import (
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool/journal"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -56,6 +57,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/esdtSupplyAPI"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
@@ -1442,6 +1444,19 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		indexValidatorsListIfNeeded(esIndexer, nodesCoordinator, processComponents.EpochStartTrigger.Epoch(), log)
 	}
 
+	log.Trace("creating esdt supply handler")
+	argsESDTSupply := &esdtSupplyAPI.ArgsESDTSupplyHandler{
+		ShardID:             shardCoordinator.SelfId(),
+		InternalMarshalizer: coreComponents.InternalMarshalizer,
+		Accounts:            stateComponents.AccountsAdapter,
+		BlockChain:          dataComponents.Blkc,
+		Config:              generalConfig.ESDTHolders,
+	}
+	esdtSupplyHandler, err := esdtSupplyAPI.CreateESDTSupplyHandler(argsESDTSupply)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating api resolver structure")
 	apiWorkingDir := filepath.Join(workingDir, factory.TemporaryPath)
 	apiResolver, err := createApiResolver(
@@ -1464,6 +1479,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		systemSCConfig,
 		rater,
 		epochNotifier,
+		esdtSupplyHandler,
 		apiWorkingDir,
 	)
 	if err != nil {
@@ -1525,6 +1541,18 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("creating tx pool journal")
+	txPoolJournal, err := createTxPoolJournal(generalConfig, dataComponents, coreComponents.InternalMarshalizer)
+	if err != nil {
+		return err
+	}
+
+	err = txPoolJournal.ReloadTransactions(currentNode)
+	if err != nil {
+		log.Warn("reloading the journaled transactions failed", "error", err.Error())
+	}
+	txPoolJournal.StartSnapshotting()
+
 	log.Info("application is now running")
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
@@ -1538,7 +1566,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, txPoolJournal, esdtSupplyHandler, dataComponents, triesComponents, networkComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1600,6 +1628,8 @@ func changeStorageConfigForDBImport(storageConfig *config.StorageConfig) {
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	txPoolJournal io.Closer,
+	esdtSupplyHandler io.Closer,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1609,6 +1639,14 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing the tx pool journal...")
+	err = txPoolJournal.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing the esdt supply handler...")
+	err = esdtSupplyHandler.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -2151,6 +2189,7 @@ func createHardForkTrigger(
 		ExportTriesStorageConfig:  hardForkConfig.ExportTriesStorageConfig,
 		ExportStateStorageConfig:  hardForkConfig.ExportStateStorageConfig,
 		ExportStateKeysConfig:     hardForkConfig.ExportKeysStorageConfig,
+		ExportJSONLines:           hardForkConfig.ExportJSONLines,
 		WhiteListHandler:          whiteListRequest,
 		WhiteListerVerifiedTxs:    whiteListerVerifiedTxs,
 		InterceptorsContainer:     process.InterceptorsContainer,
@@ -2481,6 +2520,7 @@ func createApiResolver(
 	systemSCConfig *config.SystemSmartContractsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
 	epochNotifier process.EpochNotifier,
+	esdtSupplyHandler external.ESDTSupplyHandler,
 	workingDir string,
 ) (facade.ApiResolver, error) {
 	scQueryService, err := createScQueryService(
@@ -2512,6 +2552,9 @@ func createApiResolver(
 		gasScheduleNotifier,
 		marshalizer,
 		accnts,
+		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings,
 	)
 	if err != nil {
 		return nil, err
@@ -2520,7 +2563,7 @@ func createApiResolver(
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubkeyConv,
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -2528,7 +2571,13 @@ func createApiResolver(
 		return nil, err
 	}
 
-	txCostHandler, err := transaction.NewTransactionCostEstimator(txTypeHandler, economics, scQueryService, gasScheduleNotifier)
+	txCostHandler, err := transaction.NewTransactionCostEstimator(
+		txTypeHandler,
+		economics,
+		scQueryService,
+		parsers.NewCallArgsParser(),
+		gasScheduleNotifier,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2544,7 +2593,7 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, totalStakedValueHandler)
+	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, totalStakedValueHandler, esdtSupplyHandler)
 }
 
 //TODO refactor this code when moving into feat/soft-restart. Maybe use arguments instead of endless parameter lists
@@ -2643,6 +2692,9 @@ func createScQueryElement(
 		gasScheduleNotifier,
 		marshalizer,
 		accnts,
+		shardCoordinator,
+		epochNotifier,
+		generalConfig.GeneralSettings,
 	)
 	if err != nil {
 		return nil, err
@@ -2674,17 +2726,20 @@ func createScQueryElement(
 
 	if shardCoordinator.SelfId() == core.MetachainShardId {
 		argsNewVmFactory := metachain.ArgsNewVMContainerFactory{
-			ArgBlockChainHook:   argsHook,
-			Economics:           economics,
-			MessageSignVerifier: messageSigVerifier,
-			GasSchedule:         gasScheduleNotifier,
-			NodesConfigProvider: nodesSetup,
-			Hasher:              hasher,
-			Marshalizer:         marshalizer,
-			SystemSCConfig:      systemSCConfig,
-			ValidatorAccountsDB: validatorAccounts,
-			ChanceComputer:      rater,
-			EpochNotifier:       epochNotifier,
+			ArgBlockChainHook:             argsHook,
+			Economics:                     economics,
+			MessageSignVerifier:           messageSigVerifier,
+			GasSchedule:                   gasScheduleNotifier,
+			NodesConfigProvider:           nodesSetup,
+			Hasher:                        hasher,
+			Marshalizer:                   marshalizer,
+			SystemSCConfig:                systemSCConfig,
+			ValidatorAccountsDB:           validatorAccounts,
+			ChanceComputer:                rater,
+			EpochNotifier:                 epochNotifier,
+			ESDTNFTEnableEpoch:            generalConfig.GeneralSettings.ESDTNFTEnableEpoch,
+			ESDTSpecialRolesEnableEpoch:   generalConfig.GeneralSettings.ESDTSpecialRolesEnableEpoch,
+			ESDTSupplyTrackingEnableEpoch: generalConfig.GeneralSettings.ESDTSupplyTrackingEnableEpoch,
 		}
 		vmFactory, err = metachain.NewVMContainerFactory(argsNewVmFactory)
 		if err != nil {
@@ -2726,12 +2781,21 @@ func createBuiltinFuncs(
 	gasScheduleNotifier core.GasScheduleNotifier,
 	marshalizer marshal.Marshalizer,
 	accnts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+	epochNotifier process.EpochNotifier,
+	generalSettings config.GeneralSettingsConfig,
 ) (process.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasScheduleNotifier,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
-		Accounts:        accnts,
+		GasSchedule:                   gasScheduleNotifier,
+		MapDNSAddresses:               make(map[string]struct{}),
+		Marshalizer:                   marshalizer,
+		Accounts:                      accnts,
+		ShardCoordinator:              shardCoordinator,
+		EpochNotifier:                 epochNotifier,
+		ESDTNFTEnableEpoch:            generalSettings.ESDTNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   generalSettings.ESDTSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: generalSettings.ESDTSupplyTrackingEnableEpoch,
+		ESDTMultiTransferEnableEpoch:  generalSettings.ESDTMultiTransferEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -2741,6 +2805,30 @@ func createBuiltinFuncs(
 	return builtInFuncFactory.CreateBuiltInFunctionContainer()
 }
 
+func createTxPoolJournal(
+	generalConfig *config.Config,
+	dataComponents *mainFactory.DataComponents,
+	marshalizer marshal.Marshalizer,
+) (journal.TxPoolJournalHandler, error) {
+	if !generalConfig.TxPoolJournal.Enabled {
+		return journal.NewDisabledTxPoolJournal(), nil
+	}
+
+	txPool, ok := dataComponents.Datapool.Transactions().(journal.TxPoolHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w while creating the tx pool journal", dataRetriever.ErrWrongTypeAssertion)
+	}
+
+	argTxPoolJournal := journal.ArgTxPoolJournal{
+		TxPool:           txPool,
+		Storer:           dataComponents.Store.GetStorer(dataRetriever.TxPoolJournalUnit),
+		Marshalizer:      marshalizer,
+		SnapshotInterval: time.Duration(generalConfig.TxPoolJournal.SnapshotIntervalInSec) * time.Second,
+	}
+
+	return journal.NewTxPoolJournal(argTxPoolJournal)
+}
+
 func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteListHandler, error) {
 	whiteListCacheVerified, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(generalConfig.WhiteListerVerifiedTxs))
 	if err != nil {
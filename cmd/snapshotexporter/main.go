@@ -0,0 +1,452 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	nodeConfigFilePath string
+	workingDirectory   string
+	chainID            string
+	shardID            uint
+	epoch              uint
+	outputFilePath     string
+	logLevel           string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// workingDirectoryFlag defines a flag for the node's working directory, the one holding the db directory
+	workingDirectoryFlag = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "This string flag specifies the `directory` where the node stores its db directory",
+		Value:       ".",
+		Destination: &flagsValues.workingDirectory,
+	}
+
+	// chainIDFlag defines a flag for the chain ID, the name of the directory holding the databases
+	chainIDFlag = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "This string flag specifies the chain ID, the name of the directory holding the epochs databases",
+		Value:       "",
+		Destination: &flagsValues.chainID,
+	}
+
+	// shardIDFlag defines a flag for the shard whose state is exported
+	shardIDFlag = cli.UintFlag{
+		Name:        "shard",
+		Usage:       "This uint flag specifies the shard whose state is exported. The metachain is 4294967295",
+		Value:       0,
+		Destination: &flagsValues.shardID,
+	}
+
+	// epochFlag defines a flag for the epoch whose start block state is exported
+	epochFlag = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "This uint flag specifies the epoch whose start block state is exported",
+		Value:       0,
+		Destination: &flagsValues.epoch,
+	}
+
+	// outputFilePathFlag defines a flag for the path of the written archive
+	outputFilePathFlag = cli.StringFlag{
+		Name:        "output",
+		Usage:       "This string flag specifies the `filepath` of the written state snapshot archive",
+		Value:       "./snapshot.elrdsnap",
+		Destination: &flagsValues.outputFilePath,
+	}
+
+	// logLevelFlag defines the logger level
+	logLevelFlag = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,trie:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the trie package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &flagsValues.logLevel,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("snapshotexporter")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(_ *cli.Context) error {
+		return startSnapshotExporter()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond state snapshot exporter"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond snapshotexporter writes, offline, the state of an epoch start block into an archive used for bootstrapping new nodes"
+	cliApp.Flags = []cli.Flag{
+		nodeConfigFilePathFlag,
+		workingDirectoryFlag,
+		chainIDFlag,
+		shardIDFlag,
+		epochFlag,
+		outputFilePathFlag,
+		logLevelFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func startSnapshotExporter() error {
+	err := logger.SetLogLevel(flagsValues.logLevel)
+	if err != nil {
+		return err
+	}
+
+	log.Info("snapshotexporter application started", "version", cliApp.Version)
+
+	if len(flagsValues.chainID) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", chainIDFlag.Name)
+	}
+
+	dbPathWithChainID := filepath.Join(flagsValues.workingDirectory, nodeFactory.DefaultDBPath, flagsValues.chainID)
+	if !core.DoesFileExist(dbPathWithChainID) {
+		return fmt.Errorf("no db directory found for the chain ID. Path: %s, chain id: %s", dbPathWithChainID, flagsValues.chainID)
+	}
+
+	generalConfig := config.Config{}
+	err = core.LoadTomlFile(&generalConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	bootstrapDataProvider, err := factory.NewBootstrapDataProvider(marshalizer)
+	if err != nil {
+		return err
+	}
+
+	latestStorageDataProvider, err := nodeFactory.CreateLatestStorageDataProvider(
+		bootstrapDataProvider,
+		marshalizer,
+		hasher,
+		generalConfig,
+		flagsValues.chainID,
+		flagsValues.workingDirectory,
+		nodeFactory.DefaultDBPath,
+		nodeFactory.DefaultEpochString,
+		nodeFactory.DefaultShardString,
+	)
+	if err != nil {
+		return err
+	}
+
+	dbOpener, err := factory.NewStorageUnitOpenHandler(factory.ArgsNewOpenStorageUnits{
+		GeneralConfig:             generalConfig,
+		Marshalizer:               marshalizer,
+		BootstrapDataProvider:     bootstrapDataProvider,
+		LatestStorageDataProvider: latestStorageDataProvider,
+		WorkingDir:                flagsValues.workingDirectory,
+		ChainID:                   flagsValues.chainID,
+		DefaultDBPath:             nodeFactory.DefaultDBPath,
+		DefaultEpochString:        nodeFactory.DefaultEpochString,
+		DefaultShardString:        nodeFactory.DefaultShardString,
+	})
+	if err != nil {
+		return err
+	}
+
+	shardID := uint32(flagsValues.shardID)
+	epoch := uint32(flagsValues.epoch)
+	reader := &epochStartDataReader{
+		generalConfig: generalConfig,
+		dbOpener:      dbOpener,
+		marshalizer:   marshalizer,
+		shardID:       shardID,
+		epoch:         epoch,
+	}
+	exportData, err := reader.readExportData()
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := createPathManager(dbPathWithChainID)
+	if err != nil {
+		return err
+	}
+
+	factoryArgs := trieFactory.TrieFactoryArgs{
+		EvictionWaitingListCfg:   generalConfig.EvictionWaitingList,
+		SnapshotDbCfg:            generalConfig.TrieSnapshotDB,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		PathManager:              pathManager,
+		TrieStorageManagerConfig: generalConfig.TrieStorageManagerConfig,
+	}
+	accountsTrie, err := createTrie(factoryArgs, generalConfig.AccountsTrieStorage, shardID, generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+	defer closeTrie(accountsTrie)
+
+	argsExporter := snapshot.ArgsExporter{
+		Marshalizer:  marshalizer,
+		Hasher:       hasher,
+		AccountsTrie: accountsTrie,
+	}
+	if shardID == core.MetachainShardId {
+		argsExporter.PeerAccountsTrie, err = createTrie(factoryArgs, generalConfig.PeerAccountsTrieStorage, shardID, generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory)
+		if err != nil {
+			return err
+		}
+		defer closeTrie(argsExporter.PeerAccountsTrie)
+	}
+
+	exporter, err := snapshot.NewExporter(argsExporter)
+	if err != nil {
+		return err
+	}
+
+	return exportToFile(exporter, exportData, flagsValues.outputFilePath)
+}
+
+func exportToFile(exporter snapshotExporter, exportData *snapshot.ExportData, outputFilePath string) error {
+	file, err := os.Create(outputFilePath)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := exporter.Export(file, exportData)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(outputFilePath)
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot exported", "file", outputFilePath)
+	fmt.Println(string(output))
+
+	return nil
+}
+
+type snapshotExporter interface {
+	Export(w io.Writer, exportData *snapshot.ExportData) (*snapshot.Manifest, error)
+}
+
+type dbOpener interface {
+	OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error)
+}
+
+type epochStartDataReader struct {
+	generalConfig config.Config
+	dbOpener      dbOpener
+	marshalizer   marshal.Marshalizer
+	shardID       uint32
+	epoch         uint32
+}
+
+func (r *epochStartDataReader) readExportData() (*snapshot.ExportData, error) {
+	metaBlockBytes, err := r.getFromEpochs(r.generalConfig.MetaBlockStorage.DB, []byte(core.EpochStartIdentifier(r.epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("epoch start metablock not found: %w", err)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = r.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	exportData := &snapshot.ExportData{
+		ShardID:             r.shardID,
+		EpochStartMetaBlock: metaBlock,
+	}
+
+	if r.shardID != core.MetachainShardId {
+		exportData.ShardHeader, err = r.readShardHeader(metaBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nodesConfigKey := append([]byte(core.NodesCoordinatorRegistryKeyPrefix), metaBlock.PrevRandSeed...)
+	nodesConfigBytes, err := r.getFromEpochs(r.generalConfig.BootstrapStorage.DB, nodesConfigKey)
+	if err != nil {
+		return nil, fmt.Errorf("nodes config not found: %w", err)
+	}
+
+	exportData.NodesConfig = &sharding.NodesCoordinatorRegistry{}
+	err = json.Unmarshal(nodesConfigBytes, exportData.NodesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportData, nil
+}
+
+func (r *epochStartDataReader) readShardHeader(metaBlock *block.MetaBlock) (*block.Header, error) {
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		if shardData.ShardID != r.shardID {
+			continue
+		}
+
+		headerBytes, err := r.getFromEpochs(r.generalConfig.BlockHeaderStorage.DB, shardData.HeaderHash)
+		if err != nil {
+			return nil, fmt.Errorf("epoch start shard header not found: %w", err)
+		}
+
+		header := &block.Header{}
+		err = r.marshalizer.Unmarshal(header, headerBytes)
+
+		return header, err
+	}
+
+	return nil, fmt.Errorf("the epoch start metablock does not hold the shard %d", r.shardID)
+}
+
+// getFromEpochs reads the key from the databases of the exported epoch and, as the data written around an epoch change
+// might be found in the previous epoch, falls back to the previous epoch
+func (r *epochStartDataReader) getFromEpochs(dbConfig config.DBConfig, key []byte) ([]byte, error) {
+	epochs := []uint32{r.epoch}
+	if r.epoch > 0 {
+		epochs = append(epochs, r.epoch-1)
+	}
+
+	var lastErr error
+	for _, epoch := range epochs {
+		buff, err := r.getFromEpoch(dbConfig, epoch, key)
+		if err == nil {
+			return buff, nil
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+func (r *epochStartDataReader) getFromEpoch(dbConfig config.DBConfig, epoch uint32, key []byte) ([]byte, error) {
+	persister, err := r.dbOpener.OpenDB(dbConfig, r.shardID, epoch)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := persister.Close()
+		log.LogIfError(errClose)
+	}()
+
+	return persister.Get(key)
+}
+
+func createTrie(factoryArgs trieFactory.TrieFactoryArgs, storageConfig config.StorageConfig, shardID uint32, maxTrieLevelInMemory uint) (data.Trie, error) {
+	shardIDString := core.GetShardIDString(shardID)
+	triePath := factoryArgs.PathManager.PathForStatic(shardIDString, storageConfig.DB.FilePath)
+	if !core.DoesFileExist(triePath) {
+		return nil, fmt.Errorf("%w: %s", storage.ErrDBNotFound, triePath)
+	}
+
+	trieCreator, err := trieFactory.NewTrieFactory(factoryArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pruning is disabled so that no eviction waiting list or snapshot databases are created next to the exported one
+	_, tr, err := trieCreator.Create(storageConfig, shardIDString, false, maxTrieLevelInMemory)
+
+	return tr, err
+}
+
+func closeTrie(tr data.Trie) {
+	err := tr.ClosePersister()
+	log.LogIfError(err)
+}
+
+func createPathManager(dbPathWithChainID string) (*pathmanager.PathManager, error) {
+	pathTemplateForPruningStorer := filepath.Join(
+		dbPathWithChainID,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		dbPathWithChainID,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
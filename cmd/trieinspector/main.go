@@ -0,0 +1,287 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+type flags struct {
+	nodeConfigFilePath string
+	workingDirectory   string
+	chainID            string
+	shardID            uint
+	rootHash           string
+	top                uint
+	logLevel           string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// nodeConfigFilePathFlag defines a flag which holds the node's configuration file path
+	nodeConfigFilePathFlag = cli.StringFlag{
+		Name:        "node-config",
+		Usage:       "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value:       "../node/config/config.toml",
+		Destination: &flagsValues.nodeConfigFilePath,
+	}
+
+	// workingDirectoryFlag defines a flag for the node's working directory, the one holding the db directory
+	workingDirectoryFlag = cli.StringFlag{
+		Name:        "working-directory",
+		Usage:       "This string flag specifies the `directory` where the node stores its db directory",
+		Value:       ".",
+		Destination: &flagsValues.workingDirectory,
+	}
+
+	// chainIDFlag defines a flag for the chain ID, the name of the directory holding the databases
+	chainIDFlag = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "This string flag specifies the chain ID, the name of the directory holding the epochs databases",
+		Value:       "",
+		Destination: &flagsValues.chainID,
+	}
+
+	// shardIDFlag defines a flag for the shard whose accounts trie is inspected
+	shardIDFlag = cli.UintFlag{
+		Name:        "shard",
+		Usage:       "This uint flag specifies the shard whose accounts trie is inspected",
+		Value:       0,
+		Destination: &flagsValues.shardID,
+	}
+
+	// rootHashFlag defines a flag for the root hash of the inspected accounts trie
+	rootHashFlag = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "This string flag specifies the hex encoded root hash of the inspected accounts trie",
+		Value:       "",
+		Destination: &flagsValues.rootHash,
+	}
+
+	// topFlag defines a flag for the number of the largest data tries which are reported
+	topFlag = cli.UintFlag{
+		Name:        "top",
+		Usage:       "This uint flag specifies how many of the accounts having the largest data tries are reported",
+		Value:       10,
+		Destination: &flagsValues.top,
+	}
+
+	// logLevelFlag defines the logger level
+	logLevelFlag = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,trie:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the trie package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &flagsValues.logLevel,
+	}
+
+	flagsValues = &flags{}
+
+	log    = logger.GetOrCreate("trieinspector")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(_ *cli.Context) error {
+		return startTrieInspector()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond trie inspector"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Elrond trieinspector reports, offline, the statistics of an accounts trie and of its accounts data tries"
+	cliApp.Flags = []cli.Flag{
+		nodeConfigFilePathFlag,
+		workingDirectoryFlag,
+		chainIDFlag,
+		shardIDFlag,
+		rootHashFlag,
+		topFlag,
+		logLevelFlag,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func startTrieInspector() error {
+	err := logger.SetLogLevel(flagsValues.logLevel)
+	if err != nil {
+		return err
+	}
+
+	log.Info("trieinspector application started", "version", cliApp.Version)
+
+	if len(flagsValues.chainID) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", chainIDFlag.Name)
+	}
+	rootHash, err := hex.DecodeString(flagsValues.rootHash)
+	if err != nil {
+		return fmt.Errorf("invalid %s flag: %w", rootHashFlag.Name, err)
+	}
+	if len(rootHash) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", rootHashFlag.Name)
+	}
+
+	dbPathWithChainID := filepath.Join(flagsValues.workingDirectory, nodeFactory.DefaultDBPath, flagsValues.chainID)
+	if !core.DoesFileExist(dbPathWithChainID) {
+		return fmt.Errorf("no db directory found for the chain ID. Path: %s, chain id: %s", dbPathWithChainID, flagsValues.chainID)
+	}
+
+	generalConfig := config.Config{}
+	err = core.LoadTomlFile(&generalConfig, flagsValues.nodeConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	addressPubkeyConverter, err := stateFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := createPathManager(dbPathWithChainID)
+	if err != nil {
+		return err
+	}
+
+	shardIDString := core.GetShardIDString(uint32(flagsValues.shardID))
+	triePath := pathManager.PathForStatic(shardIDString, generalConfig.AccountsTrieStorage.DB.FilePath)
+	if !core.DoesFileExist(triePath) {
+		return fmt.Errorf("%w: %s", storage.ErrDBNotFound, triePath)
+	}
+
+	factory, err := trieFactory.NewTrieFactory(trieFactory.TrieFactoryArgs{
+		EvictionWaitingListCfg:   generalConfig.EvictionWaitingList,
+		SnapshotDbCfg:            generalConfig.TrieSnapshotDB,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		PathManager:              pathManager,
+		TrieStorageManagerConfig: generalConfig.TrieStorageManagerConfig,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the pruning is disabled so that no eviction waiting list or snapshot databases are created next to the inspected one
+	_, tr, err := factory.Create(
+		generalConfig.AccountsTrieStorage,
+		shardIDString,
+		false,
+		generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := tr.ClosePersister()
+		log.LogIfError(errClose)
+	}()
+
+	accountsDB, err := state.NewAccountsDB(tr, hasher, marshalizer, stateFactory.NewAccountCreator())
+	if err != nil {
+		return err
+	}
+
+	trieStatistics, err := accountsDB.GetTrieStatistics(context.Background(), rootHash, int(flagsValues.top))
+	if err != nil {
+		return err
+	}
+
+	apiTrieStatistics := &api.TrieStatistics{
+		RootHash:                hex.EncodeToString(trieStatistics.RootHash),
+		NumAccounts:             trieStatistics.NumAccounts,
+		NumAccountsWithDataTrie: trieStatistics.NumAccountsWithDataTrie,
+		MainTrie:                trieStatistics.MainTrie,
+		DataTries:               trieStatistics.DataTries,
+		TopDataTries:            make([]*api.DataTrieStatistics, 0, len(trieStatistics.TopDataTries)),
+	}
+	for _, dataTrie := range trieStatistics.TopDataTries {
+		apiTrieStatistics.TopDataTries = append(apiTrieStatistics.TopDataTries, &api.DataTrieStatistics{
+			Address:   addressPubkeyConverter.Encode(dataTrie.Address),
+			RootHash:  hex.EncodeToString(dataTrie.RootHash),
+			NumNodes:  dataTrie.NumNodes,
+			TotalSize: dataTrie.TotalSize,
+		})
+	}
+
+	output, err := json.MarshalIndent(apiTrieStatistics, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+
+	return nil
+}
+
+func createPathManager(dbPathWithChainID string) (*pathmanager.PathManager, error) {
+	pathTemplateForPruningStorer := filepath.Join(
+		dbPathWithChainID,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		dbPathWithChainID,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
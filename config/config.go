@@ -9,6 +9,8 @@ type CacheConfig struct {
 	SizeInBytes          uint64
 	SizeInBytesPerSender uint32
 	Shards               uint32
+
+	ReplacementGasPriceBumpPercentage uint32
 }
 
 //HeadersPoolConfig will map the headers cache configuration
@@ -166,10 +168,13 @@ type Config struct {
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
+	TxPoolJournal         TxPoolJournalConfig
+	ESDTHolders           ESDTHoldersConfig
 	Versions              VersionsConfig
 	GasSchedule           GasScheduleConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
+	StateSnapshotImport   StateSnapshotImportConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -221,6 +226,7 @@ type GeneralSettingsConfig struct {
 	SCDeployEnableEpoch                    uint32
 	BuiltInFunctionsEnableEpoch            uint32
 	RelayedTransactionsEnableEpoch         uint32
+	RelayedTransactionsV2EnableEpoch       uint32
 	PenalizedTooMuchGasEnableEpoch         uint32
 	SwitchJailWaitingEnableEpoch           uint32
 	SwitchHysteresisForMinNodesEnableEpoch uint32
@@ -234,6 +240,10 @@ type GeneralSettingsConfig struct {
 	GenesisString                          string
 	GenesisMaxNumberOfShards               uint32
 	BlockGasAndFeesReCheckEnableEpoch      uint32
+	ESDTNFTEnableEpoch                     uint32
+	ESDTSpecialRolesEnableEpoch            uint32
+	ESDTSupplyTrackingEnableEpoch          uint32
+	ESDTMultiTransferEnableEpoch           uint32
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
@@ -378,6 +388,7 @@ type HardforkConfig struct {
 	EnableTriggerFromP2P         bool
 	MustImport                   bool
 	AfterHardFork                bool
+	ExportJSONLines              bool
 }
 
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
@@ -387,6 +398,22 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	EventsIndexStorageConfig           StorageConfig
+	AddressTransactionsIndexEnabled    bool
+	AddressTransactionsStorageConfig   StorageConfig
+}
+
+// TxPoolJournalConfig holds the configuration for the persisted transactions pool
+type TxPoolJournalConfig struct {
+	Enabled               bool
+	SnapshotIntervalInSec uint32
+	Storage               StorageConfig
+}
+
+// ESDTHoldersConfig holds the configuration for the esdt holders counting, done in background by the shard nodes
+type ESDTHoldersConfig struct {
+	Enabled              bool
+	RefreshIntervalInSec uint32
 }
 
 // DebugConfig will hold debugging configuration
@@ -468,3 +495,9 @@ type TrieSyncConfig struct {
 	NumConcurrentTrieSyncers  int
 	MaxHardCapForMissingNodes int
 }
+
+// StateSnapshotImportConfig holds the configuration of the state snapshot archive used when starting in epoch
+type StateSnapshotImportConfig struct {
+	Enabled  bool
+	FilePath string
+}
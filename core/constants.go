@@ -481,9 +481,90 @@ const BuiltInFunctionESDTPause = "ESDTPause"
 // BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
 const BuiltInFunctionESDTUnPause = "ESDTUnPause"
 
+// BuiltInFunctionESDTSetRole is the key for the elrond standard digital token set role built-in function
+const BuiltInFunctionESDTSetRole = "ESDTSetRole"
+
+// BuiltInFunctionESDTUnSetRole is the key for the elrond standard digital token unset role built-in function
+const BuiltInFunctionESDTUnSetRole = "ESDTUnSetRole"
+
+// BuiltInFunctionESDTLocalMint is the key for the elrond standard digital token local mint built-in function
+const BuiltInFunctionESDTLocalMint = "ESDTLocalMint"
+
+// BuiltInFunctionESDTLocalBurn is the key for the elrond standard digital token local burn built-in function
+const BuiltInFunctionESDTLocalBurn = "ESDTLocalBurn"
+
+// BuiltInFunctionESDTNFTCreate is the key for the elrond standard digital token NFT create built-in function
+const BuiltInFunctionESDTNFTCreate = "ESDTNFTCreate"
+
+// BuiltInFunctionESDTNFTAddQuantity is the key for the elrond standard digital token NFT add quantity built-in function
+const BuiltInFunctionESDTNFTAddQuantity = "ESDTNFTAddQuantity"
+
+// BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
+const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
+
+// BuiltInFunctionESDTNFTTransfer is the key for the elrond standard digital token NFT transfer built-in function
+const BuiltInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// BuiltInFunctionMultiESDTNFTTransfer is the key for the elrond standard digital token multi transfer built-in function
+const BuiltInFunctionMultiESDTNFTTransfer = "MultiESDTNFTTransfer"
+
+// ESDTRoleNFTCreate is the constant string for the local role of create for ESDT tokens
+const ESDTRoleNFTCreate = "ESDTRoleNFTCreate"
+
+// ESDTRoleNFTAddQuantity is the constant string for the local role of adding quantity for existing ESDT tokens
+const ESDTRoleNFTAddQuantity = "ESDTRoleNFTAddQuantity"
+
+// ESDTRoleNFTBurn is the constant string for the local role of burn for ESDT tokens
+const ESDTRoleNFTBurn = "ESDTRoleNFTBurn"
+
+// ESDTRoleLocalMint is the constant string for the local role of mint for fungible ESDT tokens
+const ESDTRoleLocalMint = "ESDTRoleLocalMint"
+
+// ESDTRoleLocalBurn is the constant string for the local role of burn for fungible ESDT tokens
+const ESDTRoleLocalBurn = "ESDTRoleLocalBurn"
+
+// ESDTType defines the possible types in case of ESDT tokens
+type ESDTType uint32
+
+const (
+	// Fungible defines the token type for ESDT fungible tokens
+	Fungible ESDTType = iota
+	// NonFungible defines the token type for ESDT non fungible tokens
+	NonFungible
+	// SemiFungible defines the token type for ESDT semi fungible tokens
+	SemiFungible
+)
+
+// FungibleESDT defines the string for the token type of fungible ESDT
+const FungibleESDT = "FungibleESDT"
+
+// NonFungibleESDT defines the string for the token type of non fungible ESDT
+const NonFungibleESDT = "NonFungibleESDT"
+
+// SemiFungibleESDT defines the string for the token type of semi fungible ESDT
+const SemiFungibleESDT = "SemiFungibleESDT"
+
+// String will convert the ESDT type to its string representation
+func (t ESDTType) String() string {
+	switch t {
+	case Fungible:
+		return FungibleESDT
+	case NonFungible:
+		return NonFungibleESDT
+	case SemiFungible:
+		return SemiFungibleESDT
+	default:
+		return "Unknown"
+	}
+}
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
+// RelayedTransactionV2 is the key for the optimized relayed transaction standard, which carries the user transaction
+// fields as arguments instead of a marshaled transaction
+const RelayedTransactionV2 = "relayedTxV2"
+
 // SCDeployInitFunctionName is the key for the function which is called at smart contract deploy time
 const SCDeployInitFunctionName = "_init"
 
@@ -508,6 +589,12 @@ const DelegationSystemSCKey = "delegation"
 // ESDTKeyIdentifier is the key prefix for esdt tokens
 const ESDTKeyIdentifier = "esdt"
 
+// ESDTRoleIdentifier is the key prefix for esdt role identifier
+const ESDTRoleIdentifier = "role"
+
+// ESDTNFTLatestNonceIdentifier is the key prefix for esdt latest nonce identifier
+const ESDTNFTLatestNonceIdentifier = "nonce"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -0,0 +1,398 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. addressTransactions.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	// DirectionSent marks a transaction sent by the indexed address
+	DirectionSent uint32 = 1
+	// DirectionReceived marks a transaction received by the indexed address
+	DirectionReceived uint32 = 2
+)
+
+const (
+	uint64Size = 8
+
+	addressTransactionsCountKind      byte = 0
+	addressTransactionEntryKind       byte = 1
+	addressTransactionIndexKind       byte = 2
+	addressTransactionsFirstEpochKind byte = 3
+)
+
+type addressTransactionKey struct {
+	address string
+	txHash  string
+}
+
+// addressTransactionsIndex indexes the transactions by their sender and receiver addresses. The records are kept per
+// epoch, in the epoch of the block containing the transaction. For each address, the index stores the number of
+// recorded transactions, each transaction under a key holding its position and the position of each transaction under
+// a key holding its hash. Each recorded epoch also holds the first epoch recorded by the index, so that the readers
+// do not walk the epochs the index has never seen.
+type addressTransactionsIndex struct {
+	marshalizer                marshal.Marshalizer
+	storer                     storage.Storer
+	transactionsStorer         storage.Storer
+	unsignedTransactionsStorer storage.Storer
+	rewardTransactionsStorer   storage.Storer
+
+	// the blocks are recorded sequentially, under the lock of the history repository
+	markedEpoch    uint32
+	hasMarkedEpoch bool
+}
+
+func newAddressTransactionsIndex(
+	storer storage.Storer,
+	transactionsStorer storage.Storer,
+	unsignedTransactionsStorer storage.Storer,
+	rewardTransactionsStorer storage.Storer,
+	marshalizer marshal.Marshalizer,
+) *addressTransactionsIndex {
+	return &addressTransactionsIndex{
+		marshalizer:                marshalizer,
+		storer:                     storer,
+		transactionsStorer:         transactionsStorer,
+		unsignedTransactionsStorer: unsignedTransactionsStorer,
+		rewardTransactionsStorer:   rewardTransactionsStorer,
+	}
+}
+
+// saveTransactions appends the transactions of the block to the records of their senders and receivers. Each
+// transaction is stored under its own key, so the cost of recording a block does not depend on the number of
+// transactions already recorded for an address.
+func (ati *addressTransactionsIndex) saveTransactions(epoch uint32, blockNonce uint64, blockHash []byte, body *block.Body) {
+	err := ati.markRecordedEpoch(epoch)
+	if err != nil {
+		log.Warn("addressTransactionsIndex.saveTransactions() cannot mark the recorded epoch",
+			"epoch", epoch, "error", err.Error())
+	}
+
+	recordsByAddress := make(map[string]*AddressTransactions)
+	entriesByKey := make(map[addressTransactionKey]*AddressTransaction)
+	addresses := make([]string, 0)
+
+	addTransaction := func(address []byte, txHash []byte, direction uint32) {
+		if len(address) == 0 {
+			return
+		}
+
+		entryKey := addressTransactionKey{address: string(address), txHash: string(txHash)}
+		entry, ok := entriesByKey[entryKey]
+		if ok {
+			entry.Direction |= direction
+			return
+		}
+
+		record, ok := recordsByAddress[string(address)]
+		if !ok {
+			record = &AddressTransactions{}
+			recordsByAddress[string(address)] = record
+			addresses = append(addresses, string(address))
+		}
+
+		entry = &AddressTransaction{
+			TxHash:     txHash,
+			BlockNonce: blockNonce,
+			BlockHash:  blockHash,
+			Direction:  direction,
+		}
+		entriesByKey[entryKey] = entry
+		record.Transactions = append(record.Transactions, entry)
+	}
+
+	for _, miniblock := range body.MiniBlocks {
+		for _, txHash := range miniblock.TxHashes {
+			tx, err := ati.getTransaction(txHash, miniblock.Type)
+			if err != nil {
+				continue
+			}
+
+			addTransaction(tx.GetSndAddr(), txHash, DirectionSent)
+			addTransaction(tx.GetRcvAddr(), txHash, DirectionReceived)
+		}
+	}
+
+	for _, address := range addresses {
+		err := ati.putTransactions([]byte(address), recordsByAddress[address], epoch)
+		if err != nil {
+			log.Warn("addressTransactionsIndex.saveTransactions() cannot save address transactions",
+				"error", err.Error())
+		}
+	}
+}
+
+// getTransaction loads a transaction of the block from the storage unit matching the miniblock type. Peer and
+// receipts miniblocks are not indexed.
+func (ati *addressTransactionsIndex) getTransaction(txHash []byte, miniblockType block.Type) (data.TransactionHandler, error) {
+	var storer storage.Storer
+	var tx data.TransactionHandler
+	switch miniblockType {
+	case block.TxBlock, block.InvalidBlock:
+		storer, tx = ati.transactionsStorer, &transaction.Transaction{}
+	case block.SmartContractResultBlock:
+		storer, tx = ati.unsignedTransactionsStorer, &smartContractResult.SmartContractResult{}
+	case block.RewardsBlock:
+		storer, tx = ati.rewardTransactionsStorer, &rewardTx.RewardTx{}
+	default:
+		return nil, errUnsupportedMiniblockType
+	}
+
+	txBytes, err := storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ati.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// putTransactions appends the new transactions after the ones already stored in the same epoch. A transaction already
+// present (e.g. seen both at source and at destination) is not duplicated, only its direction is updated. The
+// position of a recorded transaction is found by its hash, thus only the touched entries are read and written.
+func (ati *addressTransactionsIndex) putTransactions(address []byte, transactions *AddressTransactions, epoch uint32) error {
+	numTransactions, err := ati.getNumAddressTransactions(address, epoch)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range transactions.Transactions {
+		index, errGet := ati.getTransactionIndex(address, tx.TxHash, epoch)
+		if errGet == nil {
+			err = ati.updateTransactionDirection(address, index, tx.Direction, epoch)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err = ati.putEntry(createAddressTransactionKey(epoch, address, numTransactions), tx, epoch)
+		if err != nil {
+			return err
+		}
+
+		indexKey := createAddressTransactionIndexKey(epoch, address, tx.TxHash)
+		err = ati.storer.PutInEpoch(indexKey, uint64ToBytes(numTransactions), epoch)
+		if err != nil {
+			return err
+		}
+
+		numTransactions++
+	}
+
+	return ati.storer.PutInEpoch(createAddressTransactionsCountKey(epoch, address), uint64ToBytes(numTransactions), epoch)
+}
+
+func (ati *addressTransactionsIndex) updateTransactionDirection(address []byte, index uint64, direction uint32, epoch uint32) error {
+	key := createAddressTransactionKey(epoch, address, index)
+	entryBytes, err := ati.storer.GetFromEpoch(key, epoch)
+	if err != nil {
+		return err
+	}
+
+	entry := &AddressTransaction{}
+	err = ati.marshalizer.Unmarshal(entry, entryBytes)
+	if err != nil {
+		return err
+	}
+	if entry.Direction|direction == entry.Direction {
+		return nil
+	}
+
+	entry.Direction |= direction
+
+	return ati.putEntry(key, entry, epoch)
+}
+
+func (ati *addressTransactionsIndex) putEntry(key []byte, entry *AddressTransaction, epoch uint32) error {
+	entryBytes, err := ati.marshalizer.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return ati.storer.PutInEpoch(key, entryBytes, epoch)
+}
+
+func (ati *addressTransactionsIndex) getTransactionIndex(address []byte, txHash []byte, epoch uint32) (uint64, error) {
+	indexBytes, err := ati.storer.GetFromEpoch(createAddressTransactionIndexKey(epoch, address, txHash), epoch)
+	if err != nil {
+		return 0, err
+	}
+	if len(indexBytes) != uint64Size {
+		return 0, errInvalidAddressTransactionsIndex
+	}
+
+	return binary.BigEndian.Uint64(indexBytes), nil
+}
+
+// getNumAddressTransactions returns the number of transactions recorded for the address in the provided epoch, zero if
+// none was recorded. Any other storage error is returned, so that the recorded transactions are not overwritten.
+func (ati *addressTransactionsIndex) getNumAddressTransactions(address []byte, epoch uint32) (uint64, error) {
+	numTransactions, _, err := ati.getUint64(createAddressTransactionsCountKey(epoch, address), epoch)
+
+	return numTransactions, err
+}
+
+// markRecordedEpoch stores in the provided epoch the first epoch recorded by the index, carried over from the previous
+// epoch. If the previous epoch holds no marker, the index starts recording with the provided epoch.
+func (ati *addressTransactionsIndex) markRecordedEpoch(epoch uint32) error {
+	if ati.hasMarkedEpoch && ati.markedEpoch == epoch {
+		return nil
+	}
+
+	key := createAddressTransactionsFirstEpochKey(epoch)
+	_, isMarked, err := ati.getUint64(key, epoch)
+	if err != nil {
+		return err
+	}
+
+	if !isMarked {
+		firstEpoch := uint64(epoch)
+		if epoch > 0 {
+			previousFirstEpoch, isPreviousMarked, errGet := ati.getUint64(createAddressTransactionsFirstEpochKey(epoch-1), epoch-1)
+			if errGet != nil {
+				return errGet
+			}
+			if isPreviousMarked {
+				firstEpoch = previousFirstEpoch
+			}
+		}
+
+		err = ati.storer.PutInEpoch(key, uint64ToBytes(firstEpoch), epoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	ati.markedEpoch = epoch
+	ati.hasMarkedEpoch = true
+
+	return nil
+}
+
+// getFirstEpoch returns the first epoch recorded by the index, as marked in the provided epoch or, if no block was
+// recorded yet in it, in the previous one. Without a marker, no epoch older than the provided one was recorded.
+func (ati *addressTransactionsIndex) getFirstEpoch(epoch uint32) (uint32, error) {
+	firstEpoch, isMarked, err := ati.getUint64(createAddressTransactionsFirstEpochKey(epoch), epoch)
+	if err != nil || isMarked || epoch == 0 {
+		return uint32(firstEpoch), err
+	}
+
+	firstEpoch, isMarked, err = ati.getUint64(createAddressTransactionsFirstEpochKey(epoch-1), epoch-1)
+	if err != nil {
+		return 0, err
+	}
+	if !isMarked {
+		return epoch, nil
+	}
+
+	return uint32(firstEpoch), nil
+}
+
+// getUint64 reads a value stored in the provided epoch. A missing key is not an error, it is reported as not found.
+func (ati *addressTransactionsIndex) getUint64(key []byte, epoch uint32) (uint64, bool, error) {
+	err := ati.storer.HasInEpoch(key, epoch)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	valueBytes, err := ati.storer.GetFromEpoch(key, epoch)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(valueBytes) != uint64Size {
+		return 0, false, errInvalidAddressTransactionsIndex
+	}
+
+	return binary.BigEndian.Uint64(valueBytes), true, nil
+}
+
+// getAddressTransactions returns the transactions recorded for the address in the provided epoch, having their
+// positions in the [fromIndex, toIndex) range, in the order they were recorded. All of them are read in bulk.
+func (ati *addressTransactionsIndex) getAddressTransactions(address []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*AddressTransactions, error) {
+	if fromIndex >= toIndex {
+		return &AddressTransactions{}, nil
+	}
+
+	keys := make([][]byte, 0, toIndex-fromIndex)
+	for index := fromIndex; index < toIndex; index++ {
+		keys = append(keys, createAddressTransactionKey(epoch, address, index))
+	}
+
+	entriesBytes, err := ati.storer.GetBulkFromEpoch(keys, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &AddressTransactions{
+		Transactions: make([]*AddressTransaction, 0, len(keys)),
+	}
+	for _, key := range keys {
+		entryBytes, ok := entriesBytes[string(key)]
+		if !ok {
+			continue
+		}
+
+		entry := &AddressTransaction{}
+		err = ati.marshalizer.Unmarshal(entry, entryBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		record.Transactions = append(record.Transactions, entry)
+	}
+
+	return record, nil
+}
+
+// the keys are prefixed by the epoch, as for the events index, so that the pruning storer cache does not mix epochs,
+// followed by the kind of the key, so that the counter, the entries and the positions by hash of an address do not
+// collide
+func createAddressTransactionsKey(epoch uint32, kind byte, address []byte, suffix []byte) []byte {
+	key := make([]byte, 5, 5+len(address)+len(suffix))
+	binary.BigEndian.PutUint32(key, epoch)
+	key[4] = kind
+	key = append(key, address...)
+	return append(key, suffix...)
+}
+
+func createAddressTransactionsCountKey(epoch uint32, address []byte) []byte {
+	return createAddressTransactionsKey(epoch, addressTransactionsCountKind, address, nil)
+}
+
+func createAddressTransactionKey(epoch uint32, address []byte, index uint64) []byte {
+	return createAddressTransactionsKey(epoch, addressTransactionEntryKind, address, uint64ToBytes(index))
+}
+
+func createAddressTransactionIndexKey(epoch uint32, address []byte, txHash []byte) []byte {
+	return createAddressTransactionsKey(epoch, addressTransactionIndexKind, address, txHash)
+}
+
+func createAddressTransactionsFirstEpochKey(epoch uint32) []byte {
+	return createAddressTransactionsKey(epoch, addressTransactionsFirstEpochKind, nil, nil)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(buff, value)
+	return buff
+}
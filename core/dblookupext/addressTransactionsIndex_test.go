@@ -0,0 +1,204 @@
+package dblookupext
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressTransactionsIndex_GetAddressTransactionsNotFoundShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	index := newAddressTransactionsIndex(
+		genericMocks.NewStorerMock("AddressTransactions", 0),
+		genericMocks.NewStorerMock("Transactions", 0),
+		genericMocks.NewStorerMock("UnsignedTransactions", 0),
+		genericMocks.NewStorerMock("RewardTransactions", 0),
+		&mock.MarshalizerMock{},
+	)
+
+	numTransactions, err := index.getNumAddressTransactions([]byte("alice"), 0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), numTransactions)
+
+	record, err := index.getAddressTransactions([]byte("alice"), 0, 0, 10)
+	require.Nil(t, err)
+	require.Empty(t, record.Transactions)
+}
+
+func TestAddressTransactionsIndex_SaveAndGetAddressTransactions(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(3)
+	marshalizer := &mock.MarshalizerMock{}
+	txsStorer := genericMocks.NewStorerMock("Transactions", epoch)
+	scrsStorer := genericMocks.NewStorerMock("UnsignedTransactions", epoch)
+	rewardsStorer := genericMocks.NewStorerMock("RewardTransactions", epoch)
+	index := newAddressTransactionsIndex(
+		genericMocks.NewStorerMock("AddressTransactions", epoch),
+		txsStorer,
+		scrsStorer,
+		rewardsStorer,
+		marshalizer,
+	)
+
+	alice, bob := []byte("alice"), []byte("bob")
+	putMarshalized := func(storer *genericMocks.StorerMock, key []byte, obj interface{}) {
+		buff, _ := marshalizer.Marshal(obj)
+		_ = storer.Put(key, buff)
+	}
+	putMarshalized(txsStorer, []byte("txA"), &transaction.Transaction{SndAddr: alice, RcvAddr: bob, Value: big.NewInt(1)})
+	putMarshalized(txsStorer, []byte("txSelf"), &transaction.Transaction{SndAddr: alice, RcvAddr: alice, Value: big.NewInt(0)})
+	putMarshalized(scrsStorer, []byte("scr"), &smartContractResult.SmartContractResult{SndAddr: bob, RcvAddr: alice, Value: big.NewInt(1)})
+	putMarshalized(rewardsStorer, []byte("reward"), &rewardTx.RewardTx{RcvAddr: bob, Value: big.NewInt(1)})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txA"), []byte("missing tx")}, Type: block.TxBlock},
+			{TxHashes: [][]byte{[]byte("reward")}, Type: block.RewardsBlock},
+			{TxHashes: [][]byte{[]byte("txA")}, Type: block.PeerBlock},
+		},
+	}
+	index.saveTransactions(epoch, 7, []byte("block7"), body)
+
+	// a transaction seen again (e.g. at destination) is not duplicated
+	body = &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txA"), []byte("txSelf")}, Type: block.TxBlock},
+			{TxHashes: [][]byte{[]byte("scr")}, Type: block.SmartContractResultBlock},
+		},
+	}
+	index.saveTransactions(epoch, 8, []byte("block8"), body)
+
+	numTransactions, err := index.getNumAddressTransactions(alice, epoch)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), numTransactions)
+
+	record, err := index.getAddressTransactions(alice, epoch, 0, numTransactions)
+	require.Nil(t, err)
+	require.Equal(t, []*AddressTransaction{
+		{TxHash: []byte("txA"), BlockNonce: 7, BlockHash: []byte("block7"), Direction: DirectionSent},
+		{TxHash: []byte("txSelf"), BlockNonce: 8, BlockHash: []byte("block8"), Direction: DirectionSent | DirectionReceived},
+		{TxHash: []byte("scr"), BlockNonce: 8, BlockHash: []byte("block8"), Direction: DirectionReceived},
+	}, record.Transactions)
+
+	record, err = index.getAddressTransactions(bob, epoch, 0, 3)
+	require.Nil(t, err)
+	require.Equal(t, []*AddressTransaction{
+		{TxHash: []byte("txA"), BlockNonce: 7, BlockHash: []byte("block7"), Direction: DirectionReceived},
+		{TxHash: []byte("reward"), BlockNonce: 7, BlockHash: []byte("block7"), Direction: DirectionReceived},
+		{TxHash: []byte("scr"), BlockNonce: 8, BlockHash: []byte("block8"), Direction: DirectionSent},
+	}, record.Transactions)
+
+	record, err = index.getAddressTransactions(bob, epoch, 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, []*AddressTransaction{
+		{TxHash: []byte("reward"), BlockNonce: 7, BlockHash: []byte("block7"), Direction: DirectionReceived},
+	}, record.Transactions)
+
+	numTransactions, err = index.getNumAddressTransactions(alice, epoch+1)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), numTransactions)
+}
+
+func TestAddressTransactionsIndex_CountReadErrorShouldNotOverwriteTransactions(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	alice := []byte("alice")
+	countKey := createAddressTransactionsCountKey(epoch, alice)
+	expectedErr := errors.New("expected error")
+	marshalizer := &mock.MarshalizerMock{}
+	txsStorer := genericMocks.NewStorerMock("Transactions", epoch)
+	txBytes, _ := marshalizer.Marshal(&transaction.Transaction{SndAddr: alice, Value: big.NewInt(1)})
+	_ = txsStorer.Put([]byte("txA"), txBytes)
+
+	putKeys := make(map[string]struct{})
+	storer := &mock.StorerStub{
+		HasInEpochCalled: func(key []byte, _ uint32) error {
+			if bytes.Equal(key, countKey) {
+				return expectedErr
+			}
+			return storage.ErrKeyNotFound
+		},
+		GetFromEpochCalled: func(_ []byte, _ uint32) ([]byte, error) {
+			return nil, storage.ErrKeyNotFound
+		},
+		PutInEpochCalled: func(key, _ []byte, _ uint32) error {
+			putKeys[string(key)] = struct{}{}
+			return nil
+		},
+	}
+	index := newAddressTransactionsIndex(
+		storer,
+		txsStorer,
+		genericMocks.NewStorerMock("UnsignedTransactions", epoch),
+		genericMocks.NewStorerMock("RewardTransactions", epoch),
+		marshalizer,
+	)
+
+	numTransactions, err := index.getNumAddressTransactions(alice, epoch)
+	require.Equal(t, expectedErr, err)
+	require.Equal(t, uint64(0), numTransactions)
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txA")}, Type: block.TxBlock},
+		},
+	}
+	index.saveTransactions(epoch, 7, []byte("block7"), body)
+	require.NotContains(t, putKeys, string(countKey))
+	require.NotContains(t, putKeys, string(createAddressTransactionKey(epoch, alice, 0)))
+}
+
+func TestAddressTransactionsIndex_FirstEpochShouldBeCarriedOver(t *testing.T) {
+	t.Parallel()
+
+	storer := genericMocks.NewStorerMock("AddressTransactions", 0)
+	index := newAddressTransactionsIndex(
+		storer,
+		genericMocks.NewStorerMock("Transactions", 0),
+		genericMocks.NewStorerMock("UnsignedTransactions", 0),
+		genericMocks.NewStorerMock("RewardTransactions", 0),
+		&mock.MarshalizerMock{},
+	)
+
+	// nothing recorded yet
+	firstEpoch, err := index.getFirstEpoch(4)
+	require.Nil(t, err)
+	require.Equal(t, uint32(4), firstEpoch)
+
+	// the index starts recording in epoch 3, e.g. the node was started from an epoch 3 snapshot
+	index.saveTransactions(3, 100, []byte("block100"), &block.Body{})
+	index.saveTransactions(3, 101, []byte("block101"), &block.Body{})
+	index.saveTransactions(4, 200, []byte("block200"), &block.Body{})
+	index.saveTransactions(5, 300, []byte("block300"), &block.Body{})
+
+	for epoch := uint32(3); epoch <= 5; epoch++ {
+		firstEpoch, err = index.getFirstEpoch(epoch)
+		require.Nil(t, err)
+		require.Equal(t, uint32(3), firstEpoch)
+	}
+
+	// no block recorded yet in epoch 6, the marker of the previous epoch is used
+	firstEpoch, err = index.getFirstEpoch(6)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), firstEpoch)
+
+	// a marker of another epoch is not rewritten
+	_ = storer.PutInEpoch(createAddressTransactionsFirstEpochKey(7), uint64ToBytes(1), 7)
+	index.saveTransactions(7, 400, []byte("block400"), &block.Body{})
+	firstEpoch, err = index.getFirstEpoch(7)
+	require.Nil(t, err)
+	require.Equal(t, uint32(1), firstEpoch)
+}
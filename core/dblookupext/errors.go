@@ -8,6 +8,15 @@ import (
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
+var errUnsupportedMiniblockType = errors.New("unsupported miniblock type")
+
+var errInvalidAddressTransactionsIndex = errors.New("invalid address transactions index")
+
+var errInvalidEventsIndex = errors.New("invalid events index")
+
+// ErrAddressTransactionsIndexNotEnabled signals that the optional address transactions index is not enabled
+var ErrAddressTransactionsIndexNotEnabled = errors.New("address transactions index is not enabled")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
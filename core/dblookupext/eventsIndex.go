@@ -0,0 +1,234 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. eventsIndex.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	eventLocationsCountKind   byte = 0
+	eventLocationKind         byte = 1
+	eventLocationPresenceKind byte = 2
+)
+
+// eventsIndex indexes the events found in the transactions logs by their emitter address and identifier. The
+// locations are kept per epoch, in the epoch of the block containing the transaction. For each emitter address and
+// identifier, the index stores the number of recorded locations and each location under a key holding its position.
+// The blocks are recorded in order, thus the locations of an epoch are sorted by block nonce.
+type eventsIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+	logsStorer  storage.Storer
+}
+
+func newEventsIndex(storer storage.Storer, logsStorer storage.Storer, marshalizer marshal.Marshalizer) *eventsIndex {
+	return &eventsIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+		logsStorer:  logsStorer,
+	}
+}
+
+func (ei *eventsIndex) saveEvents(epoch uint32, blockNonce uint64, blockHash []byte, body *block.Body) {
+	locationsByKey := make(map[string]*EventLocations)
+
+	for _, miniblock := range body.MiniBlocks {
+		if miniblock.Type == block.PeerBlock {
+			continue
+		}
+
+		for _, txHash := range miniblock.TxHashes {
+			txLog, err := ei.getLog(txHash)
+			if err != nil {
+				continue
+			}
+
+			for index, event := range txLog.Events {
+				key := string(createEventsIndexKey(epoch, event.Address, event.Identifier))
+				if _, ok := locationsByKey[key]; !ok {
+					locationsByKey[key] = &EventLocations{}
+				}
+
+				locationsByKey[key].Locations = append(locationsByKey[key].Locations, &EventLocation{
+					TxHash:     txHash,
+					BlockNonce: blockNonce,
+					BlockHash:  blockHash,
+					EventIndex: uint32(index),
+				})
+			}
+		}
+	}
+
+	for key, locations := range locationsByKey {
+		err := ei.putLocations([]byte(key), locations, epoch)
+		if err != nil {
+			log.Warn("eventsIndex.saveEvents() cannot save event locations",
+				"error", err.Error())
+		}
+	}
+}
+
+func (ei *eventsIndex) getLog(txHash []byte) (*transaction.Log, error) {
+	logBytes, err := ei.logsStorer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	txLog := &transaction.Log{}
+	err = ei.marshalizer.Unmarshal(txLog, logBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return txLog, nil
+}
+
+// putLocations appends the new locations after the ones already stored in the same epoch. Each location is stored
+// under its own key, so the cost of recording a block does not depend on the number of locations already recorded. A
+// location already present (the same transaction appearing in more miniblocks of the shard, e.g. at source and at
+// destination) is not duplicated, its presence being marked by a key holding the transaction hash and the event index.
+func (ei *eventsIndex) putLocations(key []byte, locations *EventLocations, epoch uint32) error {
+	numLocations, err := ei.getNumLocations(key, epoch)
+	if err != nil {
+		return err
+	}
+
+	for _, location := range locations.Locations {
+		presenceKey := createEventLocationPresenceKey(key, location)
+		if ei.storer.HasInEpoch(presenceKey, epoch) == nil {
+			continue
+		}
+
+		locationBytes, errMarshal := ei.marshalizer.Marshal(location)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		err = ei.storer.PutInEpoch(createEventLocationKey(key, numLocations), locationBytes, epoch)
+		if err != nil {
+			return err
+		}
+
+		err = ei.storer.PutInEpoch(presenceKey, []byte{eventLocationPresenceKind}, epoch)
+		if err != nil {
+			return err
+		}
+
+		numLocations++
+	}
+
+	return ei.storer.PutInEpoch(createEventLocationsCountKey(key), uint64ToBytes(numLocations), epoch)
+}
+
+// getNumLocations returns the number of locations stored under the provided key, zero if none was recorded. Any other
+// storage error is returned, so that the recorded locations are not overwritten.
+func (ei *eventsIndex) getNumLocations(key []byte, epoch uint32) (uint64, error) {
+	countKey := createEventLocationsCountKey(key)
+	err := ei.storer.HasInEpoch(countKey, epoch)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	countBytes, err := ei.storer.GetFromEpoch(countKey, epoch)
+	if err != nil {
+		return 0, err
+	}
+	if len(countBytes) != uint64Size {
+		return 0, errInvalidEventsIndex
+	}
+
+	return binary.BigEndian.Uint64(countBytes), nil
+}
+
+// getLocations reads the locations stored under the provided key, having their positions in the [fromIndex, toIndex)
+// range, in bulk, in the order they were recorded
+func (ei *eventsIndex) getLocations(key []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*EventLocations, error) {
+	if fromIndex >= toIndex {
+		return &EventLocations{}, nil
+	}
+
+	keys := make([][]byte, 0, toIndex-fromIndex)
+	for index := fromIndex; index < toIndex; index++ {
+		keys = append(keys, createEventLocationKey(key, index))
+	}
+
+	locationsBytes, err := ei.storer.GetBulkFromEpoch(keys, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &EventLocations{
+		Locations: make([]*EventLocation, 0, len(keys)),
+	}
+	for _, locationKey := range keys {
+		locationBytes, ok := locationsBytes[string(locationKey)]
+		if !ok {
+			continue
+		}
+
+		location := &EventLocation{}
+		err = ei.marshalizer.Unmarshal(location, locationBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		record.Locations = append(record.Locations, location)
+	}
+
+	return record, nil
+}
+
+func (ei *eventsIndex) getNumEventLocations(address []byte, identifier []byte, epoch uint32) (uint64, error) {
+	return ei.getNumLocations(createEventsIndexKey(epoch, address, identifier), epoch)
+}
+
+func (ei *eventsIndex) getEventLocations(address []byte, identifier []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*EventLocations, error) {
+	return ei.getLocations(createEventsIndexKey(epoch, address, identifier), epoch, fromIndex, toIndex)
+}
+
+// The key is prefixed by the epoch because the cache of the pruning storer does not take the epoch into account, thus the
+// same key would mix the records of different epochs. The addresses have a fixed length and the identifier is preceded
+// by its length, so the concatenation is not ambiguous and can be further suffixed.
+func createEventsIndexKey(epoch uint32, address []byte, identifier []byte) []byte {
+	key := make([]byte, 4, 8+len(address)+len(identifier))
+	binary.BigEndian.PutUint32(key, epoch)
+	key = append(key, address...)
+	key = append(key, uint32ToBytes(uint32(len(identifier)))...)
+	return append(key, identifier...)
+}
+
+func createEventLocationsCountKey(eventsKey []byte) []byte {
+	return appendToEventsIndexKey(eventsKey, eventLocationsCountKind, nil)
+}
+
+func createEventLocationKey(eventsKey []byte, index uint64) []byte {
+	return appendToEventsIndexKey(eventsKey, eventLocationKind, uint64ToBytes(index))
+}
+
+func createEventLocationPresenceKey(eventsKey []byte, location *EventLocation) []byte {
+	suffix := append(uint32ToBytes(location.EventIndex), location.TxHash...)
+	return appendToEventsIndexKey(eventsKey, eventLocationPresenceKind, suffix)
+}
+
+func appendToEventsIndexKey(eventsKey []byte, kind byte, suffix []byte) []byte {
+	key := make([]byte, 0, len(eventsKey)+1+len(suffix))
+	key = append(key, eventsKey...)
+	key = append(key, kind)
+	return append(key, suffix...)
+}
+
+func uint32ToBytes(value uint32) []byte {
+	buff := make([]byte, 4)
+	binary.BigEndian.PutUint32(buff, value)
+	return buff
+}
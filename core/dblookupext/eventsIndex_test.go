@@ -0,0 +1,189 @@
+package dblookupext
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsIndex_GetEventLocationsNotFoundShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsIndex(
+		genericMocks.NewStorerMock("EventsIndex", 0),
+		genericMocks.NewStorerMock("TxLogs", 0),
+		&mock.MarshalizerMock{},
+	)
+
+	numLocations, err := index.getNumEventLocations([]byte("address"), []byte("identifier"), 0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), numLocations)
+
+	locations, err := index.getEventLocations([]byte("address"), []byte("identifier"), 0, 0, 10)
+	require.Nil(t, err)
+	require.Empty(t, locations.Locations)
+}
+
+func TestEventsIndex_SaveAndGetEventLocations(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	marshalizer := &mock.MarshalizerMock{}
+	logsStorer := genericMocks.NewStorerMock("TxLogs", epoch)
+	index := newEventsIndex(genericMocks.NewStorerMock("EventsIndex", epoch), logsStorer, marshalizer)
+
+	contract := []byte("contract")
+	txLog := &transaction.Log{
+		Address: contract,
+		Events: []*transaction.Event{
+			{Address: contract, Identifier: []byte("deposit")},
+			{Address: []byte("other contract"), Identifier: []byte("deposit")},
+			{Address: contract, Identifier: []byte("deposit")},
+		},
+	}
+	logBytes, _ := marshalizer.Marshal(txLog)
+	_ = logsStorer.Put([]byte("txA"), logBytes)
+	_ = logsStorer.Put([]byte("txB"), logBytes)
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txA"), []byte("tx without logs")}},
+			{TxHashes: [][]byte{[]byte("txB")}, Type: block.PeerBlock},
+		},
+	}
+	index.saveEvents(epoch, 7, []byte("block7"), body)
+
+	// the same transaction in a later block (e.g. at destination) does not duplicate the locations
+	body = &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txA"), []byte("txB")}},
+		},
+	}
+	index.saveEvents(epoch, 8, []byte("block8"), body)
+
+	numLocations, err := index.getNumEventLocations(contract, []byte("deposit"), epoch)
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), numLocations)
+
+	locations, err := index.getEventLocations(contract, []byte("deposit"), epoch, 0, numLocations)
+	require.Nil(t, err)
+	require.Equal(t, []*EventLocation{
+		{TxHash: []byte("txA"), BlockNonce: 7, BlockHash: []byte("block7"), EventIndex: 0},
+		{TxHash: []byte("txA"), BlockNonce: 7, BlockHash: []byte("block7"), EventIndex: 2},
+		{TxHash: []byte("txB"), BlockNonce: 8, BlockHash: []byte("block8"), EventIndex: 0},
+		{TxHash: []byte("txB"), BlockNonce: 8, BlockHash: []byte("block8"), EventIndex: 2},
+	}, locations.Locations)
+
+	locations, err = index.getEventLocations(contract, []byte("deposit"), epoch, 1, 3)
+	require.Nil(t, err)
+	require.Equal(t, []*EventLocation{
+		{TxHash: []byte("txA"), BlockNonce: 7, BlockHash: []byte("block7"), EventIndex: 2},
+		{TxHash: []byte("txB"), BlockNonce: 8, BlockHash: []byte("block8"), EventIndex: 0},
+	}, locations.Locations)
+
+	numLocations, err = index.getNumEventLocations([]byte("other contract"), []byte("deposit"), epoch)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), numLocations)
+
+	numLocations, err = index.getNumEventLocations(contract, []byte("deposit"), epoch+1)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), numLocations)
+}
+
+func TestEventsIndex_SameEmitterAndIdentifierInDifferentEpochsShouldNotMix(t *testing.T) {
+	t.Parallel()
+
+	// the storer ignores the epoch, as the cache of the pruning storer does
+	records := make(map[string][]byte)
+	storer := &mock.StorerStub{
+		PutInEpochCalled: func(key, data []byte, _ uint32) error {
+			records[string(key)] = data
+			return nil
+		},
+		GetFromEpochCalled: func(key []byte, _ uint32) ([]byte, error) {
+			data, ok := records[string(key)]
+			if !ok {
+				return nil, storage.ErrKeyNotFound
+			}
+			return data, nil
+		},
+		HasInEpochCalled: func(key []byte, _ uint32) error {
+			_, ok := records[string(key)]
+			if !ok {
+				return storage.ErrKeyNotFound
+			}
+			return nil
+		},
+		GetBulkFromEpochCalled: func(keys [][]byte, _ uint32) (map[string][]byte, error) {
+			result := make(map[string][]byte)
+			for _, key := range keys {
+				data, ok := records[string(key)]
+				if ok {
+					result[string(key)] = data
+				}
+			}
+			return result, nil
+		},
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	logsStorer := genericMocks.NewStorerMock("TxLogs", 0)
+	index := newEventsIndex(storer, logsStorer, marshalizer)
+
+	contract := []byte("contract")
+	logBytes, _ := marshalizer.Marshal(&transaction.Log{
+		Address: contract,
+		Events:  []*transaction.Event{{Address: contract, Identifier: []byte("deposit")}},
+	})
+	_ = logsStorer.Put([]byte("txA"), logBytes)
+	_ = logsStorer.Put([]byte("txB"), logBytes)
+
+	index.saveEvents(1, 7, []byte("block7"), &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txA")}}}})
+	index.saveEvents(2, 8, []byte("block8"), &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txB")}}}})
+
+	locations, err := index.getEventLocations(contract, []byte("deposit"), 1, 0, 1)
+	require.Nil(t, err)
+	require.Equal(t, []*EventLocation{{TxHash: []byte("txA"), BlockNonce: 7, BlockHash: []byte("block7")}}, locations.Locations)
+
+	locations, err = index.getEventLocations(contract, []byte("deposit"), 2, 0, 1)
+	require.Nil(t, err)
+	require.Equal(t, []*EventLocation{{TxHash: []byte("txB"), BlockNonce: 8, BlockHash: []byte("block8")}}, locations.Locations)
+}
+
+func TestEventsIndex_CountReadErrorShouldNotOverwriteLocations(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	putKeys := make(map[string]struct{})
+	storer := &mock.StorerStub{
+		HasInEpochCalled: func(_ []byte, _ uint32) error {
+			return expectedErr
+		},
+		PutInEpochCalled: func(key, _ []byte, _ uint32) error {
+			putKeys[string(key)] = struct{}{}
+			return nil
+		},
+	}
+	marshalizer := &mock.MarshalizerMock{}
+	logsStorer := genericMocks.NewStorerMock("TxLogs", 0)
+	index := newEventsIndex(storer, logsStorer, marshalizer)
+
+	contract := []byte("contract")
+	logBytes, _ := marshalizer.Marshal(&transaction.Log{
+		Address: contract,
+		Events:  []*transaction.Event{{Address: contract, Identifier: []byte("deposit")}},
+	})
+	_ = logsStorer.Put([]byte("txA"), logBytes)
+
+	numLocations, err := index.getNumEventLocations(contract, []byte("deposit"), 0)
+	require.Equal(t, expectedErr, err)
+	require.Equal(t, uint64(0), numLocations)
+
+	index.saveEvents(0, 7, []byte("block7"), &block.Body{MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("txA")}}}})
+	require.Empty(t, putKeys)
+}
@@ -63,6 +63,13 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		EventsIndexStorer:           hpf.store.GetStorer(dataRetriever.EventsIndexUnit),
+		TxLogsStorer:                hpf.store.GetStorer(dataRetriever.TxLogsUnit),
+		AddressTransactionsEnabled:  hpf.dbLookupExtensionsConfig.AddressTransactionsIndexEnabled,
+		AddressTransactionsStorer:   hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit),
+		TransactionsStorer:          hpf.store.GetStorer(dataRetriever.TransactionUnit),
+		UnsignedTransactionsStorer:  hpf.store.GetStorer(dataRetriever.UnsignedTransactionUnit),
+		RewardTransactionsStorer:    hpf.store.GetStorer(dataRetriever.RewardTransactionUnit),
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
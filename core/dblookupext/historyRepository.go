@@ -29,6 +29,13 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	EventsIndexStorer           storage.Storer
+	TxLogsStorer                storage.Storer
+	AddressTransactionsEnabled  bool
+	AddressTransactionsStorer   storage.Storer
+	TransactionsStorer          storage.Storer
+	UnsignedTransactionsStorer  storage.Storer
+	RewardTransactionsStorer    storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 }
@@ -39,6 +46,8 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	eventsIndex                *eventsIndex
+	addressTransactionsIndex   *addressTransactionsIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -81,11 +90,43 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(arguments.EventsIndexStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(arguments.TxLogsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if arguments.AddressTransactionsEnabled {
+		if check.IfNil(arguments.AddressTransactionsStorer) {
+			return nil, core.ErrNilStore
+		}
+		if check.IfNil(arguments.TransactionsStorer) {
+			return nil, core.ErrNilStore
+		}
+		if check.IfNil(arguments.UnsignedTransactionsStorer) {
+			return nil, core.ErrNilStore
+		}
+		if check.IfNil(arguments.RewardTransactionsStorer) {
+			return nil, core.ErrNilStore
+		}
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
+	eventsIndexByAddressAndIdentifier := newEventsIndex(arguments.EventsIndexStorer, arguments.TxLogsStorer, arguments.Marshalizer)
+
+	var transactionsIndexByAddress *addressTransactionsIndex
+	if arguments.AddressTransactionsEnabled {
+		transactionsIndexByAddress = newAddressTransactionsIndex(
+			arguments.AddressTransactionsStorer,
+			arguments.TransactionsStorer,
+			arguments.UnsignedTransactionsStorer,
+			arguments.RewardTransactionsStorer,
+			arguments.Marshalizer,
+		)
+	}
 
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
@@ -99,6 +140,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		eventsIndex:                                  eventsIndexByAddressAndIdentifier,
+		addressTransactionsIndex:                     transactionsIndexByAddress,
 	}, nil
 }
 
@@ -139,6 +182,11 @@ func (hr *historyRepository) RecordBlock(
 		}
 	}
 
+	hr.eventsIndex.saveEvents(epoch, blockHeader.GetNonce(), blockHeaderHash, body)
+	if hr.addressTransactionsIndex != nil {
+		hr.addressTransactionsIndex.saveTransactions(epoch, blockHeader.GetNonce(), blockHeaderHash, body)
+	}
+
 	err = hr.eventsHashesByTxHashIndex.saveResultsHashes(epoch, scrResultsFromPool, receiptsFromPool)
 	if err != nil {
 		return err
@@ -427,6 +475,50 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// GetNumEventLocations will return the number of locations of the events emitted by the provided address, having the
+// provided identifier, recorded in the provided epoch
+func (hr *historyRepository) GetNumEventLocations(address []byte, identifier []byte, epoch uint32) (uint64, error) {
+	return hr.eventsIndex.getNumEventLocations(address, identifier, epoch)
+}
+
+// GetEventLocations will return the locations of the events emitted by the provided address, having the provided
+// identifier, recorded in the provided epoch, having their positions in the [fromIndex, toIndex) range. The locations
+// of an epoch are sorted by block nonce.
+func (hr *historyRepository) GetEventLocations(address []byte, identifier []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*EventLocations, error) {
+	return hr.eventsIndex.getEventLocations(address, identifier, epoch, fromIndex, toIndex)
+}
+
+// GetNumAddressTransactions will return the number of transactions sent or received by the provided address, recorded
+// in the provided epoch. It errors if the optional address transactions index is not enabled.
+func (hr *historyRepository) GetNumAddressTransactions(address []byte, epoch uint32) (uint64, error) {
+	if hr.addressTransactionsIndex == nil {
+		return 0, ErrAddressTransactionsIndexNotEnabled
+	}
+
+	return hr.addressTransactionsIndex.getNumAddressTransactions(address, epoch)
+}
+
+// GetAddressTransactions will return the transactions sent or received by the provided address, recorded in the
+// provided epoch, having their positions in the [fromIndex, toIndex) range. It errors if the optional address
+// transactions index is not enabled.
+func (hr *historyRepository) GetAddressTransactions(address []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*AddressTransactions, error) {
+	if hr.addressTransactionsIndex == nil {
+		return nil, ErrAddressTransactionsIndexNotEnabled
+	}
+
+	return hr.addressTransactionsIndex.getAddressTransactions(address, epoch, fromIndex, toIndex)
+}
+
+// GetAddressTransactionsFirstEpoch will return the first epoch recorded by the address transactions index, as known in
+// the provided epoch. It errors if the optional address transactions index is not enabled.
+func (hr *historyRepository) GetAddressTransactionsFirstEpoch(epoch uint32) (uint32, error) {
+	if hr.addressTransactionsIndex == nil {
+		return 0, ErrAddressTransactionsIndexNotEnabled
+	}
+
+	return hr.addressTransactionsIndex.getFirstEpoch(epoch)
+}
+
 // IsEnabled will always returns true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
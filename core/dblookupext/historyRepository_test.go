@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,13 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		MiniblockHashByTxHashStorer: genericMocks.NewStorerMock("MiniblockHashByTxHash", epoch),
 		EpochByHashStorer:           genericMocks.NewStorerMock("EpochByHash", epoch),
 		EventsHashesByTxHashStorer:  genericMocks.NewStorerMock("EventsHashesByTxHash", epoch),
+		EventsIndexStorer:           genericMocks.NewStorerMock("EventsIndex", epoch),
+		TxLogsStorer:                genericMocks.NewStorerMock("TxLogs", epoch),
+		AddressTransactionsEnabled:  true,
+		AddressTransactionsStorer:   genericMocks.NewStorerMock("AddressTransactions", epoch),
+		TransactionsStorer:          genericMocks.NewStorerMock("Transactions", epoch),
+		UnsignedTransactionsStorer:  genericMocks.NewStorerMock("UnsignedTransactions", epoch),
+		RewardTransactionsStorer:    genericMocks.NewStorerMock("RewardTransactions", epoch),
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &mock.HasherMock{},
 	}
@@ -55,6 +63,52 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilStore, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.EventsIndexStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.TxLogsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.AddressTransactionsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.TransactionsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.UnsignedTransactionsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.RewardTransactionsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.AddressTransactionsEnabled = false
+	args.AddressTransactionsStorer = nil
+	args.TransactionsStorer = nil
+	args.UnsignedTransactionsStorer = nil
+	args.RewardTransactionsStorer = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+	require.NotNil(t, repo)
+
 	args = createMockHistoryRepoArgs(0)
 	args.Hasher = nil
 	repo, err = NewHistoryRepository(args)
@@ -621,6 +675,53 @@ func TestHistoryRepository_getMiniblockMetadataByMiniblockHashGetFromEpochErrors
 	assert.Equal(t, err, expectedErr)
 }
 
+func TestHistoryRepository_GetAddressTransactionsWhenIndexNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	args.AddressTransactionsEnabled = false
+	repo, _ := NewHistoryRepository(args)
+
+	numTransactions, err := repo.GetNumAddressTransactions([]byte("alice"), 0)
+	assert.Equal(t, uint64(0), numTransactions)
+	assert.Equal(t, ErrAddressTransactionsIndexNotEnabled, err)
+
+	record, err := repo.GetAddressTransactions([]byte("alice"), 0, 0, 1)
+	assert.Nil(t, record)
+	assert.Equal(t, ErrAddressTransactionsIndexNotEnabled, err)
+
+	firstEpoch, err := repo.GetAddressTransactionsFirstEpoch(0)
+	assert.Equal(t, uint32(0), firstEpoch)
+	assert.Equal(t, ErrAddressTransactionsIndexNotEnabled, err)
+}
+
+func TestHistoryRepository_RecordBlockShouldIndexAddressTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")})
+	_ = args.TransactionsStorer.Put([]byte("txA"), txBytes)
+	repo, _ := NewHistoryRepository(args)
+
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txA")}, Type: block.TxBlock},
+		},
+	}
+	err := repo.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 4}, blockBody, nil, nil)
+	require.Nil(t, err)
+
+	numTransactions, err := repo.GetNumAddressTransactions([]byte("bob"), 0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), numTransactions)
+
+	record, err := repo.GetAddressTransactions([]byte("bob"), 0, 0, numTransactions)
+	require.Nil(t, err)
+	require.Equal(t, []*AddressTransaction{
+		{TxHash: []byte("txA"), BlockNonce: 4, BlockHash: []byte("headerHash"), Direction: DirectionReceived},
+	}, record.Transactions)
+}
+
 func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes_Loop(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t)
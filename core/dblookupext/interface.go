@@ -23,6 +23,11 @@ type HistoryRepository interface {
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetNumEventLocations(address []byte, identifier []byte, epoch uint32) (uint64, error)
+	GetEventLocations(address []byte, identifier []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*EventLocations, error)
+	GetNumAddressTransactions(address []byte, epoch uint32) (uint64, error)
+	GetAddressTransactions(address []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*AddressTransactions, error)
+	GetAddressTransactionsFirstEpoch(epoch uint32) (uint32, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
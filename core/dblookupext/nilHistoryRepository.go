@@ -41,6 +41,31 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetNumEventLocations -
+func (nhr *nilHistoryRepository) GetNumEventLocations(_ []byte, _ []byte, _ uint32) (uint64, error) {
+	return 0, nil
+}
+
+// GetEventLocations -
+func (nhr *nilHistoryRepository) GetEventLocations(_ []byte, _ []byte, _ uint32, _ uint64, _ uint64) (*EventLocations, error) {
+	return nil, nil
+}
+
+// GetNumAddressTransactions -
+func (nhr *nilHistoryRepository) GetNumAddressTransactions(_ []byte, _ uint32) (uint64, error) {
+	return 0, nil
+}
+
+// GetAddressTransactions -
+func (nhr *nilHistoryRepository) GetAddressTransactions(_ []byte, _ uint32, _ uint64, _ uint64) (*AddressTransactions, error) {
+	return nil, nil
+}
+
+// GetAddressTransactionsFirstEpoch -
+func (nhr *nilHistoryRepository) GetAddressTransactionsFirstEpoch(_ uint32) (uint32, error) {
+	return 0, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
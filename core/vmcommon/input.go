@@ -18,6 +18,10 @@ const (
 	// AsynchronousCallBack means that an AsynchronousCall was performed
 	// previously, and now the control returns to the caller SmartContract's callBack method
 	AsynchronousCallBack
+
+	// ESDTSupplyChangeCall means that the invocation was performed by an ESDT built-in function, which notifies
+	// the ESDT system SmartContract about a change of the token supply. Users and SmartContracts cannot send such calls
+	ESDTSupplyChangeCall
 )
 
 // VMInput contains the common fields between the 2 types of SC call.
@@ -82,6 +86,26 @@ type VMInput struct {
 
 	// ESDTTokenName is the name of the token which was transferred by the transaction to the SC
 	ESDTTokenName []byte
+
+	// ESDTTokenNonce is the nonce of the non or semi fungible token which was transferred by the transaction to the SC.
+	// It is zero in case of fungible tokens.
+	ESDTTokenNonce uint64
+
+	// ESDTTransfers is the list of tokens which were transferred by a multi token transaction to the SC.
+	// The first token of the list is also set in the ESDTValue, ESDTTokenName and ESDTTokenNonce fields.
+	ESDTTransfers []*ESDTTransfer
+}
+
+// ESDTTransfer defines a token transferred to a smart contract
+type ESDTTransfer struct {
+	// ESDTValue is the value (amount of tokens) transferred
+	ESDTValue *big.Int
+
+	// ESDTTokenName is the name of the transferred token
+	ESDTTokenName []byte
+
+	// ESDTTokenNonce is the nonce of the transferred token, zero in case of fungible tokens
+	ESDTTokenNonce uint64
 }
 
 // ContractCreateInput VM input when creating a new contract.
@@ -0,0 +1,14 @@
+package api
+
+// AccountQueryOptions holds the options for the account queries. When neither a block nonce nor a block hash is
+// provided, the accounts are read from the current state
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
+}
+
+// IsHistorical returns true if the query targets the state at an older block
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.HasBlockNonce || len(options.BlockHash) > 0
+}
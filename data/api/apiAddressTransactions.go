@@ -0,0 +1,30 @@
+package api
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+const (
+	// AddressTransactionsSent filters the transactions sent by an address
+	AddressTransactionsSent = "sent"
+	// AddressTransactionsReceived filters the transactions received by an address
+	AddressTransactionsReceived = "received"
+)
+
+// AddressTransactionsQuery holds the filters and the pagination of an address transactions query. An empty Direction
+// selects both the sent and the received transactions, while an empty Status selects the transactions of any status
+type AddressTransactionsQuery struct {
+	Address   string
+	Direction string
+	Status    string
+	Page      uint32
+	PageSize  uint32
+}
+
+// AddressTransactions represents the structure for a page of an address' transactions history, most recent first
+type AddressTransactions struct {
+	Address      string                              `json:"address"`
+	Direction    string                              `json:"direction,omitempty"`
+	Status       string                              `json:"status,omitempty"`
+	Page         uint32                              `json:"page"`
+	PageSize     uint32                              `json:"pageSize"`
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+}
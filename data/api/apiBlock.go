@@ -23,6 +23,45 @@ type Block struct {
 	AccumulatedFeesInEpoch string            `json:"accumulatedFeesInEpoch,omitempty"`
 	DeveloperFeesInEpoch   string            `json:"developerFeesInEpoch,omitempty"`
 	Status                 string            `json:"status,omitempty"`
+	StateRootHash          string            `json:"stateRootHash,omitempty"`
+	ValidatorStatsRootHash string            `json:"validatorStatsRootHash,omitempty"`
+	ReceiptsHash           string            `json:"receiptsHash,omitempty"`
+	PrevRandSeed           string            `json:"prevRandSeed,omitempty"`
+	RandSeed               string            `json:"randSeed,omitempty"`
+	PubKeysBitmap          string            `json:"pubKeysBitmap,omitempty"`
+	Signature              string            `json:"signature,omitempty"`
+	LeaderSignature        string            `json:"leaderSignature,omitempty"`
+	ChainID                string            `json:"chainID,omitempty"`
+	SoftwareVersion        string            `json:"softwareVersion,omitempty"`
+	EpochStartMetaHash     string            `json:"epochStartMetaHash,omitempty"`
+	EpochStartInfo         *EpochStartInfo   `json:"epochStartInfo,omitempty"`
+	ConsensusGroup         []string          `json:"consensusGroup,omitempty"`
+}
+
+// EpochStartInfo represents the epoch start data of a metachain block that starts an epoch
+type EpochStartInfo struct {
+	LastFinalizedHeaders             []*EpochStartShardData `json:"lastFinalizedHeaders"`
+	TotalSupply                      string                 `json:"totalSupply"`
+	TotalToDistribute                string                 `json:"totalToDistribute"`
+	TotalNewlyMinted                 string                 `json:"totalNewlyMinted"`
+	RewardsPerBlock                  string                 `json:"rewardsPerBlock"`
+	RewardsForProtocolSustainability string                 `json:"rewardsForProtocolSustainability"`
+	NodePrice                        string                 `json:"nodePrice"`
+	PrevEpochStartRound              uint64                 `json:"prevEpochStartRound"`
+	PrevEpochStartHash               string                 `json:"prevEpochStartHash"`
+}
+
+// EpochStartShardData represents the last finalized header of a shard, as recorded in an epoch start metachain block
+type EpochStartShardData struct {
+	ShardID                 uint32       `json:"shard"`
+	Epoch                   uint32       `json:"epoch"`
+	Round                   uint64       `json:"round"`
+	Nonce                   uint64       `json:"nonce"`
+	HeaderHash              string       `json:"headerHash"`
+	RootHash                string       `json:"rootHash"`
+	FirstPendingMetaBlock   string       `json:"firstPendingMetaBlock"`
+	LastFinishedMetaBlock   string       `json:"lastFinishedMetaBlock"`
+	PendingMiniBlockHeaders []*MiniBlock `json:"pendingMiniBlockHeaders,omitempty"`
 }
 
 // NotarizedBlock represents a notarized block
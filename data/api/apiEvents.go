@@ -0,0 +1,40 @@
+package api
+
+// EventsQuery holds the filters and the pagination of an events query. The blocks range is inclusive and FromBlock is
+// mandatory, while a missing ToBlock stands for the current block
+type EventsQuery struct {
+	Address      string
+	Identifier   string
+	FromBlock    uint64
+	HasFromBlock bool
+	ToBlock      uint64
+	HasToBlock   bool
+	Page         uint32
+	PageSize     uint32
+}
+
+// Events represents the structure for a page of events that is returned by the events api route
+type Events struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	FromBlock  uint64   `json:"fromBlock"`
+	ToBlock    uint64   `json:"toBlock"`
+	NumEvents  uint32   `json:"numEvents"`
+	Page       uint32   `json:"page"`
+	PageSize   uint32   `json:"pageSize"`
+	Events     []*Event `json:"events"`
+}
+
+// Event represents an event emitted by a smart contract, along with its location
+// Index holds the position of the event within the log of the transaction
+type Event struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data,omitempty"`
+	TxHash     string   `json:"txHash"`
+	Index      uint32   `json:"index"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	Epoch      uint32   `json:"epoch"`
+}
@@ -0,0 +1,25 @@
+package api
+
+// MerkleProof represents the structure returned by the proof api routes. The proof holds the hex encoded trie nodes,
+// ordered from the node having the root hash towards the leaf holding the value
+type MerkleProof struct {
+	RootHash string   `json:"rootHash"`
+	Key      string   `json:"key"`
+	Value    string   `json:"value"`
+	Proof    []string `json:"proof"`
+}
+
+// AccountProof represents the structure returned by the account proof api route
+type AccountProof struct {
+	Address    string       `json:"address"`
+	BlockNonce uint64       `json:"blockNonce"`
+	BlockHash  string       `json:"blockHash"`
+	Account    *MerkleProof `json:"account"`
+}
+
+// AccountKeyProof represents the structure returned by the account data key proof api route. It holds the proof of
+// the account in the accounts trie and the proof of the key in the data trie of the account
+type AccountKeyProof struct {
+	AccountProof
+	DataTrie *MerkleProof `json:"dataTrie"`
+}
@@ -0,0 +1,53 @@
+package api
+
+// TransactionsPool represents the structure for a page of pending transactions that is returned by the transactions
+// pool api routes
+type TransactionsPool struct {
+	CacheID      string             `json:"cacheId"`
+	Sender       string             `json:"sender,omitempty"`
+	NumTxs       uint32             `json:"numTxs"`
+	NumNonceGaps uint32             `json:"numNonceGaps"`
+	Page         uint32             `json:"page"`
+	PageSize     uint32             `json:"pageSize"`
+	Transactions []*PoolTransaction `json:"transactions"`
+}
+
+// PoolTransaction represents a pending transaction held in the transactions pool
+// NonceGap holds the number of nonces missing right before the transaction, for the same sender
+type PoolTransaction struct {
+	Hash             string `json:"hash"`
+	Nonce            uint64 `json:"nonce"`
+	Sender           string `json:"sender"`
+	Receiver         string `json:"receiver"`
+	Value            string `json:"value"`
+	GasPrice         uint64 `json:"gasPrice"`
+	GasLimit         uint64 `json:"gasLimit"`
+	Data             []byte `json:"data,omitempty"`
+	SourceShard      uint32 `json:"sourceShard"`
+	DestinationShard uint32 `json:"destinationShard"`
+	NonceGap         uint64 `json:"nonceGap,omitempty"`
+}
+
+// SenderDiagnostics represents the structure that explains the selection state of a sender's pending transactions
+// NumFailedSelections holds the number of consecutive selection passes the sender was skipped for, due to a nonce gap
+type SenderDiagnostics struct {
+	Sender              string `json:"sender"`
+	NumTxs              uint64 `json:"numTxs"`
+	AccountNonce        uint64 `json:"accountNonce"`
+	IsAccountNonceKnown bool   `json:"isAccountNonceKnown"`
+	LowestTxNonce       uint64 `json:"lowestTxNonce"`
+	NonceGap            uint64 `json:"nonceGap"`
+	NumFailedSelections int64  `json:"numFailedSelections"`
+	IsInGracePeriod     bool   `json:"isInGracePeriod"`
+	Score               uint32 `json:"score"`
+}
+
+// SendersDiagnostics represents the structure for a page of senders diagnostics that is returned by the
+// transactions pool api routes
+type SendersDiagnostics struct {
+	CacheID    string               `json:"cacheId"`
+	NumSenders uint32               `json:"numSenders"`
+	Page       uint32               `json:"page"`
+	PageSize   uint32               `json:"pageSize"`
+	Senders    []*SenderDiagnostics `json:"senders"`
+}
@@ -0,0 +1,23 @@
+package api
+
+import "github.com/ElrondNetwork/elrond-go/data/trie/statistics"
+
+// TrieStatistics represents the structure returned by the trie statistics api route. It holds the statistics of the
+// accounts trie, the aggregated statistics of the accounts data tries and the accounts having the largest data tries
+type TrieStatistics struct {
+	RootHash                string                   `json:"rootHash"`
+	BlockNonce              uint64                   `json:"blockNonce"`
+	NumAccounts             uint64                   `json:"numAccounts"`
+	NumAccountsWithDataTrie uint64                   `json:"numAccountsWithDataTrie"`
+	MainTrie                *statistics.TrieStatsDTO `json:"mainTrie"`
+	DataTries               *statistics.TrieStatsDTO `json:"dataTries"`
+	TopDataTries            []*DataTrieStatistics    `json:"topDataTries"`
+}
+
+// DataTrieStatistics represents the size of the data trie of an account
+type DataTrieStatistics struct {
+	Address   string `json:"address"`
+	RootHash  string `json:"rootHash"`
+	NumNodes  uint64 `json:"numNodes"`
+	TotalSize uint64 `json:"totalSizeInBytes"`
+}
@@ -9,3 +9,11 @@ func New() *ESDigitalToken {
 		Value: big.NewInt(0),
 	}
 }
+
+// ESDTSupply holds the supply related values of a fungible ESDT token, as tracked by the ESDT system smart contract
+type ESDTSupply struct {
+	Minted *big.Int
+	Burnt  *big.Int
+	Wiped  *big.Int
+	Supply *big.Int
+}
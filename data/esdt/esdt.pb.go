@@ -30,8 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ESDigitalToken holds the data for a elrond standard digital token transaction
 type ESDigitalToken struct {
-	Value      *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
-	Properties []byte        `protobuf:"bytes,2,opt,name=Properties,proto3" json:"properties"`
+	Value         *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	Properties    []byte        `protobuf:"bytes,2,opt,name=Properties,proto3" json:"properties"`
+	Type          uint32        `protobuf:"varint,3,opt,name=Type,proto3" json:"type"`
+	TokenMetaData *MetaData     `protobuf:"bytes,4,opt,name=TokenMetaData,proto3" json:"metadata"`
 }
 
 func (m *ESDigitalToken) Reset()      { *m = ESDigitalToken{} }
@@ -76,33 +78,199 @@ func (m *ESDigitalToken) GetProperties() []byte {
 	return nil
 }
 
+func (m *ESDigitalToken) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *ESDigitalToken) GetTokenMetaData() *MetaData {
+	if m != nil {
+		return m.TokenMetaData
+	}
+	return nil
+}
+
+// MetaData holds the data for a non or semi fungible token, saved for each created nonce
+type MetaData struct {
+	Nonce      uint64   `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
+	Name       []byte   `protobuf:"bytes,2,opt,name=Name,proto3" json:"name"`
+	Creator    []byte   `protobuf:"bytes,3,opt,name=Creator,proto3" json:"creator"`
+	Royalties  uint32   `protobuf:"varint,4,opt,name=Royalties,proto3" json:"royalties"`
+	Hash       []byte   `protobuf:"bytes,5,opt,name=Hash,proto3" json:"hash"`
+	URIs       [][]byte `protobuf:"bytes,6,rep,name=URIs,proto3" json:"uris"`
+	Attributes []byte   `protobuf:"bytes,7,opt,name=Attributes,proto3" json:"attributes"`
+}
+
+func (m *MetaData) Reset()      { *m = MetaData{} }
+func (*MetaData) ProtoMessage() {}
+func (*MetaData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e413e402abc6a34c, []int{1}
+}
+func (m *MetaData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetaData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetaData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaData.Merge(m, src)
+}
+func (m *MetaData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetaData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaData proto.InternalMessageInfo
+
+func (m *MetaData) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MetaData) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *MetaData) GetCreator() []byte {
+	if m != nil {
+		return m.Creator
+	}
+	return nil
+}
+
+func (m *MetaData) GetRoyalties() uint32 {
+	if m != nil {
+		return m.Royalties
+	}
+	return 0
+}
+
+func (m *MetaData) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MetaData) GetURIs() [][]byte {
+	if m != nil {
+		return m.URIs
+	}
+	return nil
+}
+
+func (m *MetaData) GetAttributes() []byte {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// ESDTRoles holds the roles an account has for a given token
+type ESDTRoles struct {
+	Roles     [][]byte `protobuf:"bytes,1,rep,name=Roles,proto3" json:"roles"`
+	TokenType []byte   `protobuf:"bytes,2,opt,name=TokenType,proto3" json:"tokenType"`
+}
+
+func (m *ESDTRoles) Reset()      { *m = ESDTRoles{} }
+func (*ESDTRoles) ProtoMessage() {}
+func (*ESDTRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e413e402abc6a34c, []int{2}
+}
+func (m *ESDTRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTRoles.Merge(m, src)
+}
+func (m *ESDTRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTRoles proto.InternalMessageInfo
+
+func (m *ESDTRoles) GetRoles() [][]byte {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ESDTRoles) GetTokenType() []byte {
+	if m != nil {
+		return m.TokenType
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ESDigitalToken)(nil), "protoBuiltInFunctions.ESDigitalToken")
+	proto.RegisterType((*MetaData)(nil), "protoBuiltInFunctions.MetaData")
+	proto.RegisterType((*ESDTRoles)(nil), "protoBuiltInFunctions.ESDTRoles")
 }
 
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
-	0x14, 0x85, 0x67, 0x7e, 0xfe, 0x58, 0x0c, 0x92, 0x22, 0x20, 0x04, 0x8b, 0x1b, 0xb1, 0xb2, 0xc9,
-	0x6e, 0x61, 0x29, 0x08, 0xae, 0x46, 0xd8, 0x26, 0x48, 0x14, 0x0b, 0xbb, 0xd9, 0xec, 0x38, 0x19,
-	0xb2, 0x99, 0x59, 0x66, 0xef, 0x6a, 0xeb, 0x23, 0xf8, 0x14, 0x22, 0x3e, 0x89, 0xe5, 0x96, 0x5b,
-	0x45, 0x77, 0xb6, 0x91, 0x54, 0x79, 0x04, 0xc9, 0x04, 0x24, 0xd5, 0xbd, 0xdf, 0xe1, 0x70, 0x0e,
-	0xf7, 0x32, 0x26, 0x8a, 0x14, 0x83, 0xdc, 0x1a, 0x34, 0xbd, 0x03, 0x3f, 0xa2, 0x52, 0x65, 0x18,
-	0xeb, 0xeb, 0x52, 0x4f, 0x51, 0x19, 0x5d, 0x1c, 0x0e, 0xa5, 0xc2, 0x59, 0x99, 0x04, 0x53, 0xb3,
-	0x08, 0xa5, 0x91, 0x26, 0xf4, 0xb6, 0xa4, 0x7c, 0xf4, 0xe4, 0xc1, 0x6f, 0xdb, 0x94, 0xe3, 0x37,
-	0xca, 0xba, 0xa3, 0xdb, 0x2b, 0x25, 0x15, 0xf2, 0xec, 0xce, 0xcc, 0x85, 0xee, 0xa5, 0xac, 0x73,
-	0xcf, 0xb3, 0x52, 0xf4, 0xe9, 0x11, 0x3d, 0xd9, 0x8f, 0xc6, 0xab, 0xe5, 0xa0, 0xf3, 0xb4, 0x11,
-	0x3e, 0xbe, 0x06, 0x17, 0x0b, 0x8e, 0xb3, 0x30, 0x51, 0x32, 0x88, 0x35, 0x9e, 0xed, 0x54, 0x8d,
-	0x32, 0x6b, 0x74, 0x3a, 0x16, 0xf8, 0x6c, 0xec, 0x3c, 0x14, 0x9e, 0x86, 0xd2, 0x84, 0x29, 0x47,
-	0x1e, 0x44, 0x4a, 0xc6, 0x1a, 0x2f, 0x79, 0x81, 0xc2, 0x4e, 0xb6, 0xe1, 0xbd, 0x80, 0xb1, 0x1b,
-	0x6b, 0x72, 0x61, 0x51, 0x89, 0xa2, 0xff, 0xcf, 0x57, 0x75, 0x57, 0xcb, 0x01, 0xcb, 0xff, 0xd4,
-	0xc9, 0x8e, 0x23, 0x3a, 0xaf, 0x1a, 0x20, 0x75, 0x03, 0x64, 0xdd, 0x00, 0x7d, 0x71, 0x40, 0xdf,
-	0x1d, 0xd0, 0x4f, 0x07, 0xb4, 0x72, 0x40, 0x6b, 0x07, 0xf4, 0xdb, 0x01, 0xfd, 0x71, 0x40, 0xd6,
-	0x0e, 0xe8, 0x6b, 0x0b, 0xa4, 0x6a, 0x81, 0xd4, 0x2d, 0x90, 0x87, 0xff, 0x9b, 0xa7, 0x25, 0x7b,
-	0xfe, 0xde, 0xd3, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xe8, 0x4b, 0xb3, 0x43, 0x01, 0x00,
-	0x00,
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xbb, 0xe9, 0xb6, 0x9d, 0x6d, 0xf7, 0x10, 0x10, 0x82, 0xc8, 0xa4, 0x14, 0x84,
+	0x82, 0x6c, 0x0a, 0x7a, 0x14, 0x84, 0xcd, 0xb6, 0x62, 0x0f, 0x16, 0x99, 0x56, 0x41, 0x6f, 0xd3,
+	0x76, 0x4c, 0xc3, 0xa6, 0x99, 0x30, 0x99, 0x28, 0xbd, 0x79, 0xf5, 0xe6, 0xd5, 0x6f, 0x20, 0x7e,
+	0x12, 0x8f, 0x3d, 0xf6, 0x14, 0x6d, 0x7a, 0x91, 0x9c, 0xf6, 0x23, 0xc8, 0xbc, 0x98, 0x6d, 0x05,
+	0x4f, 0x99, 0xf7, 0x7b, 0x8f, 0xf7, 0xde, 0xff, 0xff, 0x08, 0xc6, 0x3c, 0x59, 0x28, 0x37, 0x96,
+	0x42, 0x09, 0xeb, 0x1e, 0x7c, 0xbc, 0x34, 0x08, 0xd5, 0x28, 0x7a, 0x9e, 0x46, 0x73, 0x15, 0x88,
+	0x28, 0xb9, 0x7f, 0xe9, 0x07, 0x6a, 0x99, 0xce, 0xdc, 0xb9, 0x58, 0xf5, 0x7d, 0xe1, 0x8b, 0x3e,
+	0x94, 0xcd, 0xd2, 0xf7, 0x10, 0x41, 0x00, 0xaf, 0xb2, 0x4b, 0xf7, 0xeb, 0x09, 0xbe, 0x18, 0x4e,
+	0x06, 0x81, 0x1f, 0x28, 0x16, 0x4e, 0xc5, 0x0d, 0x8f, 0xac, 0x05, 0xae, 0xbd, 0x61, 0x61, 0xca,
+	0x6d, 0xd4, 0x41, 0xbd, 0x96, 0x37, 0x2e, 0x32, 0xa7, 0xf6, 0x41, 0x83, 0xef, 0x3f, 0x9d, 0xab,
+	0x15, 0x53, 0xcb, 0xfe, 0x2c, 0xf0, 0xdd, 0x51, 0xa4, 0x9e, 0x1e, 0x8d, 0x1a, 0x86, 0x52, 0x44,
+	0x8b, 0x31, 0x57, 0x1f, 0x85, 0xbc, 0xe9, 0x73, 0x88, 0x2e, 0x7d, 0xd1, 0x5f, 0x30, 0xc5, 0x5c,
+	0x2f, 0xf0, 0x47, 0x91, 0xba, 0x66, 0x89, 0xe2, 0x92, 0x96, 0xcd, 0x2d, 0x17, 0xe3, 0x57, 0x52,
+	0xc4, 0x5c, 0xaa, 0x80, 0x27, 0xf6, 0x09, 0x8c, 0xba, 0x28, 0x32, 0x07, 0xc7, 0x77, 0x94, 0x1e,
+	0x55, 0x58, 0x0f, 0xb0, 0x39, 0x5d, 0xc7, 0xdc, 0x3e, 0xed, 0xa0, 0x5e, 0xdb, 0x6b, 0x14, 0x99,
+	0x63, 0xaa, 0x75, 0xcc, 0x29, 0x50, 0x6b, 0x82, 0xdb, 0xb0, 0xfc, 0x4b, 0xae, 0xd8, 0x80, 0x29,
+	0x66, 0x9b, 0x1d, 0xd4, 0x3b, 0x7f, 0xec, 0xb8, 0xff, 0x35, 0xc9, 0xad, 0xca, 0xbc, 0x56, 0x91,
+	0x39, 0x8d, 0x15, 0x57, 0x4c, 0xef, 0x49, 0xff, 0xed, 0xd1, 0xfd, 0x7c, 0x82, 0x1b, 0x55, 0x60,
+	0x39, 0xb8, 0x36, 0x16, 0xd1, 0xbc, 0x74, 0xc5, 0xf4, 0x9a, 0xda, 0x95, 0x48, 0x03, 0x5a, 0x72,
+	0xbd, 0xe0, 0x98, 0xad, 0xf8, 0x5f, 0x29, 0xb0, 0x60, 0xc4, 0x56, 0x9c, 0x02, 0xb5, 0x1e, 0xe2,
+	0xfa, 0xb5, 0xe4, 0x4c, 0x09, 0x09, 0x0a, 0x5a, 0xde, 0x79, 0x91, 0x39, 0xf5, 0x79, 0x89, 0x68,
+	0x95, 0xb3, 0x1e, 0xe1, 0x26, 0x15, 0x6b, 0x16, 0x82, 0x29, 0x26, 0x48, 0x6d, 0x17, 0x99, 0xd3,
+	0x94, 0x15, 0xa4, 0x87, 0xbc, 0x9e, 0xf8, 0x82, 0x25, 0x4b, 0xbb, 0x76, 0x98, 0xb8, 0x64, 0xc9,
+	0x92, 0x02, 0xd5, 0xd9, 0xd7, 0x74, 0x94, 0xd8, 0x67, 0x9d, 0xd3, 0x2a, 0x9b, 0xca, 0x20, 0xa1,
+	0x40, 0xb5, 0xfd, 0x57, 0x4a, 0xc9, 0x60, 0x96, 0x2a, 0x9e, 0xd8, 0xf5, 0x83, 0xfd, 0xec, 0x8e,
+	0xd2, 0xa3, 0x8a, 0xee, 0x5b, 0xdc, 0x1c, 0x4e, 0x06, 0x53, 0x2a, 0x42, 0x9e, 0x68, 0x2f, 0xe0,
+	0x61, 0x23, 0xe8, 0x0d, 0x5e, 0x48, 0x0d, 0x68, 0xc9, 0xb5, 0x0c, 0xb0, 0x12, 0x2e, 0x56, 0x1a,
+	0x02, 0x32, 0x54, 0x05, 0xe9, 0x21, 0xef, 0x3d, 0xdb, 0xec, 0x88, 0xb1, 0xdd, 0x11, 0xe3, 0x76,
+	0x47, 0xd0, 0xa7, 0x9c, 0xa0, 0x6f, 0x39, 0x41, 0x3f, 0x72, 0x82, 0x36, 0x39, 0x41, 0xdb, 0x9c,
+	0xa0, 0x5f, 0x39, 0x41, 0xbf, 0x73, 0x62, 0xdc, 0xe6, 0x04, 0x7d, 0xd9, 0x13, 0x63, 0xb3, 0x27,
+	0xc6, 0x76, 0x4f, 0x8c, 0x77, 0xa6, 0xfe, 0x1d, 0x66, 0x67, 0x70, 0xe3, 0x27, 0x7f, 0x06, 0x00,
+	0xcd, 0x39, 0x94, 0xa5, 0x1d, 0x03, 0x00, 0x00,
 }
 
 func (this *ESDigitalToken) Equal(that interface{}) bool {
@@ -133,16 +301,132 @@ func (this *ESDigitalToken) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Properties, that1.Properties) {
 		return false
 	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.TokenMetaData.Equal(that1.TokenMetaData) {
+		return false
+	}
+	return true
+}
+func (this *MetaData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MetaData)
+	if !ok {
+		that2, ok := that.(MetaData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !bytes.Equal(this.Name, that1.Name) {
+		return false
+	}
+	if !bytes.Equal(this.Creator, that1.Creator) {
+		return false
+	}
+	if this.Royalties != that1.Royalties {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if len(this.URIs) != len(that1.URIs) {
+		return false
+	}
+	for i := range this.URIs {
+		if !bytes.Equal(this.URIs[i], that1.URIs[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Attributes, that1.Attributes) {
+		return false
+	}
+	return true
+}
+func (this *ESDTRoles) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTRoles)
+	if !ok {
+		that2, ok := that.(ESDTRoles)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !bytes.Equal(this.Roles[i], that1.Roles[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.TokenType, that1.TokenType) {
+		return false
+	}
 	return true
 }
 func (this *ESDigitalToken) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&esdt.ESDigitalToken{")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
 	s = append(s, "Properties: "+fmt.Sprintf("%#v", this.Properties)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.TokenMetaData != nil {
+		s = append(s, "TokenMetaData: "+fmt.Sprintf("%#v", this.TokenMetaData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MetaData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&esdt.MetaData{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Creator: "+fmt.Sprintf("%#v", this.Creator)+",\n")
+	s = append(s, "Royalties: "+fmt.Sprintf("%#v", this.Royalties)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "URIs: "+fmt.Sprintf("%#v", this.URIs)+",\n")
+	s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTRoles) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&esdt.ESDTRoles{")
+	s = append(s, "Roles: "+fmt.Sprintf("%#v", this.Roles)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -174,6 +458,23 @@ func (m *ESDigitalToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TokenMetaData != nil {
+		{
+			size, err := m.TokenMetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEsdt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintEsdt(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Properties) > 0 {
 		i -= len(m.Properties)
 		copy(dAtA[i:], m.Properties)
@@ -195,6 +496,115 @@ func (m *ESDigitalToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		i -= len(m.Attributes)
+		copy(dAtA[i:], m.Attributes)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Attributes)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.URIs) > 0 {
+		for iNdEx := len(m.URIs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.URIs[iNdEx])
+			copy(dAtA[i:], m.URIs[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.URIs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Royalties != 0 {
+		i = encodeVarintEsdt(dAtA, i, uint64(m.Royalties))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEsdt(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEsdt(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEsdt(v)
 	base := offset
@@ -221,27 +631,119 @@ func (m *ESDigitalToken) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEsdt(uint64(l))
 	}
+	if m.Type != 0 {
+		n += 1 + sovEsdt(uint64(m.Type))
+	}
+	if m.TokenMetaData != nil {
+		l = m.TokenMetaData.Size()
+		n += 1 + l + sovEsdt(uint64(l))
+	}
 	return n
 }
 
-func sovEsdt(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEsdt(x uint64) (n int) {
-	return sovEsdt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ESDigitalToken) String() string {
-	if this == nil {
-		return "nil"
+func (m *MetaData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ESDigitalToken{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringEsdt(v interface{}) string {
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovEsdt(uint64(m.Nonce))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if m.Royalties != 0 {
+		n += 1 + sovEsdt(uint64(m.Royalties))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.URIs) > 0 {
+		for _, b := range m.URIs {
+			l = len(b)
+			n += 1 + l + sovEsdt(uint64(l))
+		}
+	}
+	l = len(m.Attributes)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, b := range m.Roles {
+			l = len(b)
+			n += 1 + l + sovEsdt(uint64(l))
+		}
+	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	return n
+}
+
+func sovEsdt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEsdt(x uint64) (n int) {
+	return sovEsdt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ESDigitalToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDigitalToken{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`TokenMetaData:` + strings.Replace(this.TokenMetaData.String(), "MetaData", "MetaData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetaData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetaData{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Creator:` + fmt.Sprintf("%v", this.Creator) + `,`,
+		`Royalties:` + fmt.Sprintf("%v", this.Royalties) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`URIs:` + fmt.Sprintf("%v", this.URIs) + `,`,
+		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTRoles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTRoles{`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEsdt(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
 		return "nil"
@@ -350,6 +852,439 @@ func (m *ESDigitalToken) Unmarshal(dAtA []byte) error {
 				m.Properties = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenMetaData == nil {
+				m.TokenMetaData = &MetaData{}
+			}
+			if err := m.TokenMetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetaData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetaData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			m.Royalties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Royalties |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIs = append(m.URIs, make([]byte, postIndex-iNdEx))
+			copy(m.URIs[len(m.URIs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attributes == nil {
+				m.Attributes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, make([]byte, postIndex-iNdEx))
+			copy(m.Roles[len(m.Roles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = append(m.TokenType[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenType == nil {
+				m.TokenType = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -167,10 +167,22 @@ type Trie interface {
 	IsInterfaceNil() bool
 	ClosePersister() error
 	GetProof(key []byte) ([][]byte, error)
-	VerifyProof(key []byte, proof [][]byte) (bool, error)
+	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	GetStorageManager() StorageManager
 }
 
+// TrieStatsCollector collects the statistics of the nodes visited while walking a trie
+type TrieStatsCollector interface {
+	AddBranchNode(depth int, size uint64)
+	AddExtensionNode(depth int, size uint64)
+	AddLeafNode(depth int, size uint64)
+}
+
+// TrieStatsProvider defines a trie able to walk all its nodes and report them to a statistics collector
+type TrieStatsProvider interface {
+	CollectStatistics(collector TrieStatsCollector) error
+}
+
 // DBWriteCacher is used to cache changes made to the trie, and only write to the database when it's needed
 type DBWriteCacher interface {
 	Put(key, val []byte) error
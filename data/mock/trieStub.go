@@ -25,7 +25,7 @@ type TrieStub struct {
 	GetAllHashesCalled          func() ([][]byte, error)
 	ClosePersisterCalled        func() error
 	GetProofCalled              func(key []byte) ([][]byte, error)
-	VerifyProofCalled           func(key []byte, proof [][]byte) (bool, error)
+	VerifyProofCalled           func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	GetStorageManagerCalled     func() data.StorageManager
 }
 
@@ -48,9 +48,9 @@ func (ts *TrieStub) GetProof(key []byte) ([][]byte, error) {
 }
 
 // VerifyProof -
-func (ts *TrieStub) VerifyProof(key []byte, proof [][]byte) (bool, error) {
+func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	if ts.VerifyProofCalled != nil {
-		return ts.VerifyProofCalled(key, proof)
+		return ts.VerifyProofCalled(rootHash, key, proof)
 	}
 
 	return false, nil
@@ -835,6 +835,27 @@ func (adb *AccountsDB) recreateTrie(rootHash []byte) error {
 	return nil
 }
 
+// CreateViewAtRootHash creates a new accounts DB sharing the trie storage with the current one and recreates its
+// main trie at the provided root hash. The current accounts DB is not altered. The returned instance is meant to be
+// used only for reads and will error if the state at the provided root hash was already pruned
+func (adb *AccountsDB) CreateViewAtRootHash(rootHash []byte) (AccountsAdapter, error) {
+	adb.mutOp.RLock()
+	mainTrie := adb.mainTrie
+	adb.mutOp.RUnlock()
+
+	view, err := NewAccountsDB(mainTrie, adb.hasher, adb.marshalizer, adb.accountFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	err = view.RecreateTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
+
 // RecreateAllTries recreates all the tries from the accounts DB
 func (adb *AccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (map[string]data.Trie, error) {
 	leavesChannel, err := adb.mainTrie.GetAllLeavesOnChannel(rootHash, ctx)
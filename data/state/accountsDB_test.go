@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -1016,6 +1017,58 @@ func TestAccountsDB_RecreateTrieOkValsShouldWork(t *testing.T) {
 
 }
 
+//------- CreateViewAtRootHash
+
+func TestAccountsDB_CreateViewAtRootHashShouldNotAlterTheCurrentState(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, uint(5))
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, factory.NewAccountCreator())
+
+	address := make([]byte, 32)
+	acc, _ := adb.LoadAccount(address)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(acc)
+	oldRootHash, _ := adb.Commit()
+
+	acc, _ = adb.LoadAccount(address)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+	_ = adb.SaveAccount(acc)
+	newRootHash, _ := adb.Commit()
+
+	view, err := adb.CreateViewAtRootHash(oldRootHash)
+	require.Nil(t, err)
+
+	oldAcc, err := view.GetExistingAccount(address)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), oldAcc.(state.UserAccountHandler).GetBalance())
+
+	viewRootHash, _ := view.RootHash()
+	assert.Equal(t, oldRootHash, viewRootHash)
+	currentRootHash, _ := adb.RootHash()
+	assert.Equal(t, newRootHash, currentRootHash)
+
+	currentAcc, _ := adb.GetExistingAccount(address)
+	assert.Equal(t, big.NewInt(15), currentAcc.(state.UserAccountHandler).GetBalance())
+}
+
+func TestAccountsDB_CreateViewAtRootHashMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, uint(5))
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, factory.NewAccountCreator())
+
+	view, err := adb.CreateViewAtRootHash([]byte("missing root hash"))
+	assert.NotNil(t, err)
+	assert.Nil(t, view)
+}
+
 func TestAccountsDB_CancelPrune(t *testing.T) {
 	t.Parallel()
 
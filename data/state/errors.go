@@ -115,3 +115,12 @@ var ErrInvalidRootHash = errors.New("invalid root hash")
 
 // ErrInvalidMaxHardCapForMissingNodes signals that the maximum hardcap value for missing nodes is invalid
 var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for missing nodes")
+
+// ErrTrieStatisticsNotSupported signals that the trie does not support walking its nodes for statistics
+var ErrTrieStatisticsNotSupported = errors.New("trie statistics not supported")
+
+// ErrKeyNotFound signals that the key is not present in the trie
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrAccountWithoutDataTrie signals that the account does not have a data trie
+var ErrAccountWithoutDataTrie = errors.New("account does not have a data trie")
@@ -184,3 +184,20 @@ type AccountsDBImporter interface {
 	Commit() ([]byte, error)
 	IsInterfaceNil() bool
 }
+
+// AccountsViewCreator defines an accounts adapter able to open a read-only view over the state at an older root hash
+type AccountsViewCreator interface {
+	CreateViewAtRootHash(rootHash []byte) (AccountsAdapter, error)
+}
+
+// TrieStatisticsProvider defines an accounts adapter able to walk its tries and report their statistics
+type TrieStatisticsProvider interface {
+	GetTrieStatistics(ctx context.Context, rootHash []byte, numTopDataTries int) (*TrieStatistics, error)
+}
+
+// MerkleProofProvider defines an accounts adapter able to prove the accounts and the accounts data stored under a
+// root hash
+type MerkleProofProvider interface {
+	GetAccountProof(rootHash []byte, address []byte) (*MerkleProof, error)
+	GetDataTrieProof(rootHash []byte, address []byte, key []byte) (*MerkleProof, *MerkleProof, error)
+}
@@ -0,0 +1,86 @@
+package state
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// MerkleProof holds the encoded trie nodes proving that a value is stored at a key in the trie having the given
+// root hash. The nodes are ordered from the root towards the leaf holding the value
+type MerkleProof struct {
+	RootHash []byte
+	Key      []byte
+	Value    []byte
+	Proof    [][]byte
+}
+
+// GetAccountProof returns the Merkle proof of the account stored at the given address in the main trie having the
+// provided root hash. The current state is not altered, the trie being recreated over the same storage
+func (adb *AccountsDB) GetAccountProof(rootHash []byte, address []byte) (*MerkleProof, error) {
+	adb.mutOp.RLock()
+	mainTrie := adb.mainTrie
+	adb.mutOp.RUnlock()
+
+	return getProof(mainTrie, rootHash, address)
+}
+
+// GetDataTrieProof returns the Merkle proof of the account stored at the given address in the main trie having the
+// provided root hash, together with the Merkle proof of the given key in the data trie of that account
+func (adb *AccountsDB) GetDataTrieProof(rootHash []byte, address []byte, key []byte) (*MerkleProof, *MerkleProof, error) {
+	adb.mutOp.RLock()
+	mainTrie := adb.mainTrie
+	adb.mutOp.RUnlock()
+
+	accountProof, err := getProof(mainTrie, rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account := &userAccount{}
+	err = adb.marshalizer.Unmarshal(account, accountProof.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(account.RootHash) == 0 {
+		return nil, nil, ErrAccountWithoutDataTrie
+	}
+
+	dataTrieProof, err := getProof(mainTrie, account.RootHash, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accountProof, dataTrieProof, nil
+}
+
+func getProof(tr data.Trie, rootHash []byte, key []byte) (*MerkleProof, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrInvalidRootHash
+	}
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := recreatedTrie.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("%w for key %x", ErrKeyNotFound, key)
+	}
+
+	proof, err := recreatedTrie.GetProof(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MerkleProof{
+		RootHash: rootHash,
+		Key:      key,
+		Value:    value,
+		Proof:    proof,
+	}, nil
+}
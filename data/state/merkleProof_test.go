@@ -0,0 +1,63 @@
+package state_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountsDB_GetAccountProof(t *testing.T) {
+	t.Parallel()
+
+	adb, rootHash, addresses := createAccountsDBWithDataTries(t, []int{0, 3, 1})
+
+	for _, address := range addresses {
+		proof, err := adb.GetAccountProof(rootHash, address)
+		require.Nil(t, err)
+		assert.Equal(t, rootHash, proof.RootHash)
+		assert.Equal(t, address, proof.Key)
+		assert.NotEqual(t, 0, len(proof.Value))
+		require.NotEqual(t, 0, len(proof.Proof))
+	}
+
+	currentRootHash, _ := adb.RootHash()
+	assert.Equal(t, rootHash, currentRootHash)
+}
+
+func TestAccountsDB_GetAccountProofMissingAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, rootHash, _ := createAccountsDBWithDataTries(t, []int{1})
+
+	proof, err := adb.GetAccountProof(rootHash, make([]byte, 32))
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, state.ErrKeyNotFound))
+
+	proof, err = adb.GetAccountProof(nil, make([]byte, 32))
+	assert.Nil(t, proof)
+	assert.Equal(t, state.ErrInvalidRootHash, err)
+}
+
+func TestAccountsDB_GetDataTrieProof(t *testing.T) {
+	t.Parallel()
+
+	adb, rootHash, addresses := createAccountsDBWithDataTries(t, []int{0, 3})
+
+	accountProof, dataTrieProof, err := adb.GetDataTrieProof(rootHash, addresses[1], []byte("key2"))
+	require.Nil(t, err)
+	assert.Equal(t, rootHash, accountProof.RootHash)
+	assert.Equal(t, []byte("key2"), dataTrieProof.Key)
+	assert.NotEqual(t, 0, len(dataTrieProof.Proof))
+
+	account, _ := adb.GetExistingAccount(addresses[1])
+	assert.Equal(t, account.(state.UserAccountHandler).GetRootHash(), dataTrieProof.RootHash)
+
+	_, _, err = adb.GetDataTrieProof(rootHash, addresses[1], []byte("missing key"))
+	assert.True(t, errors.Is(err, state.ErrKeyNotFound))
+
+	_, _, err = adb.GetDataTrieProof(rootHash, addresses[0], []byte("key0"))
+	assert.Equal(t, state.ErrAccountWithoutDataTrie, err)
+}
@@ -0,0 +1,142 @@
+package state
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie/statistics"
+)
+
+// DataTrieStatistics holds the size of the data trie of an account
+type DataTrieStatistics struct {
+	Address   []byte
+	RootHash  []byte
+	NumNodes  uint64
+	TotalSize uint64
+}
+
+// TrieStatistics holds the statistics of the main trie and the aggregated statistics of the accounts data tries,
+// together with the accounts having the largest data tries
+type TrieStatistics struct {
+	RootHash                []byte
+	NumAccounts             uint64
+	NumAccountsWithDataTrie uint64
+	MainTrie                *statistics.TrieStatsDTO
+	DataTries               *statistics.TrieStatsDTO
+	TopDataTries            []*DataTrieStatistics
+}
+
+// GetTrieStatistics walks the main trie at the provided root hash and the data tries of all its accounts. The current
+// state is not altered, the tries being recreated over the same storage. The walk loads every trie node, so it is
+// meant for debugging purposes
+func (adb *AccountsDB) GetTrieStatistics(ctx context.Context, rootHash []byte, numTopDataTries int) (*TrieStatistics, error) {
+	adb.mutOp.RLock()
+	mainTrie := adb.mainTrie
+	adb.mutOp.RUnlock()
+
+	mainTrieStats, err := collectTrieStatistics(mainTrie, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWalk, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	leavesChannel, err := mainTrie.GetAllLeavesOnChannel(rootHash, ctxWalk)
+	if err != nil {
+		return nil, err
+	}
+
+	trieStatistics := &TrieStatistics{
+		RootHash:     rootHash,
+		MainTrie:     mainTrieStats,
+		TopDataTries: make([]*DataTrieStatistics, 0),
+	}
+	dataTriesStats := statistics.NewTrieStatistics()
+	for leaf := range leavesChannel {
+		account := &userAccount{}
+		err = adb.marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			log.Trace("this must be a leaf with code", "err", err)
+			continue
+		}
+
+		trieStatistics.NumAccounts++
+		if len(account.RootHash) == 0 {
+			continue
+		}
+
+		dataTrieStats, errCollect := collectTrieStatistics(mainTrie, account.RootHash)
+		if errCollect != nil {
+			return nil, errCollect
+		}
+
+		trieStatistics.NumAccountsWithDataTrie++
+		dataTriesStats.AddTrieStats(dataTrieStats)
+		trieStatistics.TopDataTries = addToTopDataTries(
+			trieStatistics.TopDataTries,
+			&DataTrieStatistics{
+				Address:   leaf.Key(),
+				RootHash:  account.RootHash,
+				NumNodes:  dataTrieStats.NumNodes(),
+				TotalSize: dataTrieStats.TotalSize,
+			},
+			numTopDataTries,
+		)
+	}
+
+	// the leaves channel is closed early if the context is done
+	err = ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	trieStatistics.DataTries = dataTriesStats.GetTrieStats()
+
+	return trieStatistics, nil
+}
+
+func collectTrieStatistics(tr data.Trie, rootHash []byte) (*statistics.TrieStatsDTO, error) {
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	statsProvider, ok := recreatedTrie.(data.TrieStatsProvider)
+	if !ok {
+		return nil, ErrTrieStatisticsNotSupported
+	}
+
+	collector := statistics.NewTrieStatistics()
+	err = statsProvider.CollectStatistics(collector)
+	if err != nil {
+		return nil, err
+	}
+
+	return collector.GetTrieStats(), nil
+}
+
+// addToTopDataTries keeps the data tries sorted by their size, descending, and at most numTopDataTries of them
+func addToTopDataTries(topDataTries []*DataTrieStatistics, dataTrie *DataTrieStatistics, numTopDataTries int) []*DataTrieStatistics {
+	if numTopDataTries <= 0 {
+		return topDataTries
+	}
+
+	index := sort.Search(len(topDataTries), func(i int) bool {
+		return topDataTries[i].TotalSize < dataTrie.TotalSize
+	})
+	if index >= numTopDataTries {
+		return topDataTries
+	}
+
+	topDataTries = append(topDataTries, nil)
+	copy(topDataTries[index+1:], topDataTries[index:])
+	topDataTries[index] = dataTrie
+
+	if len(topDataTries) > numTopDataTries {
+		topDataTries = topDataTries[:numTopDataTries]
+	}
+
+	return topDataTries
+}
@@ -0,0 +1,109 @@
+package state_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsDBWithDataTries(t *testing.T, numKeysPerAccount []int) (*state.AccountsDB, []byte, [][]byte) {
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, uint(5))
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, factory.NewAccountCreator())
+
+	addresses := make([][]byte, 0, len(numKeysPerAccount))
+	for i, numKeys := range numKeysPerAccount {
+		address := make([]byte, 32)
+		address[0] = byte(i + 1)
+		addresses = append(addresses, address)
+
+		acc, err := adb.LoadAccount(address)
+		require.Nil(t, err)
+		for j := 0; j < numKeys; j++ {
+			key := []byte(fmt.Sprintf("key%d", j))
+			err = acc.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue(key, []byte("value"))
+			require.Nil(t, err)
+		}
+		require.Nil(t, adb.SaveAccount(acc))
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return adb, rootHash, addresses
+}
+
+func TestAccountsDB_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	adb, rootHash, addresses := createAccountsDBWithDataTries(t, []int{0, 3, 20, 1})
+
+	stats, err := adb.GetTrieStatistics(context.Background(), rootHash, 2)
+	require.Nil(t, err)
+
+	assert.Equal(t, rootHash, stats.RootHash)
+	assert.Equal(t, uint64(4), stats.NumAccounts)
+	assert.Equal(t, uint64(3), stats.NumAccountsWithDataTrie)
+	assert.Equal(t, uint64(4), stats.MainTrie.NumLeafNodes)
+	assert.Equal(t, uint64(24), stats.DataTries.NumLeafNodes)
+
+	require.Equal(t, 2, len(stats.TopDataTries))
+	assert.Equal(t, addresses[2], stats.TopDataTries[0].Address)
+	assert.Equal(t, addresses[1], stats.TopDataTries[1].Address)
+	assert.True(t, stats.TopDataTries[0].TotalSize > stats.TopDataTries[1].TotalSize)
+	assert.True(t, stats.TopDataTries[0].NumNodes >= 20)
+}
+
+func TestAccountsDB_GetTrieStatisticsShouldNotAlterTheCurrentState(t *testing.T) {
+	t.Parallel()
+
+	adb, rootHash, _ := createAccountsDBWithDataTries(t, []int{2})
+
+	_, err := adb.GetTrieStatistics(context.Background(), rootHash, 0)
+	require.Nil(t, err)
+
+	currentRootHash, _ := adb.RootHash()
+	assert.Equal(t, rootHash, currentRootHash)
+	assert.Equal(t, 0, adb.JournalLen())
+}
+
+func TestAccountsDB_GetTrieStatisticsRecreateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	trieStub := &mock.TrieStub{
+		RecreateCalled: func(root []byte) (data.Trie, error) {
+			return nil, expectedErr
+		},
+	}
+	adb := generateAccountDBFromTrie(trieStub)
+
+	stats, err := adb.GetTrieStatistics(context.Background(), []byte("root hash"), 10)
+	assert.Nil(t, stats)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestAccountsDB_GetTrieStatisticsNotSupportedTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	trieStub := &mock.TrieStub{}
+	trieStub.RecreateCalled = func(root []byte) (data.Trie, error) {
+		return trieStub, nil
+	}
+	adb := generateAccountDBFromTrie(trieStub)
+
+	stats, err := adb.GetTrieStatistics(context.Background(), []byte("root hash"), 10)
+	assert.Nil(t, stats)
+	assert.Equal(t, state.ErrTrieStatisticsNotSupported, err)
+}
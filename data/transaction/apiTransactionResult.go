@@ -30,6 +30,7 @@ type ApiTransactionResult struct {
 	ReturnMessage                     string                    `json:"returnMessage,omitempty"`
 	OriginalSender                    string                    `json:"originalSender,omitempty"`
 	Signature                         string                    `json:"signature,omitempty"`
+	InnerTransaction                  *ApiInnerTransaction      `json:"innerTransaction,omitempty"`
 	SourceShard                       uint32                    `json:"sourceShard"`
 	DestinationShard                  uint32                    `json:"destinationShard"`
 	BlockNonce                        uint64                    `json:"blockNonce,omitempty"`
@@ -38,6 +39,10 @@ type ApiTransactionResult struct {
 	NotarizedAtSourceInMetaHash       string                    `json:"NotarizedAtSourceInMetaHash,omitempty"`
 	NotarizedAtDestinationInMetaNonce uint64                    `json:"notarizedAtDestinationInMetaNonce,omitempty"`
 	NotarizedAtDestinationInMetaHash  string                    `json:"notarizedAtDestinationInMetaHash,omitempty"`
+	HyperblockNonce                   uint64                    `json:"hyperblockNonce,omitempty"`
+	HyperblockHash                    string                    `json:"hyperblockHash,omitempty"`
+	Confirmations                     uint64                    `json:"confirmations"`
+	IsFinal                           bool                      `json:"isFinal"`
 	MiniBlockType                     string                    `json:"miniblockType,omitempty"`
 	MiniBlockHash                     string                    `json:"miniblockHash,omitempty"`
 	Receipt                           *ReceiptApi               `json:"receipt,omitempty"`
@@ -45,6 +50,19 @@ type ApiTransactionResult struct {
 	Status                            TxStatus                  `json:"status,omitempty"`
 }
 
+// ApiInnerTransaction represents the user transaction carried by a relayed transaction v2, as rebuilt from the
+// arguments of the relayed transaction
+type ApiInnerTransaction struct {
+	Nonce     uint64 `json:"nonce"`
+	Value     string `json:"value"`
+	Receiver  string `json:"receiver"`
+	Sender    string `json:"sender"`
+	GasPrice  uint64 `json:"gasPrice"`
+	GasLimit  uint64 `json:"gasLimit"`
+	Data      []byte `json:"data,omitempty"`
+	Signature string `json:"signature"`
+}
+
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResults struct {
 	Status     TxStatus                           `json:"status,omitempty"`
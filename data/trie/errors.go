@@ -99,3 +99,9 @@ var ErrInvalidTimeout = errors.New("invalid timeout value")
 
 // ErrInvalidMaxHardCapForMissingNodes signals that the maximum hardcap value for missing nodes is invalid
 var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for missing nodes")
+
+// ErrNilTrieStatsCollector signals that a nil trie statistics collector has been provided
+var ErrNilTrieStatsCollector = errors.New("nil trie statistics collector")
+
+// ErrNilRootHash signals that a nil root hash was provided
+var ErrNilRootHash = errors.New("nil root hash")
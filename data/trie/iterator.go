@@ -6,9 +6,11 @@ import (
 )
 
 type iterator struct {
-	currentNode node
-	nextNodes   []node
-	db          data.DBWriteCacher
+	currentNode  node
+	currentDepth int
+	nextNodes    []node
+	nextDepths   []int
+	db           data.DBWriteCacher
 }
 
 // NewIterator creates a new instance of trie iterator
@@ -23,18 +25,34 @@ func NewIterator(trie data.Trie) (*iterator, error) {
 	}
 
 	trieStorage := trie.GetStorageManager()
-	nextNodes, err := pmt.root.getChildren(trieStorage.Database())
+
+	return newIterator(pmt.root, trieStorage.Database())
+}
+
+func newIterator(root node, db data.DBWriteCacher) (*iterator, error) {
+	nextNodes, err := root.getChildren(db)
 	if err != nil {
 		return nil, err
 	}
 
 	return &iterator{
-		currentNode: pmt.root,
-		nextNodes:   nextNodes,
-		db:          trieStorage.Database(),
+		currentNode:  root,
+		currentDepth: 0,
+		nextNodes:    nextNodes,
+		nextDepths:   createDepths(len(nextNodes), 1),
+		db:           db,
 	}, nil
 }
 
+func createDepths(numNodes int, depth int) []int {
+	depths := make([]int, numNodes)
+	for i := range depths {
+		depths[i] = depth
+	}
+
+	return depths
+}
+
 // HasNext returns true if there is a next node
 func (it *iterator) HasNext() bool {
 	return len(it.nextNodes) > 0
@@ -50,6 +68,7 @@ func (it *iterator) Next() error {
 	}
 
 	it.currentNode = n
+	it.currentDepth = it.nextDepths[0]
 	nextChildren, err := it.currentNode.getChildren(it.db)
 	if err != nil {
 		return err
@@ -57,9 +76,16 @@ func (it *iterator) Next() error {
 
 	it.nextNodes = append(it.nextNodes, nextChildren...)
 	it.nextNodes = it.nextNodes[1:]
+	it.nextDepths = append(it.nextDepths, createDepths(len(nextChildren), it.currentDepth+1)...)
+	it.nextDepths = it.nextDepths[1:]
 	return nil
 }
 
+// GetDepth returns the depth of the current node, the root node having the depth 0
+func (it *iterator) GetDepth() int {
+	return it.currentDepth
+}
+
 // MarshalizedNode marshalizes the current node, and then returns the serialized node
 func (it *iterator) MarshalizedNode() ([]byte, error) {
 	err := it.currentNode.setHash()
@@ -79,3 +79,23 @@ func TestIterator_GetHash(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, rootHash, hash)
 }
+
+func TestIterator_GetDepth(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	it, _ := trie.NewIterator(tr)
+	assert.Equal(t, 0, it.GetDepth())
+
+	previousDepth := 0
+	for it.HasNext() {
+		err := it.Next()
+		assert.Nil(t, err)
+
+		// the nodes are walked breadth first
+		assert.True(t, it.GetDepth() == previousDepth || it.GetDepth() == previousDepth+1)
+		previousDepth = it.GetDepth()
+	}
+	assert.True(t, previousDepth > 0)
+}
@@ -603,6 +603,63 @@ func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	return hashes, nil
 }
 
+// CollectStatistics walks all the trie nodes, loading the collapsed ones from the storage, and reports each of them,
+// with its depth and encoded size, to the provided collector
+func (tr *patriciaMerkleTrie) CollectStatistics(collector data.TrieStatsCollector) error {
+	if collector == nil {
+		return ErrNilTrieStatsCollector
+	}
+
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil
+	}
+
+	it, err := newIterator(tr.root, tr.trieStorage.Database())
+	if err != nil {
+		return err
+	}
+
+	for {
+		err = collectNodeStatistics(it, collector)
+		if err != nil {
+			return err
+		}
+
+		if !it.HasNext() {
+			return nil
+		}
+
+		err = it.Next()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func collectNodeStatistics(it *iterator, collector data.TrieStatsCollector) error {
+	encodedNode, err := it.MarshalizedNode()
+	if err != nil {
+		return err
+	}
+
+	size := uint64(len(encodedNode))
+	switch it.currentNode.(type) {
+	case *branchNode:
+		collector.AddBranchNode(it.GetDepth(), size)
+	case *extensionNode:
+		collector.AddExtensionNode(it.GetDepth(), size)
+	case *leafNode:
+		collector.AddLeafNode(it.GetDepth(), size)
+	default:
+		return ErrInvalidNode
+	}
+
+	return nil
+}
+
 func logArrayWithTrace(message string, paramName string, hashes [][]byte) {
 	if log.GetLevel() == logger.LogTrace {
 		for _, hash := range hashes {
@@ -655,16 +712,14 @@ func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, error) {
 	}
 }
 
-// VerifyProof verifies the given Merkle proof
-func (tr *patriciaMerkleTrie) VerifyProof(key []byte, proof [][]byte) (bool, error) {
-	tr.mutOperation.Lock()
-	defer tr.mutOperation.Unlock()
-
-	wantHash, err := tr.getRootHash()
-	if err != nil {
-		return false, err
+// VerifyProof verifies the given Merkle proof against the given root hash. The proof is self contained, so it can
+// be verified by a trie that does not hold the nodes of the proved trie
+func (tr *patriciaMerkleTrie) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
+	if len(rootHash) == 0 {
+		return false, ErrNilRootHash
 	}
 
+	wantHash := rootHash
 	key = keyBytesToHex(key)
 	for _, encodedNode := range proof {
 		if encodedNode == nil {
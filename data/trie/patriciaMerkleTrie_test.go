@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/statistics"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -628,6 +629,75 @@ func TestPatriciaMerkleTrie_GetAllHashesEmtyTrie(t *testing.T) {
 	assert.Equal(t, 0, len(hashes))
 }
 
+func TestPatriciaMerkleTrie_CollectStatisticsNilCollectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	err := tr.(data.TrieStatsProvider).CollectStatistics(nil)
+	assert.Equal(t, trie.ErrNilTrieStatsCollector, err)
+}
+
+func TestPatriciaMerkleTrie_CollectStatisticsEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	collector := statistics.NewTrieStatistics()
+
+	err := tr.(data.TrieStatsProvider).CollectStatistics(collector)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), collector.GetTrieStats().NumNodes())
+}
+
+func TestPatriciaMerkleTrie_CollectStatistics(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	collector := statistics.NewTrieStatistics()
+
+	err := tr.(data.TrieStatsProvider).CollectStatistics(collector)
+	require.Nil(t, err)
+
+	stats := collector.GetTrieStats()
+	assert.Equal(t, uint64(3), stats.NumLeafNodes)
+	assert.True(t, stats.NumBranchNodes > 0)
+	assert.True(t, stats.TotalSize > 0)
+	assert.Equal(t, uint64(1), stats.DepthHistogram[0])
+	assert.Equal(t, int(stats.MaxDepth)+1, len(stats.DepthHistogram))
+
+	numNodesInHistogram := uint64(0)
+	for _, numNodes := range stats.DepthHistogram {
+		numNodesInHistogram += numNodes
+	}
+	assert.Equal(t, stats.NumNodes(), numNodesInHistogram)
+
+	numIteratedNodes := uint64(1)
+	it, _ := trie.NewIterator(tr)
+	for it.HasNext() {
+		require.Nil(t, it.Next())
+		numIteratedNodes++
+	}
+	assert.Equal(t, numIteratedNodes, stats.NumNodes())
+}
+
+func TestPatriciaMerkleTrie_CollectStatisticsShouldLoadTheCollapsedNodes(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	collector := statistics.NewTrieStatistics()
+	_ = tr.(data.TrieStatsProvider).CollectStatistics(collector)
+
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	recreatedTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	recreatedCollector := statistics.NewTrieStatistics()
+	err = recreatedTrie.(data.TrieStatsProvider).CollectStatistics(recreatedCollector)
+	assert.Nil(t, err)
+	assert.Equal(t, collector.GetTrieStats(), recreatedCollector.GetTrieStats())
+}
+
 func TestPatriciaMerkleTrie_GetAllLeavesOnChannelEmptyTrie(t *testing.T) {
 	t.Parallel()
 
@@ -671,7 +741,8 @@ func TestPatriciaMerkleTree_Prove(t *testing.T) {
 
 	proof, err := tr.GetProof([]byte("dog"))
 	assert.Nil(t, err)
-	ok, _ := tr.VerifyProof([]byte("dog"), proof)
+	rootHash, _ := tr.RootHash()
+	ok, _ := tr.VerifyProof(rootHash, []byte("dog"), proof)
 	assert.True(t, ok)
 }
 
@@ -683,7 +754,8 @@ func TestPatriciaMerkleTree_ProveCollapsedTrie(t *testing.T) {
 
 	proof, err := tr.GetProof([]byte("dog"))
 	assert.Nil(t, err)
-	ok, _ := tr.VerifyProof([]byte("dog"), proof)
+	rootHash, _ := tr.RootHash()
+	ok, _ := tr.VerifyProof(rootHash, []byte("dog"), proof)
 	assert.True(t, ok)
 }
 
@@ -701,15 +773,16 @@ func TestPatriciaMerkleTree_VerifyProof(t *testing.T) {
 	t.Parallel()
 
 	tr, val := initTrieMultipleValues(50)
+	rootHash, _ := tr.RootHash()
 
 	for i := range val {
 		proof, _ := tr.GetProof(val[i])
 
-		ok, err := tr.VerifyProof(val[i], proof)
+		ok, err := tr.VerifyProof(rootHash, val[i], proof)
 		assert.Nil(t, err)
 		assert.True(t, ok)
 
-		ok, err = tr.VerifyProof([]byte("dog"+strconv.Itoa(i)), proof)
+		ok, err = tr.VerifyProof(rootHash, []byte("dog"+strconv.Itoa(i)), proof)
 		assert.Nil(t, err)
 		assert.False(t, ok)
 	}
@@ -724,7 +797,8 @@ func TestPatriciaMerkleTrie_VerifyProofBranchNodeWantHashShouldWork(t *testing.T
 	_ = tr.Update([]byte("zebra"), []byte("horse"))
 
 	proof, _ := tr.GetProof([]byte("dog"))
-	ok, err := tr.VerifyProof([]byte("dog"), proof)
+	rootHash, _ := tr.RootHash()
+	ok, err := tr.VerifyProof(rootHash, []byte("dog"), proof)
 	assert.True(t, ok)
 	assert.Nil(t, err)
 }
@@ -738,7 +812,8 @@ func TestPatriciaMerkleTrie_VerifyProofExtensionNodeWantHashShouldWork(t *testin
 	_ = tr.Update([]byte("doe"), []byte("reindeer"))
 
 	proof, _ := tr.GetProof([]byte("dog"))
-	ok, err := tr.VerifyProof([]byte("dog"), proof)
+	rootHash, _ := tr.RootHash()
+	ok, err := tr.VerifyProof(rootHash, []byte("dog"), proof)
 	assert.True(t, ok)
 	assert.Nil(t, err)
 }
@@ -747,8 +822,9 @@ func TestPatriciaMerkleTree_VerifyProofNilProofs(t *testing.T) {
 	t.Parallel()
 
 	tr := initTrie()
+	rootHash, _ := tr.RootHash()
 
-	ok, err := tr.VerifyProof([]byte("dog"), nil)
+	ok, err := tr.VerifyProof(rootHash, []byte("dog"), nil)
 	assert.False(t, ok)
 	assert.Nil(t, err)
 }
@@ -757,8 +833,9 @@ func TestPatriciaMerkleTree_VerifyProofEmptyProofs(t *testing.T) {
 	t.Parallel()
 
 	tr := initTrie()
+	rootHash, _ := tr.RootHash()
 
-	ok, err := tr.VerifyProof([]byte("dog"), [][]byte{})
+	ok, err := tr.VerifyProof(rootHash, []byte("dog"), [][]byte{})
 	assert.False(t, ok)
 	assert.Nil(t, err)
 }
@@ -778,10 +855,40 @@ func TestPatriciaMerkleTrie_VerifyProofFromDifferentTrieShouldNotWork(t *testing
 	_ = tr2.Update([]byte("dogglesworth"), []byte("caterpillar"))
 
 	proof, _ := tr2.GetProof([]byte("dogglesworth"))
-	ok, _ := tr1.VerifyProof([]byte("dogglesworth"), proof)
+	rootHash, _ := tr1.RootHash()
+	ok, _ := tr1.VerifyProof(rootHash, []byte("dogglesworth"), proof)
 	assert.False(t, ok)
 }
 
+func TestPatriciaMerkleTrie_VerifyProofWithNilRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	proof, _ := tr.GetProof([]byte("dog"))
+
+	ok, err := tr.VerifyProof(nil, []byte("dog"), proof)
+	assert.False(t, ok)
+	assert.Equal(t, trie.ErrNilRootHash, err)
+}
+
+func TestPatriciaMerkleTrie_VerifyProofFromAnotherTrieInstanceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	proof, _ := tr.GetProof([]byte("doe"))
+
+	verifier := emptyTrie()
+	ok, err := verifier.VerifyProof(rootHash, []byte("doe"), proof)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	ok, err = verifier.VerifyProof(trie.EmptyTrieHash, []byte("doe"), proof)
+	assert.False(t, ok)
+	assert.Nil(t, err)
+}
+
 func TestPatriciaMerkleTrie_GetAndVerifyProof(t *testing.T) {
 	t.Parallel()
 
@@ -799,6 +906,7 @@ func TestPatriciaMerkleTrie_GetAndVerifyProof(t *testing.T) {
 		_ = tr.Update(values[i], values[i])
 	}
 
+	rootHash, _ := tr.RootHash()
 	for i := 0; i < numRuns; i++ {
 		randNum := rand.Intn(nrLeaves)
 		proof, err := tr.GetProof(values[randNum])
@@ -809,7 +917,7 @@ func TestPatriciaMerkleTrie_GetAndVerifyProof(t *testing.T) {
 		require.Nil(t, err)
 		require.NotEqual(t, 0, len(proof))
 
-		ok, err := tr.VerifyProof(values[randNum], proof)
+		ok, err := tr.VerifyProof(rootHash, values[randNum], proof)
 		if err != nil {
 			dumpTrieContents(tr, values)
 			fmt.Printf("error verifying proof for %v, proof = %v, err = %s", values[randNum], proof, err.Error())
@@ -0,0 +1,96 @@
+package statistics
+
+// TrieStatsDTO holds the node counts, the depth histogram and the size of one or more tries
+type TrieStatsDTO struct {
+	NumBranchNodes    uint64   `json:"numBranchNodes"`
+	NumExtensionNodes uint64   `json:"numExtensionNodes"`
+	NumLeafNodes      uint64   `json:"numLeafNodes"`
+	TotalSize         uint64   `json:"totalSizeInBytes"`
+	MaxDepth          uint32   `json:"maxDepth"`
+	DepthHistogram    []uint64 `json:"depthHistogram"`
+}
+
+// NumNodes returns the total number of nodes
+func (dto *TrieStatsDTO) NumNodes() uint64 {
+	return dto.NumBranchNodes + dto.NumExtensionNodes + dto.NumLeafNodes
+}
+
+type trieStatistics struct {
+	stats TrieStatsDTO
+}
+
+// NewTrieStatistics returns a structure able to collect the statistics of the nodes visited while walking a trie. The
+// depth of the root node is 0. It is not concurrent safe, as a trie is walked from a single go routine
+func NewTrieStatistics() *trieStatistics {
+	return &trieStatistics{
+		stats: TrieStatsDTO{
+			DepthHistogram: make([]uint64, 0),
+		},
+	}
+}
+
+// AddBranchNode will add a branch node having the given depth and encoded size
+func (ts *trieStatistics) AddBranchNode(depth int, size uint64) {
+	ts.stats.NumBranchNodes++
+	ts.addNode(depth, size)
+}
+
+// AddExtensionNode will add an extension node having the given depth and encoded size
+func (ts *trieStatistics) AddExtensionNode(depth int, size uint64) {
+	ts.stats.NumExtensionNodes++
+	ts.addNode(depth, size)
+}
+
+// AddLeafNode will add a leaf node having the given depth and encoded size
+func (ts *trieStatistics) AddLeafNode(depth int, size uint64) {
+	ts.stats.NumLeafNodes++
+	ts.addNode(depth, size)
+}
+
+func (ts *trieStatistics) addNode(depth int, size uint64) {
+	ts.stats.TotalSize += size
+	if uint32(depth) > ts.stats.MaxDepth {
+		ts.stats.MaxDepth = uint32(depth)
+	}
+
+	for len(ts.stats.DepthHistogram) <= depth {
+		ts.stats.DepthHistogram = append(ts.stats.DepthHistogram, 0)
+	}
+	ts.stats.DepthHistogram[depth]++
+}
+
+// AddTrieStats will add the statistics of another trie to the collected ones
+func (ts *trieStatistics) AddTrieStats(other *TrieStatsDTO) {
+	if other == nil {
+		return
+	}
+
+	ts.stats.NumBranchNodes += other.NumBranchNodes
+	ts.stats.NumExtensionNodes += other.NumExtensionNodes
+	ts.stats.NumLeafNodes += other.NumLeafNodes
+	ts.stats.TotalSize += other.TotalSize
+	if other.MaxDepth > ts.stats.MaxDepth {
+		ts.stats.MaxDepth = other.MaxDepth
+	}
+
+	for depth, numNodes := range other.DepthHistogram {
+		for len(ts.stats.DepthHistogram) <= depth {
+			ts.stats.DepthHistogram = append(ts.stats.DepthHistogram, 0)
+		}
+		ts.stats.DepthHistogram[depth] += numNodes
+	}
+}
+
+// GetTrieStats returns a copy of the collected statistics
+func (ts *trieStatistics) GetTrieStats() *TrieStatsDTO {
+	stats := ts.stats
+	stats.DepthHistogram = make([]uint64, len(ts.stats.DepthHistogram))
+	copy(stats.DepthHistogram, ts.stats.DepthHistogram)
+
+	return &stats
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *trieStatistics) IsInterfaceNil() bool {
+	return ts == nil
+}
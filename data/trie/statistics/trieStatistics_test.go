@@ -0,0 +1,75 @@
+package statistics
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrieStatistics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+
+	assert.False(t, check.IfNil(ts))
+	assert.Equal(t, uint64(0), ts.GetTrieStats().NumNodes())
+}
+
+func TestTrieStatistics_AddNodes(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddBranchNode(0, 100)
+	ts.AddExtensionNode(1, 40)
+	ts.AddBranchNode(2, 90)
+	ts.AddLeafNode(3, 20)
+	ts.AddLeafNode(3, 30)
+	ts.AddLeafNode(1, 10)
+
+	stats := ts.GetTrieStats()
+	assert.Equal(t, uint64(2), stats.NumBranchNodes)
+	assert.Equal(t, uint64(1), stats.NumExtensionNodes)
+	assert.Equal(t, uint64(3), stats.NumLeafNodes)
+	assert.Equal(t, uint64(6), stats.NumNodes())
+	assert.Equal(t, uint64(290), stats.TotalSize)
+	assert.Equal(t, uint32(3), stats.MaxDepth)
+	assert.Equal(t, []uint64{1, 2, 1, 2}, stats.DepthHistogram)
+}
+
+func TestTrieStatistics_AddTrieStats(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddBranchNode(0, 100)
+	ts.AddLeafNode(1, 10)
+	ts.AddTrieStats(nil)
+	ts.AddTrieStats(&TrieStatsDTO{
+		NumBranchNodes:    1,
+		NumExtensionNodes: 1,
+		NumLeafNodes:      2,
+		TotalSize:         50,
+		MaxDepth:          2,
+		DepthHistogram:    []uint64{1, 1, 2},
+	})
+
+	stats := ts.GetTrieStats()
+	assert.Equal(t, uint64(2), stats.NumBranchNodes)
+	assert.Equal(t, uint64(1), stats.NumExtensionNodes)
+	assert.Equal(t, uint64(3), stats.NumLeafNodes)
+	assert.Equal(t, uint64(160), stats.TotalSize)
+	assert.Equal(t, uint32(2), stats.MaxDepth)
+	assert.Equal(t, []uint64{2, 2, 2}, stats.DepthHistogram)
+}
+
+func TestTrieStatistics_GetTrieStatsShouldReturnACopy(t *testing.T) {
+	t.Parallel()
+
+	ts := NewTrieStatistics()
+	ts.AddLeafNode(0, 10)
+
+	stats := ts.GetTrieStats()
+	stats.DepthHistogram[0] = 100
+
+	assert.Equal(t, []uint64{1}, ts.GetTrieStats().DepthHistogram)
+}
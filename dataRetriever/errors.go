@@ -196,3 +196,12 @@ var ErrNilGracefullyCloseChannel = errors.New("nil gracefully close channel")
 
 // ErrNilSmartContractsPool signals that a nil smart contracts pool has been provided
 var ErrNilSmartContractsPool = errors.New("nil smart contracts pool")
+
+// ErrInvalidTxPoolJournalSnapshotInterval signals that an invalid snapshot interval has been provided to the tx pool journal
+var ErrInvalidTxPoolJournalSnapshotInterval = errors.New("invalid tx pool journal snapshot interval")
+
+// ErrNilTransactionsPoolAdder signals that a nil transactions pool adder has been provided
+var ErrNilTransactionsPoolAdder = errors.New("nil transactions pool adder")
+
+// ErrWrongTypeAssertion signals that a type assertion failed
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
@@ -41,6 +41,12 @@ func (ut UnitType) String() string {
 		return "StatusMetricsUnit"
 	case ReceiptsUnit:
 		return "ReceiptsUnit"
+	case TxPoolJournalUnit:
+		return "TxPoolJournalUnit"
+	case EventsIndexUnit:
+		return "EventsIndexUnit"
+	case AddressTransactionsUnit:
+		return "AddressTransactionsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -85,6 +91,12 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// TxPoolJournalUnit is the storage unit identifier of the persisted transactions pool
+	TxPoolJournalUnit UnitType = 17
+	// EventsIndexUnit is the storage unit identifier of the events index (by emitter address and identifier)
+	EventsIndexUnit UnitType = 18
+	// AddressTransactionsUnit is the storage unit identifier of the transactions index (by sender and receiver address)
+	AddressTransactionsUnit UnitType = 19
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
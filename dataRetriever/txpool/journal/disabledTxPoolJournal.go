@@ -0,0 +1,28 @@
+package journal
+
+type disabledTxPoolJournal struct {
+}
+
+// NewDisabledTxPoolJournal creates a tx pool journal that persists nothing, to be used when the journal is not enabled
+func NewDisabledTxPoolJournal() *disabledTxPoolJournal {
+	return &disabledTxPoolJournal{}
+}
+
+// ReloadTransactions does nothing
+func (journal *disabledTxPoolJournal) ReloadTransactions(_ TransactionsPoolAdder) error {
+	return nil
+}
+
+// StartSnapshotting does nothing
+func (journal *disabledTxPoolJournal) StartSnapshotting() {
+}
+
+// Close does nothing
+func (journal *disabledTxPoolJournal) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *disabledTxPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
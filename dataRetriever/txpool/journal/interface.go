@@ -0,0 +1,26 @@
+package journal
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// TxPoolHandler defines the transactions pool capabilities required by the journal
+type TxPoolHandler interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+	IsInterfaceNil() bool
+}
+
+// TransactionsPoolAdder defines the component able to revalidate the journaled transactions, with the same checks as
+// the interceptors, and to add the valid ones to the local pool, without broadcasting them
+type TransactionsPoolAdder interface {
+	AddTransactionsToPool(txs []*transaction.Transaction) (uint64, error)
+}
+
+// TxPoolJournalHandler defines the behavior of a tx pool journal
+type TxPoolJournalHandler interface {
+	ReloadTransactions(poolAdder TransactionsPoolAdder) error
+	StartSnapshotting()
+	Close() error
+	IsInterfaceNil() bool
+}
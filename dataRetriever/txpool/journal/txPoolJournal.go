@@ -0,0 +1,224 @@
+package journal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/closing"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+var _ closing.Closer = (*txPoolJournal)(nil)
+
+var log = logger.GetOrCreate("txpool/journal")
+
+// ArgTxPoolJournal is the argument for the tx pool journal's constructor
+type ArgTxPoolJournal struct {
+	TxPool           TxPoolHandler
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	SnapshotInterval time.Duration
+}
+
+// txPoolJournal persists the pending transactions of the pool (periodically and on close), so that they can be
+// revalidated and added back to the pool after a node restart
+type txPoolJournal struct {
+	txPool           TxPoolHandler
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	snapshotInterval time.Duration
+
+	mutSnapshot     sync.Mutex
+	journaledHashes map[string]struct{}
+	cancelFunc      func()
+}
+
+// NewTxPoolJournal creates a new tx pool journal
+func NewTxPoolJournal(args ArgTxPoolJournal) (*txPoolJournal, error) {
+	if check.IfNil(args.TxPool) {
+		return nil, dataRetriever.ErrNilTxDataPool
+	}
+	if check.IfNil(args.Storer) {
+		return nil, dataRetriever.ErrNilTxStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if args.SnapshotInterval <= 0 {
+		return nil, dataRetriever.ErrInvalidTxPoolJournalSnapshotInterval
+	}
+
+	journal := &txPoolJournal{
+		txPool:           args.TxPool,
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		snapshotInterval: args.SnapshotInterval,
+		journaledHashes:  make(map[string]struct{}),
+		cancelFunc:       func() {},
+	}
+
+	journal.storer.RangeKeys(func(key []byte, _ []byte) bool {
+		journal.journaledHashes[string(key)] = struct{}{}
+		return true
+	})
+
+	return journal, nil
+}
+
+// LoadTransactions returns the transactions found in the journal, as persisted by the previous run of the node
+func (journal *txPoolJournal) LoadTransactions() []*transaction.Transaction {
+	txs := make([]*transaction.Transaction, 0)
+	journal.storer.RangeKeys(func(key []byte, value []byte) bool {
+		tx := &transaction.Transaction{}
+		err := journal.marshalizer.Unmarshal(tx, value)
+		if err != nil {
+			log.Debug("txPoolJournal.LoadTransactions: can not unmarshal transaction", "hash", key, "error", err)
+			return true
+		}
+
+		txs = append(txs, tx)
+		return true
+	})
+
+	log.Debug("txPoolJournal.LoadTransactions", "num txs", len(txs))
+
+	return txs
+}
+
+// ReloadTransactions hands the journaled transactions to the provided pool adder, which revalidates them and adds
+// the valid ones to the local pool (the ones that became invalid meanwhile will not reach the pool anymore). The
+// transactions are not broadcast again, as the network has already seen them.
+func (journal *txPoolJournal) ReloadTransactions(poolAdder TransactionsPoolAdder) error {
+	if poolAdder == nil {
+		return dataRetriever.ErrNilTransactionsPoolAdder
+	}
+
+	txs := journal.LoadTransactions()
+	if len(txs) == 0 {
+		return nil
+	}
+
+	numAdded, err := poolAdder.AddTransactionsToPool(txs)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("txPoolJournal.ReloadTransactions", "num journaled", len(txs), "num added to pool", numAdded)
+
+	return nil
+}
+
+// StartSnapshotting starts persisting the transactions of the pool, once every snapshot interval
+func (journal *txPoolJournal) StartSnapshotting() {
+	var ctx context.Context
+	ctx, journal.cancelFunc = context.WithCancel(context.Background())
+	go journal.snapshotPeriodically(ctx)
+}
+
+func (journal *txPoolJournal) snapshotPeriodically(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("txPoolJournal's go routine is stopping...")
+			return
+		case <-time.After(journal.snapshotInterval):
+		}
+
+		err := journal.Snapshot()
+		if err != nil {
+			log.Warn("txPoolJournal.Snapshot", "error", err)
+		}
+	}
+}
+
+// Snapshot persists the transactions currently held by the pool. Only the differences with respect to the previous
+// snapshot are written: the new transactions are added and the ones no longer in the pool are removed.
+func (journal *txPoolJournal) Snapshot() error {
+	journal.mutSnapshot.Lock()
+	defer journal.mutSnapshot.Unlock()
+
+	startTime := time.Now()
+	poolHashes := make(map[string]struct{})
+	txsToAdd := make([]*txcache.WrappedTransaction, 0)
+	journal.txPool.ForEachTransaction(func(txHash []byte, value *txcache.WrappedTransaction) {
+		poolHashes[string(txHash)] = struct{}{}
+		_, isJournaled := journal.journaledHashes[string(txHash)]
+		if !isJournaled {
+			txsToAdd = append(txsToAdd, value)
+		}
+	})
+
+	var lastErr error
+	numAdded := 0
+	for _, wrappedTx := range txsToAdd {
+		err := journal.putTransaction(wrappedTx)
+		if err != nil {
+			lastErr = err
+			delete(poolHashes, string(wrappedTx.TxHash))
+			continue
+		}
+
+		numAdded++
+	}
+
+	numRemoved := 0
+	for txHash := range journal.journaledHashes {
+		_, isInPool := poolHashes[txHash]
+		if isInPool {
+			continue
+		}
+
+		err := journal.storer.Remove([]byte(txHash))
+		if err != nil {
+			lastErr = err
+			poolHashes[txHash] = struct{}{}
+			continue
+		}
+
+		numRemoved++
+	}
+
+	journal.journaledHashes = poolHashes
+
+	log.Debug("txPoolJournal.Snapshot",
+		"num txs", len(poolHashes),
+		"num added", numAdded,
+		"num removed", numRemoved,
+		"elapsed time", time.Since(startTime),
+	)
+
+	return lastErr
+}
+
+func (journal *txPoolJournal) putTransaction(wrappedTx *txcache.WrappedTransaction) error {
+	tx, ok := wrappedTx.Tx.(*transaction.Transaction)
+	if !ok {
+		return dataRetriever.ErrWrongTypeAssertion
+	}
+
+	buff, err := journal.marshalizer.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	return journal.storer.Put(wrappedTx.TxHash, buff)
+}
+
+// Close stops the periodic snapshots and takes a last snapshot of the pool
+func (journal *txPoolJournal) Close() error {
+	journal.cancelFunc()
+
+	return journal.Snapshot()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *txPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -0,0 +1,248 @@
+package journal
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/require"
+)
+
+type transactionsPoolAdderStub struct {
+	addedTxs []*transaction.Transaction
+	err      error
+}
+
+func (stub *transactionsPoolAdderStub) AddTransactionsToPool(txs []*transaction.Transaction) (uint64, error) {
+	stub.addedTxs = append(stub.addedTxs, txs...)
+	return uint64(len(txs)), stub.err
+}
+
+func TestNewTxPoolJournal_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal(t)
+	args.TxPool = nil
+	journal, err := NewTxPoolJournal(args)
+	require.Nil(t, journal)
+	require.Equal(t, dataRetriever.ErrNilTxDataPool, err)
+
+	args = createMockArgTxPoolJournal(t)
+	args.Storer = nil
+	journal, err = NewTxPoolJournal(args)
+	require.Nil(t, journal)
+	require.Equal(t, dataRetriever.ErrNilTxStorage, err)
+
+	args = createMockArgTxPoolJournal(t)
+	args.Marshalizer = nil
+	journal, err = NewTxPoolJournal(args)
+	require.Nil(t, journal)
+	require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+
+	args = createMockArgTxPoolJournal(t)
+	args.SnapshotInterval = 0
+	journal, err = NewTxPoolJournal(args)
+	require.Nil(t, journal)
+	require.Equal(t, dataRetriever.ErrInvalidTxPoolJournalSnapshotInterval, err)
+}
+
+func TestNewTxPoolJournal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	journal, err := NewTxPoolJournal(createMockArgTxPoolJournal(t))
+	require.Nil(t, err)
+	require.False(t, journal.IsInterfaceNil())
+	require.Len(t, journal.LoadTransactions(), 0)
+}
+
+func TestTxPoolJournal_SnapshotAndLoadTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal(t)
+	pool := args.TxPool.(dataRetriever.ShardedDataCacherNotifier)
+	pool.AddData([]byte("hash-alice-1"), createTx("alice", 1), 0, "0")
+	pool.AddData([]byte("hash-alice-2"), createTx("alice", 2), 0, "0")
+	pool.AddData([]byte("hash-bob-7"), createTx("bob", 7), 0, "1_0")
+
+	journal, _ := NewTxPoolJournal(args)
+	err := journal.Snapshot()
+	require.Nil(t, err)
+	require.Nil(t, args.Storer.Has([]byte("hash-alice-1")))
+	require.Nil(t, args.Storer.Has([]byte("hash-alice-2")))
+	require.Nil(t, args.Storer.Has([]byte("hash-bob-7")))
+
+	txs := journal.LoadTransactions()
+	require.Len(t, txs, 3)
+	requireContainsTx(t, txs, createTx("alice", 1))
+	requireContainsTx(t, txs, createTx("alice", 2))
+	requireContainsTx(t, txs, createTx("bob", 7))
+}
+
+func TestTxPoolJournal_SnapshotShouldRemoveTransactionsNoLongerInPool(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal(t)
+	pool := args.TxPool.(dataRetriever.ShardedDataCacherNotifier)
+	pool.AddData([]byte("hash-alice-1"), createTx("alice", 1), 0, "0")
+	pool.AddData([]byte("hash-alice-2"), createTx("alice", 2), 0, "0")
+
+	journal, _ := NewTxPoolJournal(args)
+	_ = journal.Snapshot()
+
+	pool.RemoveData([]byte("hash-alice-1"), "0")
+	pool.AddData([]byte("hash-alice-3"), createTx("alice", 3), 0, "0")
+	err := journal.Snapshot()
+	require.Nil(t, err)
+
+	require.NotNil(t, args.Storer.Has([]byte("hash-alice-1")))
+	require.Nil(t, args.Storer.Has([]byte("hash-alice-2")))
+	require.Nil(t, args.Storer.Has([]byte("hash-alice-3")))
+	require.Len(t, journal.LoadTransactions(), 2)
+}
+
+func TestTxPoolJournal_RestartShouldReloadAndThenForgetTheStaleTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal(t)
+	pool := args.TxPool.(dataRetriever.ShardedDataCacherNotifier)
+	pool.AddData([]byte("hash-alice-1"), createTx("alice", 1), 0, "0")
+	pool.AddData([]byte("hash-alice-2"), createTx("alice", 2), 0, "0")
+
+	journal, _ := NewTxPoolJournal(args)
+	err := journal.Close()
+	require.Nil(t, err)
+
+	// the node restarts with an empty pool, on the same storer
+	argsAfterRestart := createMockArgTxPoolJournal(t)
+	argsAfterRestart.Storer = args.Storer
+	journalAfterRestart, _ := NewTxPoolJournal(argsAfterRestart)
+
+	poolAdder := &transactionsPoolAdderStub{}
+	err = journalAfterRestart.ReloadTransactions(poolAdder)
+	require.Nil(t, err)
+	require.Len(t, poolAdder.addedTxs, 2)
+	requireContainsTx(t, poolAdder.addedTxs, createTx("alice", 1))
+	requireContainsTx(t, poolAdder.addedTxs, createTx("alice", 2))
+
+	// only one transaction passed the revalidation and reached the pool again
+	poolAfterRestart := argsAfterRestart.TxPool.(dataRetriever.ShardedDataCacherNotifier)
+	poolAfterRestart.AddData([]byte("hash-alice-2"), createTx("alice", 2), 0, "0")
+	err = journalAfterRestart.Snapshot()
+	require.Nil(t, err)
+
+	require.NotNil(t, args.Storer.Has([]byte("hash-alice-1")))
+	require.Nil(t, args.Storer.Has([]byte("hash-alice-2")))
+}
+
+func TestTxPoolJournal_ReloadTransactionsErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal(t)
+	pool := args.TxPool.(dataRetriever.ShardedDataCacherNotifier)
+	pool.AddData([]byte("hash-alice-1"), createTx("alice", 1), 0, "0")
+	journal, _ := NewTxPoolJournal(args)
+	_ = journal.Snapshot()
+
+	err := journal.ReloadTransactions(nil)
+	require.Equal(t, dataRetriever.ErrNilTransactionsPoolAdder, err)
+
+	expectedErr := errors.New("expected error")
+	err = journal.ReloadTransactions(&transactionsPoolAdderStub{err: expectedErr})
+	require.Equal(t, expectedErr, err)
+}
+
+func TestTxPoolJournal_StartSnapshottingShouldSnapshotPeriodically(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgTxPoolJournal(t)
+	args.SnapshotInterval = time.Millisecond * 10
+	pool := args.TxPool.(dataRetriever.ShardedDataCacherNotifier)
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.StartSnapshotting()
+	pool.AddData([]byte("hash-alice-1"), createTx("alice", 1), 0, "0")
+	time.Sleep(time.Millisecond * 100)
+
+	require.Nil(t, args.Storer.Has([]byte("hash-alice-1")))
+	require.Nil(t, journal.Close())
+}
+
+func TestDisabledTxPoolJournal_ShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	journal := NewDisabledTxPoolJournal()
+	require.False(t, journal.IsInterfaceNil())
+	require.NotPanics(t, func() {
+		journal.StartSnapshotting()
+		require.Nil(t, journal.ReloadTransactions(nil))
+		require.Nil(t, journal.Close())
+	})
+}
+
+func createMockArgTxPoolJournal(t *testing.T) ArgTxPoolJournal {
+	pool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             1000,
+			SizePerSender:        100,
+			SizeInBytes:          1000000,
+			SizeInBytesPerSender: 100000,
+			Shards:               1,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 2,
+		SelfShardID:    0,
+	})
+	require.Nil(t, err)
+
+	return ArgTxPoolJournal{
+		TxPool:           pool,
+		Storer:           createMemStorer(),
+		Marshalizer:      &marshal.GogoProtoMarshalizer{},
+		SnapshotInterval: time.Minute,
+	}
+}
+
+func createMemStorer() storage.Storer {
+	cache, _ := storageUnit.NewCache(storageUnit.CacheConfig{Type: storageUnit.LRUCache, Capacity: 10, Shards: 1})
+	unit, _ := storageUnit.NewStorageUnit(cache, memorydb.New())
+
+	return unit
+}
+
+func createTx(sender string, nonce uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		RcvAddr:  []byte("receiver"),
+		Nonce:    nonce,
+		Value:    big.NewInt(10),
+		GasLimit: 50000,
+		GasPrice: 200000000000,
+	}
+}
+
+func requireContainsTx(t *testing.T, txs []*transaction.Transaction, expected *transaction.Transaction) {
+	for _, tx := range txs {
+		if tx.Nonce == expected.Nonce && string(tx.SndAddr) == string(expected.SndAddr) {
+			require.Equal(t, expected.RcvAddr, tx.RcvAddr)
+			require.Equal(t, expected.Value.String(), tx.Value.String())
+			require.Equal(t, expected.GasLimit, tx.GasLimit)
+			require.Equal(t, expected.GasPrice, tx.GasPrice)
+			return
+		}
+	}
+
+	require.Fail(t, "transaction not found", "sender %s, nonce %d", expected.SndAddr, expected.Nonce)
+}
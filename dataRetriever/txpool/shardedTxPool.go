@@ -56,6 +56,8 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
 		CountPerSenderThreshold:       args.Config.SizePerSender,
 		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+
+		ReplacementGasPriceBumpPercentage: args.Config.ReplacementGasPriceBumpPercentage,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -89,6 +91,21 @@ func (txPool *shardedTxPool) ShardDataStore(cacheID string) storage.Cacher {
 	return cache
 }
 
+// ExistingShardDataStore returns the requested cache only if it already exists (it does not create missing caches)
+func (txPool *shardedTxPool) ExistingShardDataStore(cacheID string) (storage.Cacher, bool) {
+	cacheID = txPool.routeToCacheUnions(cacheID)
+
+	txPool.mutexBackingMap.RLock()
+	shard, ok := txPool.backingMap[cacheID]
+	txPool.mutexBackingMap.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	return shard.Cache, true
+}
+
 // getTxCache returns the requested cache
 func (txPool *shardedTxPool) getTxCache(cacheID string) txCache {
 	shard := txPool.getOrCreateShard(cacheID)
@@ -226,6 +243,20 @@ func (txPool *shardedTxPool) searchFirstTx(txHash []byte) (tx data.TransactionHa
 	return nil, false
 }
 
+// ForEachTransaction iterates over the transactions of all the caches
+func (txPool *shardedTxPool) ForEachTransaction(function txcache.ForEachTransaction) {
+	txPool.mutexBackingMap.RLock()
+	shards := make([]*txPoolShard, 0, len(txPool.backingMap))
+	for _, shard := range txPool.backingMap {
+		shards = append(shards, shard)
+	}
+	txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range shards {
+		shard.Cache.ForEachTransaction(function)
+	}
+}
+
 // RemoveData removes the transaction from the pool
 func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 	txPool.removeTx(key, cacheID)
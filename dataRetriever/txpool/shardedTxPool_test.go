@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -96,7 +97,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
-	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1}
+	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1, ReplacementGasPriceBumpPercentage: 10}
 	args := ArgShardedTxPool{
 		Config: config,
 		TxGasHandler: &txcachemocks.TxGasHandlerMock{
@@ -116,6 +117,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 1000, int(pool.configPrototypeSourceMe.CountPerSenderThreshold))
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
+	require.Equal(t, 10, int(pool.configPrototypeSourceMe.ReplacementGasPriceBumpPercentage))
 
 	require.Equal(t, 300000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 209715200, int(pool.configPrototypeDestinationMe.MaxNumBytes))
@@ -130,6 +132,28 @@ func Test_ShardDataStore_Or_GetTxCache(t *testing.T) {
 	require.Equal(t, fooGenericCache, fooTxCache)
 }
 
+func Test_ExistingShardDataStore_DoesNotCreateMissingCaches(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	cache, ok := pool.ExistingShardDataStore("1_0")
+	require.False(t, ok)
+	require.Nil(t, cache)
+	require.Equal(t, 0, len(pool.backingMap))
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "1_0")
+	cache, ok = pool.ExistingShardDataStore("1_0")
+	require.True(t, ok)
+	require.Equal(t, pool.getTxCache("1_0"), cache)
+
+	// caches having the current shard as source are routed to the union
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	cache, ok = pool.ExistingShardDataStore("0_2")
+	require.True(t, ok)
+	require.Equal(t, pool.getTxCache("0"), cache)
+	require.Equal(t, 2, len(pool.backingMap))
+}
+
 func Test_ShardDataStore_CreatesIfMissingWithoutConcurrencyIssues(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -206,6 +230,52 @@ func Test_AddData_CallsOnAddedHandlers(t *testing.T) {
 	require.Equal(t, uint32(1), atomic.LoadUint32(&numAdded))
 }
 
+func Test_AddData_ReplacesTransactionWhenGasPriceBumpIsSufficient(t *testing.T) {
+	config := storageUnit.CacheConfig{
+		Capacity:                          100,
+		SizePerSender:                     10,
+		SizeInBytes:                       409600,
+		SizeInBytesPerSender:              40960,
+		Shards:                            1,
+		ReplacementGasPriceBumpPercentage: 10,
+	}
+	args := ArgShardedTxPool{
+		Config: config,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	numAdded := uint32(0)
+	pool.RegisterOnAdded(func(key []byte, value interface{}) {
+		atomic.AddUint32(&numAdded, 1)
+	})
+
+	pool.AddData([]byte("hash-x"), createTxWithGasPrice("alice", 42, 200000000000), 0, "0")
+	// Underpriced, discarded (and not notified)
+	pool.AddData([]byte("hash-y"), createTxWithGasPrice("alice", 42, 210000000000), 0, "0")
+	// Sufficiently priced, replaces "hash-x"
+	pool.AddData([]byte("hash-z"), createTxWithGasPrice("alice", 42, 220000000000), 0, "0")
+
+	cache := pool.getTxCache("0")
+	require.Equal(t, 1, cache.Len())
+	_, ok := cache.GetByTxHash([]byte("hash-x"))
+	require.False(t, ok)
+	_, ok = cache.GetByTxHash([]byte("hash-y"))
+	require.False(t, ok)
+	_, ok = cache.GetByTxHash([]byte("hash-z"))
+	require.True(t, ok)
+
+	waitABit()
+	require.Equal(t, uint32(2), atomic.LoadUint32(&numAdded))
+}
+
 func Test_SearchFirstData(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -228,6 +298,25 @@ func Test_SearchFirstData(t *testing.T) {
 	require.Equal(t, tx, foundTx)
 }
 
+func Test_ForEachTransaction(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0_1")
+	pool.AddData([]byte("hash-z"), createTx("bob", 7), 0, "1_0")
+
+	visited := make(map[string]struct{})
+	pool.ForEachTransaction(func(txHash []byte, _ *txcache.WrappedTransaction) {
+		visited[string(txHash)] = struct{}{}
+	})
+
+	require.Len(t, visited, 3)
+	require.Contains(t, visited, "hash-x")
+	require.Contains(t, visited, "hash-y")
+	require.Contains(t, visited, "hash-z")
+}
+
 func Test_RemoveData(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -381,6 +470,14 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
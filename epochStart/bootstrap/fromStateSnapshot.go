@@ -0,0 +1,111 @@
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+)
+
+// importStateSnapshotIfEnabled writes the trie nodes of the configured state snapshot archive into the tries storage,
+// so that the following tries sync finds them locally instead of requesting them from the network. Any failure only
+// disables the shortcut, the tries being then synced from the network as usual
+func (e *epochStartBootstrap) importStateSnapshotIfEnabled(expected *snapshot.ExpectedState) {
+	snapshotConfig := e.generalConfig.StateSnapshotImport
+	if !snapshotConfig.Enabled {
+		return
+	}
+
+	log.Info("start in epoch bootstrap: importing the state snapshot", "file", snapshotConfig.FilePath)
+	result, err := e.importStateSnapshot(snapshotConfig.FilePath, expected)
+	if err != nil {
+		log.Warn("start in epoch bootstrap: the state snapshot was not imported, the tries will be synced from the network",
+			"file", snapshotConfig.FilePath, "error", err)
+		return
+	}
+
+	log.Info("start in epoch bootstrap: state snapshot imported",
+		"epoch", result.Manifest.Epoch,
+		"shard", result.Manifest.ShardID,
+		"root hash", result.Manifest.RootHash,
+		"accounts trie nodes", result.NumAccountsTrieNodes,
+		"peer accounts trie nodes", result.NumPeerAccountsTrieNodes,
+	)
+	e.checkSnapshotNodesConfig(result)
+}
+
+func (e *epochStartBootstrap) importStateSnapshot(filePath string, expected *snapshot.ExpectedState) (*snapshot.ImportResult, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := file.Close()
+		log.LogIfError(errClose)
+	}()
+
+	argsImporter := snapshot.ArgsImporter{
+		Marshalizer:     e.marshalizer,
+		Hasher:          e.hasher,
+		AccountsStorage: e.trieStorageManagers[factory.UserAccountTrie].Database(),
+	}
+	if expected.ShardID == core.MetachainShardId {
+		argsImporter.PeerAccountsStorage = e.trieStorageManagers[factory.PeerAccountTrie].Database()
+	}
+
+	importer, err := snapshot.NewImporter(argsImporter)
+	if err != nil {
+		return nil, err
+	}
+
+	return importer.Import(file, expected)
+}
+
+// checkSnapshotNodesConfig compares the nodes config of the snapshot with the one computed from the network data. The
+// latter is always the one used, a mismatch only hints that the snapshot was not produced by an honest exporter
+func (e *epochStartBootstrap) checkSnapshotNodesConfig(result *snapshot.ImportResult) {
+	if result.NodesConfig == nil || e.nodesConfig == nil {
+		return
+	}
+
+	snapshotNodesConfig, errSnapshot := json.Marshal(result.NodesConfig)
+	computedNodesConfig, errComputed := json.Marshal(e.nodesConfig)
+	if errSnapshot != nil || errComputed != nil {
+		return
+	}
+
+	if !bytes.Equal(snapshotNodesConfig, computedNodesConfig) {
+		log.Warn("start in epoch bootstrap: the nodes config of the state snapshot differs from the one synced from the network")
+	}
+}
+
+func (e *epochStartBootstrap) createExpectedMetaState() (*snapshot.ExpectedState, error) {
+	metaBlockHash, err := core.CalculateHash(e.marshalizer, e.hasher, e.epochStartMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot.ExpectedState{
+		ShardID:                 core.MetachainShardId,
+		EpochStartMetaBlockHash: metaBlockHash,
+		RootHash:                e.epochStartMeta.RootHash,
+		ValidatorStatsRootHash:  e.epochStartMeta.ValidatorStatsRootHash,
+	}, nil
+}
+
+func (e *epochStartBootstrap) createExpectedShardState(shardHeaderHash []byte, rootHash []byte) (*snapshot.ExpectedState, error) {
+	metaBlockHash, err := core.CalculateHash(e.marshalizer, e.hasher, e.epochStartMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot.ExpectedState{
+		ShardID:                 e.shardCoordinator.SelfId(),
+		EpochStartMetaBlockHash: metaBlockHash,
+		ShardHeaderHash:         shardHeaderHash,
+		RootHash:                rootHash,
+	}, nil
+}
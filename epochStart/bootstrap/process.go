@@ -692,7 +692,11 @@ func (e *epochStartBootstrap) processNodesConfig(pubKey []byte) error {
 }
 
 func (e *epochStartBootstrap) requestAndProcessForMeta() error {
-	var err error
+	expectedState, err := e.createExpectedMetaState()
+	if err != nil {
+		return err
+	}
+	e.importStateSnapshotIfEnabled(expectedState)
 
 	log.Debug("start in epoch bootstrap: started syncPeerAccountsState")
 	err = e.syncPeerAccountsState(e.epochStartMeta.ValidatorStatsRootHash)
@@ -798,6 +802,12 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 		return epochStart.ErrWrongTypeAssertion
 	}
 
+	expectedState, err := e.createExpectedShardState(epochStartData.HeaderHash, ownShardHdr.RootHash)
+	if err != nil {
+		return err
+	}
+	e.importStateSnapshotIfEnabled(expectedState)
+
 	log.Debug("start in epoch bootstrap: started syncUserAccountsState")
 	err = e.syncUserAccountsState(ownShardHdr.RootHash)
 	if err != nil {
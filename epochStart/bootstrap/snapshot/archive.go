@@ -0,0 +1,348 @@
+package snapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// ArchiveVersion is the version of the state snapshot archives written by this package
+const ArchiveVersion = uint32(1)
+
+// maxRecordPartSize bounds the size of a record key or value, so that a corrupted archive does not cause huge
+// allocations
+const maxRecordPartSize = 64 * 1024 * 1024
+
+var archiveMagic = []byte("ELRDSNAP")
+
+// RecordType defines the type of a record from a state snapshot archive
+type RecordType uint8
+
+const (
+	// EpochStartMetaBlockRecord holds the marshalized epoch start metablock, keyed by its hash
+	EpochStartMetaBlockRecord RecordType = iota + 1
+	// ShardHeaderRecord holds the marshalized epoch start shard header, keyed by its hash
+	ShardHeaderRecord
+	// NodesConfigRecord holds the json encoded nodes coordinator registry of the epoch
+	NodesConfigRecord
+	// AccountsTrieNodeRecord holds an encoded node of the accounts trie or of an accounts data trie, keyed by its hash
+	AccountsTrieNodeRecord
+	// PeerAccountsTrieNodeRecord holds an encoded node of the validators trie, keyed by its hash
+	PeerAccountsTrieNodeRecord
+	// ManifestRecord holds the json encoded manifest and is the last record of the archive
+	ManifestRecord
+)
+
+var recordTypeNames = map[RecordType]string{
+	EpochStartMetaBlockRecord:  "epochStartMetaBlock",
+	ShardHeaderRecord:          "shardHeader",
+	NodesConfigRecord:          "nodesConfig",
+	AccountsTrieNodeRecord:     "accountsTrieNode",
+	PeerAccountsTrieNodeRecord: "peerAccountsTrieNode",
+	ManifestRecord:             "manifest",
+}
+
+// String returns the human readable name of the record type
+func (rt RecordType) String() string {
+	name, ok := recordTypeNames[rt]
+	if !ok {
+		return fmt.Sprintf("unknown(%d)", uint8(rt))
+	}
+
+	return name
+}
+
+// RecordsInfo holds the number of records of a type and the hex encoded sha256 checksum over all of them
+type RecordsInfo struct {
+	Type       string `json:"type"`
+	NumRecords uint64 `json:"numRecords"`
+	Checksum   string `json:"checksum"`
+}
+
+// Manifest describes the content of a state snapshot archive. The hashes are hex encoded
+type Manifest struct {
+	Version                 uint32         `json:"version"`
+	Epoch                   uint32         `json:"epoch"`
+	ShardID                 uint32         `json:"shardID"`
+	EpochStartMetaBlockHash string         `json:"epochStartMetaBlockHash"`
+	ShardHeaderHash         string         `json:"shardHeaderHash,omitempty"`
+	RootHash                string         `json:"rootHash"`
+	ValidatorStatsRootHash  string         `json:"validatorStatsRootHash,omitempty"`
+	Records                 []*RecordsInfo `json:"records"`
+}
+
+type recordsChecksum struct {
+	numRecords uint64
+	hasher     hash.Hash
+}
+
+type recordsChecksums struct {
+	checksums map[RecordType]*recordsChecksum
+}
+
+func newRecordsChecksums() *recordsChecksums {
+	return &recordsChecksums{
+		checksums: make(map[RecordType]*recordsChecksum),
+	}
+}
+
+func (rc *recordsChecksums) add(recordType RecordType, encodedRecord []byte) {
+	checksum, ok := rc.checksums[recordType]
+	if !ok {
+		checksum = &recordsChecksum{
+			hasher: sha256.New(),
+		}
+		rc.checksums[recordType] = checksum
+	}
+
+	checksum.numRecords++
+	_, _ = checksum.hasher.Write(encodedRecord)
+}
+
+// recordsInfo returns the records information sorted by the record type
+func (rc *recordsChecksums) recordsInfo() []*RecordsInfo {
+	recordsInfo := make([]*RecordsInfo, 0, len(rc.checksums))
+	for recordType := EpochStartMetaBlockRecord; recordType < ManifestRecord; recordType++ {
+		checksum, ok := rc.checksums[recordType]
+		if !ok {
+			continue
+		}
+
+		recordsInfo = append(recordsInfo, &RecordsInfo{
+			Type:       recordType.String(),
+			NumRecords: checksum.numRecords,
+			Checksum:   hex.EncodeToString(checksum.hasher.Sum(nil)),
+		})
+	}
+
+	return recordsInfo
+}
+
+func (rc *recordsChecksums) verify(manifest *Manifest) error {
+	computed := rc.recordsInfo()
+	if len(computed) != len(manifest.Records) {
+		return fmt.Errorf("%w: %d record types found, the manifest declares %d",
+			ErrChecksumMismatch, len(computed), len(manifest.Records))
+	}
+
+	for i, info := range computed {
+		declared := manifest.Records[i]
+		if declared == nil || *declared != *info {
+			return fmt.Errorf("%w for the %s records", ErrChecksumMismatch, info.Type)
+		}
+	}
+
+	return nil
+}
+
+func encodeRecord(recordType RecordType, key []byte, value []byte) []byte {
+	buff := make([]byte, 0, 1+2*binary.MaxVarintLen64+len(key)+len(value))
+	buff = append(buff, byte(recordType))
+	buff = appendUvarint(buff, uint64(len(key)))
+	buff = append(buff, key...)
+	buff = appendUvarint(buff, uint64(len(value)))
+	buff = append(buff, value...)
+
+	return buff
+}
+
+func appendUvarint(buff []byte, value uint64) []byte {
+	varint := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(varint, value)
+
+	return append(buff, varint[:n]...)
+}
+
+type archiveWriter struct {
+	gzipWriter *gzip.Writer
+	writer     *bufio.Writer
+	checksums  *recordsChecksums
+	closed     bool
+}
+
+// NewArchiveWriter creates a writer of a state snapshot archive: a gzip compressed stream of typed key-value records
+// ending with the manifest, which holds a checksum for each records type. The provided writer is not closed
+func NewArchiveWriter(w io.Writer) (*archiveWriter, error) {
+	gzipWriter := gzip.NewWriter(w)
+	aw := &archiveWriter{
+		gzipWriter: gzipWriter,
+		writer:     bufio.NewWriter(gzipWriter),
+		checksums:  newRecordsChecksums(),
+	}
+
+	header := appendUvarint(append([]byte{}, archiveMagic...), uint64(ArchiveVersion))
+	_, err := aw.writer.Write(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return aw, nil
+}
+
+// WriteRecord appends a record to the archive
+func (aw *archiveWriter) WriteRecord(recordType RecordType, key []byte, value []byte) error {
+	if aw.closed {
+		return ErrArchiveClosed
+	}
+	_, ok := recordTypeNames[recordType]
+	if !ok || recordType == ManifestRecord {
+		return fmt.Errorf("%w: %s", ErrUnknownRecordType, recordType)
+	}
+
+	encodedRecord := encodeRecord(recordType, key, value)
+	aw.checksums.add(recordType, encodedRecord)
+
+	_, err := aw.writer.Write(encodedRecord)
+
+	return err
+}
+
+// Close fills the records information of the provided manifest, writes it as the last record and flushes the archive
+func (aw *archiveWriter) Close(manifest *Manifest) error {
+	if aw.closed {
+		return ErrArchiveClosed
+	}
+	aw.closed = true
+
+	manifest.Version = ArchiveVersion
+	manifest.Records = aw.checksums.recordsInfo()
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	_, err = aw.writer.Write(encodeRecord(ManifestRecord, nil, manifestBytes))
+	if err != nil {
+		return err
+	}
+
+	err = aw.writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	return aw.gzipWriter.Close()
+}
+
+type archiveReader struct {
+	gzipReader *gzip.Reader
+	reader     *bufio.Reader
+	checksums  *recordsChecksums
+	manifest   *Manifest
+}
+
+// NewArchiveReader creates a reader of a state snapshot archive. The records checksums are verified when reaching the
+// manifest, so the records read before it should be considered untrusted until ReadRecord returns io.EOF
+func NewArchiveReader(r io.Reader) (*archiveReader, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+
+	ar := &archiveReader{
+		gzipReader: gzipReader,
+		reader:     bufio.NewReader(gzipReader),
+		checksums:  newRecordsChecksums(),
+	}
+
+	magic := make([]byte, len(archiveMagic))
+	_, err = io.ReadFull(ar.reader, magic)
+	if err != nil || string(magic) != string(archiveMagic) {
+		return nil, ErrInvalidArchive
+	}
+
+	version, err := binary.ReadUvarint(ar.reader)
+	if err != nil {
+		return nil, ErrInvalidArchive
+	}
+	if version != uint64(ArchiveVersion) {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedArchiveVersion, version)
+	}
+
+	return ar, nil
+}
+
+// ReadRecord returns the next record of the archive. When reaching the manifest, the checksums of all the read records
+// are verified and io.EOF is returned if they match
+func (ar *archiveReader) ReadRecord() (RecordType, []byte, []byte, error) {
+	if ar.manifest != nil {
+		return 0, nil, nil, io.EOF
+	}
+
+	typeByte, err := ar.reader.ReadByte()
+	if err == io.EOF {
+		return 0, nil, nil, ErrMissingManifest
+	}
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	recordType := RecordType(typeByte)
+	_, ok := recordTypeNames[recordType]
+	if !ok {
+		return 0, nil, nil, fmt.Errorf("%w: %s", ErrUnknownRecordType, recordType)
+	}
+
+	key, err := ar.readRecordPart()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	value, err := ar.readRecordPart()
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	if recordType != ManifestRecord {
+		ar.checksums.add(recordType, encodeRecord(recordType, key, value))
+		return recordType, key, value, nil
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(value, manifest)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+
+	err = ar.checksums.verify(manifest)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	ar.manifest = manifest
+
+	return 0, nil, nil, io.EOF
+}
+
+func (ar *archiveReader) readRecordPart() ([]byte, error) {
+	size, err := binary.ReadUvarint(ar.reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+	if size > maxRecordPartSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrRecordTooLarge, size)
+	}
+
+	buff := make([]byte, size)
+	_, err = io.ReadFull(ar.reader, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err.Error())
+	}
+
+	return buff, nil
+}
+
+// Manifest returns the verified manifest of the archive, once all the records were read
+func (ar *archiveReader) Manifest() *Manifest {
+	return ar.manifest
+}
+
+// Close closes the decompression stream. The provided reader is not closed
+func (ar *archiveReader) Close() error {
+	return ar.gzipReader.Close()
+}
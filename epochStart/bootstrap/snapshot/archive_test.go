@@ -0,0 +1,109 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive_WriteAndReadRecords(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	writer, err := NewArchiveWriter(buff)
+	require.Nil(t, err)
+
+	require.Nil(t, writer.WriteRecord(EpochStartMetaBlockRecord, []byte("metaHash"), []byte("meta")))
+	require.Nil(t, writer.WriteRecord(AccountsTrieNodeRecord, []byte("node1"), []byte("value1")))
+	require.Nil(t, writer.WriteRecord(AccountsTrieNodeRecord, []byte("node2"), []byte("value2")))
+	err = writer.WriteRecord(ManifestRecord, nil, []byte("manifest"))
+	assert.True(t, errors.Is(err, ErrUnknownRecordType))
+
+	manifest := &Manifest{Epoch: 7, RootHash: "aa"}
+	require.Nil(t, writer.Close(manifest))
+	assert.Equal(t, ArchiveVersion, manifest.Version)
+	require.Equal(t, 2, len(manifest.Records))
+	assert.Equal(t, EpochStartMetaBlockRecord.String(), manifest.Records[0].Type)
+	assert.Equal(t, uint64(2), manifest.Records[1].NumRecords)
+	assert.Equal(t, ErrArchiveClosed, writer.WriteRecord(AccountsTrieNodeRecord, []byte("node3"), []byte("value3")))
+
+	reader, err := NewArchiveReader(buff)
+	require.Nil(t, err)
+
+	expectedKeys := []string{"metaHash", "node1", "node2"}
+	for _, expectedKey := range expectedKeys {
+		_, key, _, errRead := reader.ReadRecord()
+		require.Nil(t, errRead)
+		assert.Equal(t, expectedKey, string(key))
+	}
+
+	_, _, _, err = reader.ReadRecord()
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, manifest, reader.Manifest())
+	assert.Nil(t, reader.Close())
+}
+
+func TestArchive_ReadInvalidArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	reader, err := NewArchiveReader(bytes.NewBufferString("not an archive"))
+	assert.Nil(t, reader)
+	assert.True(t, errors.Is(err, ErrInvalidArchive))
+}
+
+func TestArchive_ReadWithoutManifestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	writer, _ := NewArchiveWriter(buff)
+	_ = writer.WriteRecord(AccountsTrieNodeRecord, []byte("node"), []byte("value"))
+	_ = writer.writer.Flush()
+	_ = writer.gzipWriter.Close()
+
+	reader, err := NewArchiveReader(buff)
+	require.Nil(t, err)
+
+	_, _, _, err = reader.ReadRecord()
+	require.Nil(t, err)
+	_, _, _, err = reader.ReadRecord()
+	assert.Equal(t, ErrMissingManifest, err)
+}
+
+func TestArchive_ReadTruncatedArchiveShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	writer, _ := NewArchiveWriter(buff)
+	value := make([]byte, 10000)
+	_, _ = rand.Read(value)
+	_ = writer.WriteRecord(AccountsTrieNodeRecord, []byte("node"), value)
+	_ = writer.Close(&Manifest{})
+
+	truncated := buff.Bytes()[:buff.Len()/2]
+	reader, err := NewArchiveReader(bytes.NewReader(truncated))
+	require.Nil(t, err)
+
+	_, _, _, err = reader.ReadRecord()
+	assert.True(t, errors.Is(err, ErrInvalidArchive))
+}
+
+func TestRecordsChecksums_VerifyMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	checksums := newRecordsChecksums()
+	checksums.add(AccountsTrieNodeRecord, encodeRecord(AccountsTrieNodeRecord, []byte("node"), []byte("value")))
+
+	manifest := &Manifest{Records: checksums.recordsInfo()}
+	assert.Nil(t, checksums.verify(manifest))
+
+	manifest.Records[0].NumRecords++
+	assert.True(t, errors.Is(checksums.verify(manifest), ErrChecksumMismatch))
+
+	manifest.Records = nil
+	assert.True(t, errors.Is(checksums.verify(manifest), ErrChecksumMismatch))
+}
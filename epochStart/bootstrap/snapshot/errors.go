@@ -0,0 +1,66 @@
+package snapshot
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAccountsTrie signals that a nil accounts trie has been provided
+var ErrNilAccountsTrie = errors.New("nil accounts trie")
+
+// ErrNilPeerAccountsTrie signals that a nil peer accounts trie has been provided when exporting the metachain state
+var ErrNilPeerAccountsTrie = errors.New("nil peer accounts trie")
+
+// ErrNilAccountsStorage signals that a nil accounts trie storage has been provided
+var ErrNilAccountsStorage = errors.New("nil accounts trie storage")
+
+// ErrNilPeerAccountsStorage signals that a nil peer accounts trie storage has been provided
+var ErrNilPeerAccountsStorage = errors.New("nil peer accounts trie storage")
+
+// ErrNilEpochStartMetaBlock signals that a nil epoch start metablock has been provided
+var ErrNilEpochStartMetaBlock = errors.New("nil epoch start metablock")
+
+// ErrNilShardHeader signals that a nil shard header has been provided when exporting the state of a shard
+var ErrNilShardHeader = errors.New("nil shard header")
+
+// ErrNilNodesConfig signals that a nil nodes config has been provided
+var ErrNilNodesConfig = errors.New("nil nodes config")
+
+// ErrNotAnEpochStartMetaBlock signals that the provided metablock does not start an epoch
+var ErrNotAnEpochStartMetaBlock = errors.New("the metablock does not start an epoch")
+
+// ErrInvalidArchive signals that the archive is not a state snapshot archive
+var ErrInvalidArchive = errors.New("invalid state snapshot archive")
+
+// ErrUnsupportedArchiveVersion signals that the archive was written in a version which can not be read
+var ErrUnsupportedArchiveVersion = errors.New("unsupported state snapshot archive version")
+
+// ErrRecordTooLarge signals that a record of the archive exceeds the maximum accepted size
+var ErrRecordTooLarge = errors.New("state snapshot record too large")
+
+// ErrUnknownRecordType signals that a record of the archive has an unknown type
+var ErrUnknownRecordType = errors.New("unknown state snapshot record type")
+
+// ErrArchiveClosed signals that records are read or written after the archive manifest
+var ErrArchiveClosed = errors.New("state snapshot archive already closed")
+
+// ErrMissingManifest signals that the archive ended before its manifest
+var ErrMissingManifest = errors.New("state snapshot archive without manifest")
+
+// ErrChecksumMismatch signals that the records of the archive do not match the checksums of the manifest
+var ErrChecksumMismatch = errors.New("state snapshot checksum mismatch")
+
+// ErrHashMismatch signals that an archived header or trie node does not match its expected hash
+var ErrHashMismatch = errors.New("state snapshot hash mismatch")
+
+// ErrRootHashMismatch signals that the root hashes of the archive do not match the ones of the header
+var ErrRootHashMismatch = errors.New("state snapshot root hash mismatch")
+
+// ErrUnexpectedRecordsOrder signals that the trie nodes are found in the archive before the verified headers
+var ErrUnexpectedRecordsOrder = errors.New("unexpected state snapshot records order")
+
+// ErrMissingRootNode signals that the archive does not hold the root node of an imported trie
+var ErrMissingRootNode = errors.New("state snapshot without the trie root node")
@@ -0,0 +1,288 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("epochStart/bootstrap/snapshot")
+
+// ArgsExporter holds the arguments needed for creating a new state snapshot exporter
+type ArgsExporter struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	AccountsTrie     data.Trie
+	PeerAccountsTrie data.Trie
+}
+
+// ExportData holds the epoch start data whose state is exported. The shard header is nil when exporting the
+// metachain state
+type ExportData struct {
+	ShardID             uint32
+	EpochStartMetaBlock *block.MetaBlock
+	ShardHeader         *block.Header
+	NodesConfig         *sharding.NodesCoordinatorRegistry
+}
+
+type exporter struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	accountsTrie     data.Trie
+	peerAccountsTrie data.Trie
+}
+
+// NewExporter creates a component which writes the state of an epoch start block into a state snapshot archive
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AccountsTrie) {
+		return nil, ErrNilAccountsTrie
+	}
+
+	return &exporter{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		accountsTrie:     args.AccountsTrie,
+		peerAccountsTrie: args.PeerAccountsTrie,
+	}, nil
+}
+
+// Export writes the epoch start headers, the nodes config, the accounts trie together with all the accounts data tries
+// and, for the metachain, the validators trie into the provided writer. The returned manifest is the one closing the
+// archive
+func (se *exporter) Export(w io.Writer, exportData *ExportData) (*Manifest, error) {
+	err := se.checkExportData(exportData)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := NewArchiveWriter(w)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := exportData.EpochStartMetaBlock
+	manifest := &Manifest{
+		Epoch:   metaBlock.Epoch,
+		ShardID: exportData.ShardID,
+	}
+
+	metaBlockHash, err := se.writeHeader(archive, EpochStartMetaBlockRecord, metaBlock)
+	if err != nil {
+		return nil, err
+	}
+	manifest.EpochStartMetaBlockHash = hex.EncodeToString(metaBlockHash)
+
+	rootHash := metaBlock.RootHash
+	if exportData.ShardID != core.MetachainShardId {
+		var shardHeaderHash []byte
+		shardHeaderHash, err = se.writeHeader(archive, ShardHeaderRecord, exportData.ShardHeader)
+		if err != nil {
+			return nil, err
+		}
+
+		manifest.ShardHeaderHash = hex.EncodeToString(shardHeaderHash)
+		rootHash = exportData.ShardHeader.RootHash
+	}
+	manifest.RootHash = hex.EncodeToString(rootHash)
+
+	nodesConfigBytes, err := json.Marshal(exportData.NodesConfig)
+	if err != nil {
+		return nil, err
+	}
+	err = archive.WriteRecord(NodesConfigRecord, nil, nodesConfigBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = se.writeAccountsTries(archive, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if exportData.ShardID == core.MetachainShardId {
+		manifest.ValidatorStatsRootHash = hex.EncodeToString(metaBlock.ValidatorStatsRootHash)
+
+		var numNodes uint64
+		numNodes, err = se.writeTrie(archive, PeerAccountsTrieNodeRecord, se.peerAccountsTrie, metaBlock.ValidatorStatsRootHash)
+		if err != nil {
+			return nil, err
+		}
+		log.Debug("exported the validators trie", "root hash", metaBlock.ValidatorStatsRootHash, "num nodes", numNodes)
+	}
+
+	err = archive.Close(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (se *exporter) checkExportData(exportData *ExportData) error {
+	if exportData == nil || exportData.EpochStartMetaBlock == nil {
+		return ErrNilEpochStartMetaBlock
+	}
+	if !exportData.EpochStartMetaBlock.IsStartOfEpochBlock() {
+		return ErrNotAnEpochStartMetaBlock
+	}
+	if exportData.NodesConfig == nil {
+		return ErrNilNodesConfig
+	}
+	if exportData.ShardID == core.MetachainShardId {
+		if check.IfNil(se.peerAccountsTrie) {
+			return ErrNilPeerAccountsTrie
+		}
+		return nil
+	}
+	if exportData.ShardHeader == nil {
+		return ErrNilShardHeader
+	}
+
+	return nil
+}
+
+func (se *exporter) writeHeader(archive *archiveWriter, recordType RecordType, header data.HeaderHandler) ([]byte, error) {
+	headerBytes, err := se.marshalizer.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	headerHash := se.hasher.Compute(string(headerBytes))
+	err = archive.WriteRecord(recordType, headerHash, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return headerHash, nil
+}
+
+func (se *exporter) writeAccountsTries(archive *archiveWriter, rootHash []byte) error {
+	numNodes, err := se.writeTrie(archive, AccountsTrieNodeRecord, se.accountsTrie, rootHash)
+	if err != nil {
+		return err
+	}
+	log.Debug("exported the accounts trie", "root hash", rootHash, "num nodes", numNodes)
+
+	dataTriesRootHashes, err := se.getDataTriesRootHashes(rootHash)
+	if err != nil {
+		return err
+	}
+
+	numDataTriesNodes := uint64(0)
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		numNodes, err = se.writeTrie(archive, AccountsTrieNodeRecord, se.accountsTrie, dataTrieRootHash)
+		if err != nil {
+			return err
+		}
+
+		numDataTriesNodes += numNodes
+	}
+	log.Debug("exported the accounts data tries", "num tries", len(dataTriesRootHashes), "num nodes", numDataTriesNodes)
+
+	return nil
+}
+
+// getDataTriesRootHashes returns the distinct root hashes of the accounts data tries
+func (se *exporter) getDataTriesRootHashes(rootHash []byte) ([][]byte, error) {
+	leavesChannel, err := se.accountsTrie.GetAllLeavesOnChannel(rootHash, context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	rootHashes := make([][]byte, 0)
+	foundRootHashes := make(map[string]struct{})
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		err = se.marshalizer.Unmarshal(account, leaf.Value())
+		if err != nil {
+			log.Trace("this must be a leaf with code", "err", err)
+			continue
+		}
+
+		if len(account.RootHash) == 0 {
+			continue
+		}
+		_, found := foundRootHashes[string(account.RootHash)]
+		if found {
+			continue
+		}
+
+		foundRootHashes[string(account.RootHash)] = struct{}{}
+		rootHashes = append(rootHashes, account.RootHash)
+	}
+
+	return rootHashes, nil
+}
+
+// writeTrie walks all the nodes of the trie having the provided root hash and writes them, returning their number
+func (se *exporter) writeTrie(archive *archiveWriter, recordType RecordType, tr data.Trie, rootHash []byte) (uint64, error) {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		return 0, nil
+	}
+
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	it, err := trie.NewIterator(recreatedTrie)
+	if err != nil {
+		return 0, err
+	}
+
+	numNodes := uint64(0)
+	for {
+		err = se.writeCurrentNode(archive, recordType, it)
+		if err != nil {
+			return 0, err
+		}
+		numNodes++
+
+		if !it.HasNext() {
+			return numNodes, nil
+		}
+
+		err = it.Next()
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (se *exporter) writeCurrentNode(archive *archiveWriter, recordType RecordType, it nodesIterator) error {
+	nodeHash, err := it.GetHash()
+	if err != nil {
+		return err
+	}
+
+	encodedNode, err := it.MarshalizedNode()
+	if err != nil {
+		return err
+	}
+
+	return archive.WriteRecord(recordType, nodeHash, encodedNode)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *exporter) IsInterfaceNil() bool {
+	return se == nil
+}
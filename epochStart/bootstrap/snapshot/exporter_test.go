@@ -0,0 +1,244 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/snapshot"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var marshalizer = &mock.MarshalizerMock{}
+var hasher = &mock.HasherMock{}
+
+func createTrie() data.Trie {
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+
+	return tr
+}
+
+func createAccountsWithDataTries(t *testing.T, numAccounts int) (data.Trie, []byte) {
+	tr := createTrie()
+	adb, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	for i := 0; i < numAccounts; i++ {
+		address := make([]byte, 32)
+		address[0] = byte(i + 1)
+
+		account, errLoad := adb.LoadAccount(address)
+		require.Nil(t, errLoad)
+		for j := 0; j < i; j++ {
+			err = account.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte(fmt.Sprintf("key%d", j)), []byte("value"))
+			require.Nil(t, err)
+		}
+		require.Nil(t, adb.SaveAccount(account))
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return tr, rootHash
+}
+
+func createExportData(rootHash []byte) *snapshot.ExportData {
+	shardHeader := &block.Header{Nonce: 10, Epoch: 2, RootHash: rootHash}
+	shardHeaderHash, _ := core.CalculateHash(marshalizer, hasher, shardHeader)
+
+	return &snapshot.ExportData{
+		ShardID: 0,
+		EpochStartMetaBlock: &block.MetaBlock{
+			Nonce: 20,
+			Epoch: 2,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0, HeaderHash: shardHeaderHash, RootHash: rootHash}},
+			},
+		},
+		ShardHeader: shardHeader,
+		NodesConfig: &sharding.NodesCoordinatorRegistry{CurrentEpoch: 2},
+	}
+}
+
+func createExpectedShardState(exportData *snapshot.ExportData) *snapshot.ExpectedState {
+	metaBlockHash, _ := core.CalculateHash(marshalizer, hasher, exportData.EpochStartMetaBlock)
+	shardHeaderHash, _ := core.CalculateHash(marshalizer, hasher, exportData.ShardHeader)
+
+	return &snapshot.ExpectedState{
+		ShardID:                 exportData.ShardID,
+		EpochStartMetaBlockHash: metaBlockHash,
+		ShardHeaderHash:         shardHeaderHash,
+		RootHash:                exportData.ShardHeader.RootHash,
+	}
+}
+
+func exportState(t *testing.T, args snapshot.ArgsExporter, exportData *snapshot.ExportData) (*bytes.Buffer, *snapshot.Manifest) {
+	exporter, err := snapshot.NewExporter(args)
+	require.Nil(t, err)
+
+	buff := &bytes.Buffer{}
+	manifest, err := exporter.Export(buff, exportData)
+	require.Nil(t, err)
+
+	return buff, manifest
+}
+
+func TestNewExporter_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	exporter, err := snapshot.NewExporter(snapshot.ArgsExporter{Hasher: hasher, AccountsTrie: createTrie()})
+	assert.Nil(t, exporter)
+	assert.Equal(t, snapshot.ErrNilMarshalizer, err)
+
+	exporter, err = snapshot.NewExporter(snapshot.ArgsExporter{Marshalizer: marshalizer, AccountsTrie: createTrie()})
+	assert.Nil(t, exporter)
+	assert.Equal(t, snapshot.ErrNilHasher, err)
+
+	exporter, err = snapshot.NewExporter(snapshot.ArgsExporter{Marshalizer: marshalizer, Hasher: hasher})
+	assert.Nil(t, exporter)
+	assert.Equal(t, snapshot.ErrNilAccountsTrie, err)
+}
+
+func TestExporter_ExportInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	exporter, _ := snapshot.NewExporter(snapshot.ArgsExporter{Marshalizer: marshalizer, Hasher: hasher, AccountsTrie: createTrie()})
+
+	exportData := createExportData(nil)
+	exportData.EpochStartMetaBlock.EpochStart.LastFinalizedHeaders = nil
+	_, err := exporter.Export(&bytes.Buffer{}, exportData)
+	assert.Equal(t, snapshot.ErrNotAnEpochStartMetaBlock, err)
+
+	exportData = createExportData(nil)
+	exportData.ShardHeader = nil
+	_, err = exporter.Export(&bytes.Buffer{}, exportData)
+	assert.Equal(t, snapshot.ErrNilShardHeader, err)
+
+	exportData = createExportData(nil)
+	exportData.ShardID = core.MetachainShardId
+	_, err = exporter.Export(&bytes.Buffer{}, exportData)
+	assert.Equal(t, snapshot.ErrNilPeerAccountsTrie, err)
+}
+
+func TestExporter_ExportAndImportShardState(t *testing.T) {
+	t.Parallel()
+
+	accountsTrie, rootHash := createAccountsWithDataTries(t, 5)
+	exportData := createExportData(rootHash)
+	buff, manifest := exportState(t, snapshot.ArgsExporter{Marshalizer: marshalizer, Hasher: hasher, AccountsTrie: accountsTrie}, exportData)
+	assert.Equal(t, hex.EncodeToString(rootHash), manifest.RootHash)
+	assert.Equal(t, uint32(2), manifest.Epoch)
+
+	importedTrie := createTrie()
+	importer, err := snapshot.NewImporter(snapshot.ArgsImporter{
+		Marshalizer:     marshalizer,
+		Hasher:          hasher,
+		AccountsStorage: importedTrie.GetStorageManager().Database(),
+	})
+	require.Nil(t, err)
+
+	result, err := importer.Import(buff, createExpectedShardState(exportData))
+	require.Nil(t, err)
+	assert.Equal(t, manifest, result.Manifest)
+	assert.Equal(t, exportData.NodesConfig, result.NodesConfig)
+	assert.Equal(t, uint64(0), result.NumPeerAccountsTrieNodes)
+
+	recreatedTrie, err := importedTrie.Recreate(rootHash)
+	require.Nil(t, err)
+	importedHashes, err := recreatedTrie.GetAllHashes()
+	require.Nil(t, err)
+	exportedTrie, _ := accountsTrie.Recreate(rootHash)
+	exportedHashes, _ := exportedTrie.GetAllHashes()
+	assert.Equal(t, len(exportedHashes), len(importedHashes))
+	assert.True(t, result.NumAccountsTrieNodes > uint64(len(importedHashes)))
+}
+
+func TestExporter_ExportAndImportMetaState(t *testing.T) {
+	t.Parallel()
+
+	accountsTrie, rootHash := createAccountsWithDataTries(t, 3)
+	peerAccountsTrie, validatorsRootHash := createAccountsWithDataTries(t, 2)
+	exportData := createExportData(nil)
+	exportData.ShardID = core.MetachainShardId
+	exportData.ShardHeader = nil
+	exportData.EpochStartMetaBlock.RootHash = rootHash
+	exportData.EpochStartMetaBlock.ValidatorStatsRootHash = validatorsRootHash
+
+	buff, manifest := exportState(t, snapshot.ArgsExporter{
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+		AccountsTrie:     accountsTrie,
+		PeerAccountsTrie: peerAccountsTrie,
+	}, exportData)
+	assert.Empty(t, manifest.ShardHeaderHash)
+	assert.Equal(t, hex.EncodeToString(validatorsRootHash), manifest.ValidatorStatsRootHash)
+
+	importedPeerAccountsTrie := createTrie()
+	importer, _ := snapshot.NewImporter(snapshot.ArgsImporter{
+		Marshalizer:         marshalizer,
+		Hasher:              hasher,
+		AccountsStorage:     createTrie().GetStorageManager().Database(),
+		PeerAccountsStorage: importedPeerAccountsTrie.GetStorageManager().Database(),
+	})
+	metaBlockHash, _ := core.CalculateHash(marshalizer, hasher, exportData.EpochStartMetaBlock)
+	result, err := importer.Import(buff, &snapshot.ExpectedState{
+		ShardID:                 core.MetachainShardId,
+		EpochStartMetaBlockHash: metaBlockHash,
+		RootHash:                rootHash,
+		ValidatorStatsRootHash:  validatorsRootHash,
+	})
+	require.Nil(t, err)
+	assert.True(t, result.NumPeerAccountsTrieNodes > 0)
+
+	recreatedTrie, err := importedPeerAccountsTrie.Recreate(validatorsRootHash)
+	require.Nil(t, err)
+	_, err = recreatedTrie.GetAllHashes()
+	assert.Nil(t, err)
+}
+
+func TestImporter_ImportMismatchingStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accountsTrie, rootHash := createAccountsWithDataTries(t, 3)
+	exportData := createExportData(rootHash)
+	args := snapshot.ArgsExporter{Marshalizer: marshalizer, Hasher: hasher, AccountsTrie: accountsTrie}
+	archive, _ := exportState(t, args, exportData)
+	archiveBytes := archive.Bytes()
+
+	importer, _ := snapshot.NewImporter(snapshot.ArgsImporter{
+		Marshalizer:     marshalizer,
+		Hasher:          hasher,
+		AccountsStorage: createTrie().GetStorageManager().Database(),
+	})
+
+	expected := createExpectedShardState(exportData)
+	expected.EpochStartMetaBlockHash = []byte("another metablock hash")
+	result, err := importer.Import(bytes.NewReader(archiveBytes), expected)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, snapshot.ErrHashMismatch))
+
+	expected = createExpectedShardState(exportData)
+	expected.RootHash = []byte("another root hash")
+	result, err = importer.Import(bytes.NewReader(archiveBytes), expected)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, snapshot.ErrRootHashMismatch))
+
+	expected = createExpectedShardState(exportData)
+	expected.ShardID = 1
+	result, err = importer.Import(bytes.NewReader(archiveBytes), expected)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, snapshot.ErrRootHashMismatch))
+}
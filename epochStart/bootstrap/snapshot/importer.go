@@ -0,0 +1,284 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsImporter holds the arguments needed for creating a new state snapshot importer
+type ArgsImporter struct {
+	Marshalizer         marshal.Marshalizer
+	Hasher              hashing.Hasher
+	AccountsStorage     data.DBWriteCacher
+	PeerAccountsStorage data.DBWriteCacher
+}
+
+// ExpectedState holds the already verified epoch start data that the imported archive has to match. The shard header
+// hash is empty when importing the metachain state
+type ExpectedState struct {
+	ShardID                 uint32
+	EpochStartMetaBlockHash []byte
+	ShardHeaderHash         []byte
+	RootHash                []byte
+	ValidatorStatsRootHash  []byte
+}
+
+// ImportResult holds the outcome of a successful state snapshot import
+type ImportResult struct {
+	Manifest                 *Manifest
+	NodesConfig              *sharding.NodesCoordinatorRegistry
+	NumAccountsTrieNodes     uint64
+	NumPeerAccountsTrieNodes uint64
+}
+
+type importer struct {
+	marshalizer         marshal.Marshalizer
+	hasher              hashing.Hasher
+	accountsStorage     data.DBWriteCacher
+	peerAccountsStorage data.DBWriteCacher
+}
+
+type importState struct {
+	expected          *ExpectedState
+	metaBlockVerified bool
+	shardHdrVerified  bool
+	result            *ImportResult
+}
+
+// NewImporter creates a component which writes the trie nodes of a state snapshot archive into the tries storage
+func NewImporter(args ArgsImporter) (*importer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AccountsStorage) {
+		return nil, ErrNilAccountsStorage
+	}
+
+	return &importer{
+		marshalizer:         args.Marshalizer,
+		hasher:              args.Hasher,
+		accountsStorage:     args.AccountsStorage,
+		peerAccountsStorage: args.PeerAccountsStorage,
+	}, nil
+}
+
+// Import reads the archive and writes its trie nodes into the tries storage. The archived headers have to match the
+// expected hashes and their root hashes the expected ones before any trie node is accepted. Each trie node is checked
+// against its hash, so the nodes written before a failure (e.g. a checksum mismatch at the end of the archive) are
+// valid nodes, just possibly unreferenced
+func (si *importer) Import(r io.Reader, expected *ExpectedState) (*ImportResult, error) {
+	if expected == nil {
+		return nil, ErrNilEpochStartMetaBlock
+	}
+
+	archive, err := NewArchiveReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = archive.Close()
+	}()
+
+	state := &importState{
+		expected: expected,
+		result:   &ImportResult{},
+	}
+	for {
+		recordType, key, value, errRead := archive.ReadRecord()
+		if errRead == io.EOF {
+			break
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		err = si.processRecord(state, recordType, key, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the %s record %s", err, recordType, hex.EncodeToString(key))
+		}
+	}
+
+	state.result.Manifest = archive.Manifest()
+	err = si.checkImportedState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.result, nil
+}
+
+func (si *importer) processRecord(state *importState, recordType RecordType, key []byte, value []byte) error {
+	switch recordType {
+	case EpochStartMetaBlockRecord:
+		return si.verifyMetaBlock(state, key, value)
+	case ShardHeaderRecord:
+		return si.verifyShardHeader(state, key, value)
+	case NodesConfigRecord:
+		nodesConfig := &sharding.NodesCoordinatorRegistry{}
+		err := json.Unmarshal(value, nodesConfig)
+		if err != nil {
+			return err
+		}
+
+		state.result.NodesConfig = nodesConfig
+		return nil
+	case AccountsTrieNodeRecord:
+		err := si.putTrieNode(state, si.accountsStorage, key, value)
+		if err != nil {
+			return err
+		}
+
+		state.result.NumAccountsTrieNodes++
+		return nil
+	case PeerAccountsTrieNodeRecord:
+		if check.IfNil(si.peerAccountsStorage) {
+			return ErrNilPeerAccountsStorage
+		}
+
+		err := si.putTrieNode(state, si.peerAccountsStorage, key, value)
+		if err != nil {
+			return err
+		}
+
+		state.result.NumPeerAccountsTrieNodes++
+		return nil
+	default:
+		return ErrUnknownRecordType
+	}
+}
+
+func (si *importer) verifyMetaBlock(state *importState, key []byte, value []byte) error {
+	err := si.checkHash(key, value, state.expected.EpochStartMetaBlockHash)
+	if err != nil {
+		return err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = si.marshalizer.Unmarshal(metaBlock, value)
+	if err != nil {
+		return err
+	}
+
+	if state.expected.ShardID == core.MetachainShardId {
+		if !bytes.Equal(metaBlock.RootHash, state.expected.RootHash) ||
+			!bytes.Equal(metaBlock.ValidatorStatsRootHash, state.expected.ValidatorStatsRootHash) {
+			return ErrRootHashMismatch
+		}
+	}
+
+	state.metaBlockVerified = true
+
+	return nil
+}
+
+func (si *importer) verifyShardHeader(state *importState, key []byte, value []byte) error {
+	err := si.checkHash(key, value, state.expected.ShardHeaderHash)
+	if err != nil {
+		return err
+	}
+
+	header := &block.Header{}
+	err = si.marshalizer.Unmarshal(header, value)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(header.RootHash, state.expected.RootHash) {
+		return ErrRootHashMismatch
+	}
+
+	state.shardHdrVerified = true
+
+	return nil
+}
+
+func (si *importer) checkHash(key []byte, value []byte, expectedHash []byte) error {
+	computedHash := si.hasher.Compute(string(value))
+	if !bytes.Equal(computedHash, key) || !bytes.Equal(computedHash, expectedHash) {
+		return ErrHashMismatch
+	}
+
+	return nil
+}
+
+func (si *importer) putTrieNode(state *importState, storage data.DBWriteCacher, key []byte, value []byte) error {
+	if !state.areHeadersVerified() {
+		return ErrUnexpectedRecordsOrder
+	}
+	if !bytes.Equal(si.hasher.Compute(string(value)), key) {
+		return ErrHashMismatch
+	}
+
+	return storage.Put(key, value)
+}
+
+func (state *importState) areHeadersVerified() bool {
+	if state.expected.ShardID == core.MetachainShardId {
+		return state.metaBlockVerified
+	}
+
+	return state.metaBlockVerified && state.shardHdrVerified
+}
+
+func (si *importer) checkImportedState(state *importState) error {
+	manifest := state.result.Manifest
+	if !state.areHeadersVerified() {
+		return fmt.Errorf("%w: the archive does not hold the epoch start headers", ErrHashMismatch)
+	}
+	if manifest.ShardID != state.expected.ShardID {
+		return fmt.Errorf("%w: the archive holds the state of shard %d", ErrRootHashMismatch, manifest.ShardID)
+	}
+	if manifest.RootHash != hex.EncodeToString(state.expected.RootHash) {
+		return fmt.Errorf("%w: the manifest declares the root hash %s", ErrRootHashMismatch, manifest.RootHash)
+	}
+
+	err := checkRootNode(si.accountsStorage, state.expected.RootHash)
+	if err != nil {
+		return err
+	}
+	if state.expected.ShardID != core.MetachainShardId {
+		return nil
+	}
+
+	if manifest.ValidatorStatsRootHash != hex.EncodeToString(state.expected.ValidatorStatsRootHash) {
+		return fmt.Errorf("%w: the manifest declares the validators root hash %s", ErrRootHashMismatch, manifest.ValidatorStatsRootHash)
+	}
+	if check.IfNil(si.peerAccountsStorage) {
+		return ErrNilPeerAccountsStorage
+	}
+
+	return checkRootNode(si.peerAccountsStorage, state.expected.ValidatorStatsRootHash)
+}
+
+func checkRootNode(storage data.DBWriteCacher, rootHash []byte) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, trie.EmptyTrieHash) {
+		return nil
+	}
+
+	_, err := storage.Get(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrMissingRootNode, hex.EncodeToString(rootHash))
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *importer) IsInterfaceNil() bool {
+	return si == nil
+}
@@ -0,0 +1,9 @@
+package snapshot
+
+// nodesIterator defines the methods of the trie iterator used when walking the exported tries
+type nodesIterator interface {
+	HasNext() bool
+	Next() error
+	GetHash() ([]byte, error)
+	MarshalizedNode() ([]byte, error)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	chainData "github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -20,7 +22,7 @@ type NodeHandler interface {
 	StartConsensus() error
 
 	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
 	GetUsername(address string) (string, error)
@@ -29,7 +31,13 @@ type NodeHandler interface {
 	GetValueForKey(address string, key string) (string, error)
 
 	// GetKeyValuePairs returns the key-value pairs under a given address
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error)
+
+	// GetAccountProof returns the Merkle proof of an account in the accounts trie
+	GetAccountProof(address string, options api.AccountQueryOptions) (*api.AccountProof, error)
+
+	// GetAccountKeyProof returns the Merkle proofs of an account and of a key in the data trie of the account
+	GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
 
 	// GetESDTBalance returns the esdt balance and properties from a given account
 	GetESDTBalance(address string, key string) (string, string, error)
@@ -53,10 +61,10 @@ type NodeHandler interface {
 
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
 
-	// GetCode returns the code for the given account
-	GetCode(account state.UserAccountHandler) []byte
+	// GetAccountWithCode returns the account correlated with provided address, along with its code
+	GetAccountWithCode(address string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error)
 
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
@@ -77,6 +85,21 @@ type NodeHandler interface {
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
+	GetLatestBlock(withTxs bool) (*api.Block, error)
+
+	GetTransactionsPoolForSender(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolForCache(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolSenderDiagnostics(sender string) (*api.SenderDiagnostics, error)
+	GetTransactionsPoolSendersDiagnostics(page uint32, pageSize uint32) (*api.SendersDiagnostics, error)
+
+	GetEvents(query api.EventsQuery) (*api.Events, error)
+	GetTransactionsForAddress(query api.AddressTransactionsQuery) (*api.AddressTransactions, error)
+	GetTrieStatistics(numTopDataTries uint32) (*api.TrieStatistics, error)
+	RegisterHeadersHandlers(
+		newHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+		finalizedHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+	) error
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -91,6 +114,8 @@ type ApiResolver interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue() (*big.Int, error)
+	GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error)
+	GetESDTHolders(tokenIdentifier string) (uint64, error)
 	IsInterfaceNil() bool
 }
 
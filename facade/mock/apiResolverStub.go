@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -15,6 +16,8 @@ type ApiResolverStub struct {
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	GetTotalStakedValueHandler        func() (*big.Int, error)
+	GetESDTSupplyHandler              func(tokenIdentifier string) (*esdt.ESDTSupply, error)
+	GetESDTHoldersHandler             func(tokenIdentifier string) (uint64, error)
 }
 
 // ExecuteSCQuery -
@@ -37,6 +40,16 @@ func (ars *ApiResolverStub) GetTotalStakedValue() (*big.Int, error) {
 	return ars.GetTotalStakedValueHandler()
 }
 
+// GetESDTSupply -
+func (ars *ApiResolverStub) GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+	return ars.GetESDTSupplyHandler(tokenIdentifier)
+}
+
+// GetESDTHolders -
+func (ars *ApiResolverStub) GetESDTHolders(tokenIdentifier string) (uint64, error) {
+	return ars.GetESDTHoldersHandler(tokenIdentifier)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	chainData "github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -17,7 +18,7 @@ type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
 	StartConsensusHandler      func() error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options api.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
@@ -25,8 +26,8 @@ type NodeStub struct {
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
-	GetCodeCalled                                  func(state.UserAccountHandler) []byte
+	GetAccountHandler                              func(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetAccountWithCodeCalled                       func(address string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
@@ -39,10 +40,22 @@ type NodeStub struct {
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRangeCalled                    func(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
+	GetLatestBlockCalled                           func(withTxs bool) (*api.Block, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
-	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
+	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions) (map[string]string, error)
+	GetAccountProofCalled                          func(address string, options api.AccountQueryOptions) (*api.AccountProof, error)
+	GetAccountKeyProofCalled                       func(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error)
+	GetTransactionsPoolForSenderCalled             func(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolForCacheCalled              func(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error)
+	GetTransactionsPoolSenderDiagnosticsCalled     func(sender string) (*api.SenderDiagnostics, error)
+	GetTransactionsPoolSendersDiagnosticsCalled    func(page uint32, pageSize uint32) (*api.SendersDiagnostics, error)
+	GetEventsCalled                                func(query api.EventsQuery) (*api.Events, error)
+	GetTrieStatisticsCalled                        func(numTopDataTries uint32) (*api.TrieStatistics, error)
+	GetTransactionsForAddressCalled                func(query api.AddressTransactionsQuery) (*api.AddressTransactions, error)
+	RegisterHeadersHandlersCalled                  func(newHeadersHandler, finalizedHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte)) error
 }
 
 // GetUsername -
@@ -55,9 +68,27 @@ func (ns *NodeStub) GetUsername(address string) (string, error) {
 }
 
 // GetKeyValuesPairs -
-func (ns *NodeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (ns *NodeStub) GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error) {
 	if ns.GetKeyValuePairsCalled != nil {
-		return ns.GetKeyValuePairsCalled(address)
+		return ns.GetKeyValuePairsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetAccountProof -
+func (ns *NodeStub) GetAccountProof(address string, options api.AccountQueryOptions) (*api.AccountProof, error) {
+	if ns.GetAccountProofCalled != nil {
+		return ns.GetAccountProofCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetAccountKeyProof -
+func (ns *NodeStub) GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+	if ns.GetAccountKeyProofCalled != nil {
+		return ns.GetAccountKeyProofCalled(address, key, options)
 	}
 
 	return nil, nil
@@ -87,6 +118,99 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, err
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetBlocksByNonceRange -
+func (ns *NodeStub) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	if ns.GetBlocksByNonceRangeCalled != nil {
+		return ns.GetBlocksByNonceRangeCalled(from, to, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetLatestBlock -
+func (ns *NodeStub) GetLatestBlock(withTxs bool) (*api.Block, error) {
+	if ns.GetLatestBlockCalled != nil {
+		return ns.GetLatestBlockCalled(withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForSender -
+func (ns *NodeStub) GetTransactionsPoolForSender(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+	if ns.GetTransactionsPoolForSenderCalled != nil {
+		return ns.GetTransactionsPoolForSenderCalled(sender, page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolForCache -
+func (ns *NodeStub) GetTransactionsPoolForCache(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+	if ns.GetTransactionsPoolForCacheCalled != nil {
+		return ns.GetTransactionsPoolForCacheCalled(cacheID, page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSenderDiagnostics -
+func (ns *NodeStub) GetTransactionsPoolSenderDiagnostics(sender string) (*api.SenderDiagnostics, error) {
+	if ns.GetTransactionsPoolSenderDiagnosticsCalled != nil {
+		return ns.GetTransactionsPoolSenderDiagnosticsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSendersDiagnostics -
+func (ns *NodeStub) GetTransactionsPoolSendersDiagnostics(page uint32, pageSize uint32) (*api.SendersDiagnostics, error) {
+	if ns.GetTransactionsPoolSendersDiagnosticsCalled != nil {
+		return ns.GetTransactionsPoolSendersDiagnosticsCalled(page, pageSize)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (ns *NodeStub) GetEvents(query api.EventsQuery) (*api.Events, error) {
+	if ns.GetEventsCalled != nil {
+		return ns.GetEventsCalled(query)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (ns *NodeStub) GetTransactionsForAddress(query api.AddressTransactionsQuery) (*api.AddressTransactions, error) {
+	if ns.GetTransactionsForAddressCalled != nil {
+		return ns.GetTransactionsForAddressCalled(query)
+	}
+
+	return nil, nil
+}
+
+// GetTrieStatistics -
+func (ns *NodeStub) GetTrieStatistics(numTopDataTries uint32) (*api.TrieStatistics, error) {
+	if ns.GetTrieStatisticsCalled != nil {
+		return ns.GetTrieStatisticsCalled(numTopDataTries)
+	}
+
+	return nil, nil
+}
+
+// RegisterHeadersHandlers -
+func (ns *NodeStub) RegisterHeadersHandlers(
+	newHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+	finalizedHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+) error {
+	if ns.RegisterHeadersHandlersCalled != nil {
+		return ns.RegisterHeadersHandlersCalled(newHeadersHandler, finalizedHeadersHandler)
+	}
+
+	return nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -98,8 +222,8 @@ func (ns *NodeStub) StartConsensus() error {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -130,17 +254,17 @@ func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
-// GetCode -
-func (ns *NodeStub) GetCode(account state.UserAccountHandler) []byte {
-	if ns.GetCodeCalled != nil {
-		return ns.GetCodeCalled(account)
+// GetAccountWithCode -
+func (ns *NodeStub) GetAccountWithCode(address string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+	if ns.GetAccountWithCodeCalled != nil {
+		return ns.GetAccountWithCodeCalled(address, options)
 	}
 
-	return nil
+	return nil, nil, nil
 }
 
 // GetHeartbeats -
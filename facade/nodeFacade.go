@@ -22,7 +22,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	chainData "github.com/ElrondNetwork/elrond-go/data"
 	apiData "github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
@@ -255,9 +257,9 @@ func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
 	}
 }
 
-// GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+// GetBalance gets the current balance for a specified address, or the one at an older block if requested
+func (nf *nodeFacade) GetBalance(address string, options apiData.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
@@ -276,8 +278,18 @@ func (nf *nodeFacade) GetESDTBalance(address string, key string) (string, string
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the provided address
-func (nf *nodeFacade) GetKeyValuePairs(address string) (map[string]string, error) {
-	return nf.node.GetKeyValuePairs(address)
+func (nf *nodeFacade) GetKeyValuePairs(address string, options apiData.AccountQueryOptions) (map[string]string, error) {
+	return nf.node.GetKeyValuePairs(address, options)
+}
+
+// GetAccountProof returns the Merkle proof of the provided address in the accounts trie
+func (nf *nodeFacade) GetAccountProof(address string, options apiData.AccountQueryOptions) (*apiData.AccountProof, error) {
+	return nf.node.GetAccountProof(address, options)
+}
+
+// GetAccountKeyProof returns the Merkle proofs of the provided address and of the provided key in its data trie
+func (nf *nodeFacade) GetAccountKeyProof(address string, key string, options apiData.AccountQueryOptions) (*apiData.AccountKeyProof, error) {
+	return nf.node.GetAccountKeyProof(address, key, options)
 }
 
 // GetAllESDTTokens returns all the esdt tokens for a given address
@@ -342,13 +354,13 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*
 
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
 }
 
-// GetCode returns the code for the given account
-func (nf *nodeFacade) GetCode(account state.UserAccountHandler) []byte {
-	return nf.node.GetCode(account)
+// GetAccountWithCode returns the account correlated with provided address, along with its code
+func (nf *nodeFacade) GetAccountWithCode(address string, options apiData.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+	return nf.node.GetAccountWithCode(address, options)
 }
 
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
@@ -371,6 +383,16 @@ func (nf *nodeFacade) GetTotalStakedValue() (*big.Int, error) {
 	return nf.apiResolver.GetTotalStakedValue()
 }
 
+// GetESDTSupply will return the minted, burnt and wiped values of an esdt token, together with its supply
+func (nf *nodeFacade) GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+	return nf.apiResolver.GetESDTSupply(tokenIdentifier)
+}
+
+// GetESDTHolders will return the number of accounts holding an esdt token
+func (nf *nodeFacade) GetESDTHolders(tokenIdentifier string) (uint64, error) {
+	return nf.apiResolver.GetESDTHolders(tokenIdentifier)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -434,6 +456,59 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetBlocksByNonceRange returns the blocks having the nonces in the provided range
+func (nf *nodeFacade) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*apiData.Block, error) {
+	return nf.node.GetBlocksByNonceRange(from, to, withTxs)
+}
+
+// GetLatestBlock returns the current block of the node
+func (nf *nodeFacade) GetLatestBlock(withTxs bool) (*apiData.Block, error) {
+	return nf.node.GetLatestBlock(withTxs)
+}
+
+// GetTransactionsPoolForSender returns a page of the pending transactions of a given sender
+func (nf *nodeFacade) GetTransactionsPoolForSender(sender string, page uint32, pageSize uint32) (*apiData.TransactionsPool, error) {
+	return nf.node.GetTransactionsPoolForSender(sender, page, pageSize)
+}
+
+// GetTransactionsPoolForCache returns a page of the pending transactions held in a given cache of the pool
+func (nf *nodeFacade) GetTransactionsPoolForCache(cacheID string, page uint32, pageSize uint32) (*apiData.TransactionsPool, error) {
+	return nf.node.GetTransactionsPoolForCache(cacheID, page, pageSize)
+}
+
+// GetTransactionsPoolSenderDiagnostics returns the selection diagnostics of a given sender
+func (nf *nodeFacade) GetTransactionsPoolSenderDiagnostics(sender string) (*apiData.SenderDiagnostics, error) {
+	return nf.node.GetTransactionsPoolSenderDiagnostics(sender)
+}
+
+// GetTransactionsPoolSendersDiagnostics returns a page of selection diagnostics for the senders in the pool
+func (nf *nodeFacade) GetTransactionsPoolSendersDiagnostics(page uint32, pageSize uint32) (*apiData.SendersDiagnostics, error) {
+	return nf.node.GetTransactionsPoolSendersDiagnostics(page, pageSize)
+}
+
+// GetEvents returns a page of the events emitted by a given address, having a given identifier
+func (nf *nodeFacade) GetEvents(query apiData.EventsQuery) (*apiData.Events, error) {
+	return nf.node.GetEvents(query)
+}
+
+// GetTransactionsForAddress returns a page of the transactions history of a given address
+func (nf *nodeFacade) GetTransactionsForAddress(query apiData.AddressTransactionsQuery) (*apiData.AddressTransactions, error) {
+	return nf.node.GetTransactionsForAddress(query)
+}
+
+// GetTrieStatistics returns the statistics of the accounts trie and of the accounts data tries at the current block
+func (nf *nodeFacade) GetTrieStatistics(numTopDataTries uint32) (*apiData.TrieStatistics, error) {
+	return nf.node.GetTrieStatistics(numTopDataTries)
+}
+
+// RegisterHeadersHandlers registers the handlers of the new and of the finalized block headers
+func (nf *nodeFacade) RegisterHeadersHandlers(
+	newHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+	finalizedHeadersHandler func(shardID uint32, headers []chainData.HeaderHandler, headersHashes [][]byte),
+) error {
+	return nf.node.RegisterHeadersHandlers(newHeadersHandler, finalizedHeadersHandler)
+}
+
 // Close will cleanup started go routines
 // TODO use this close method
 func (nf *nodeFacade) Close() error {
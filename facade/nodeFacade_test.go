@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	apiData "github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -140,7 +141,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ apiData.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -152,7 +153,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, apiData.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -167,7 +168,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ apiData.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -179,7 +180,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, apiData.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -191,7 +192,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ apiData.AccountQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -200,11 +201,31 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, apiData.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
 
+func TestNodeFacade_GetBalanceShouldForwardQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	expectedOptions := apiData.AccountQueryOptions{BlockNonce: 37, HasBlockNonce: true}
+	node := &mock.NodeStub{
+		GetBalanceHandler: func(address string, options apiData.AccountQueryOptions) (*big.Int, error) {
+			assert.Equal(t, expectedOptions, options)
+			return big.NewInt(10), nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	amount, err := nf.GetBalance("testAddress", expectedOptions)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), amount)
+}
+
 func TestNodeFacade_GetTransactionWithValidInputsShouldNotReturnError(t *testing.T) {
 	t.Parallel()
 
@@ -279,7 +300,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	called := 0
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (state.UserAccountHandler, error) {
+	node.GetAccountHandler = func(address string, _ apiData.AccountQueryOptions) (state.UserAccountHandler, error) {
 		called++
 		return nil, nil
 	}
@@ -288,10 +309,28 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", apiData.AccountQueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
+func TestNodeFacade_GetAccountWithCode(t *testing.T) {
+	t.Parallel()
+
+	expectedCode := []byte("code")
+	node := &mock.NodeStub{}
+	node.GetAccountWithCodeCalled = func(address string, _ apiData.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+		return nil, expectedCode, nil
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	_, code, err := nf.GetAccountWithCode("test", apiData.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedCode, code)
+}
+
 func TestNodeFacade_GetUsername(t *testing.T) {
 	t.Parallel()
 
@@ -661,14 +700,14 @@ func TestNodeFacade_GetKeyValuePairs(t *testing.T) {
 	expectedPairs := map[string]string{"k": "v"}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetKeyValuePairsCalled: func(address string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(address string, _ apiData.AccountQueryOptions) (map[string]string, error) {
 			return expectedPairs, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetKeyValuePairs("addr")
+	res, err := nf.GetKeyValuePairs("addr", apiData.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPairs, res)
 }
@@ -690,3 +729,88 @@ func TestNodeFacade_ValidateTransactionForSimulation(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, called)
 }
+
+func TestNodeFacade_GetTransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	expectedPool := &apiData.TransactionsPool{CacheID: "0", Sender: "alice", NumTxs: 1}
+	node := &mock.NodeStub{
+		GetTransactionsPoolForSenderCalled: func(sender string, page uint32, pageSize uint32) (*apiData.TransactionsPool, error) {
+			assert.Equal(t, "alice", sender)
+			assert.Equal(t, uint32(1), page)
+			assert.Equal(t, uint32(10), pageSize)
+			return expectedPool, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	txsPool, err := nf.GetTransactionsPoolForSender("alice", 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPool, txsPool)
+}
+
+func TestNodeFacade_GetTransactionsPoolForCache(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	node := &mock.NodeStub{
+		GetTransactionsPoolForCacheCalled: func(cacheID string, page uint32, pageSize uint32) (*apiData.TransactionsPool, error) {
+			assert.Equal(t, "1_0", cacheID)
+			assert.Equal(t, uint32(2), page)
+			assert.Equal(t, uint32(20), pageSize)
+			return nil, expectedErr
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	txsPool, err := nf.GetTransactionsPoolForCache("1_0", 2, 20)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, txsPool)
+}
+
+func TestNodeFacade_GetTransactionsPoolSenderDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	expectedDiagnostics := &apiData.SenderDiagnostics{Sender: "alice", NonceGap: 2}
+	node := &mock.NodeStub{
+		GetTransactionsPoolSenderDiagnosticsCalled: func(sender string) (*apiData.SenderDiagnostics, error) {
+			assert.Equal(t, "alice", sender)
+			return expectedDiagnostics, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	diagnostics, err := nf.GetTransactionsPoolSenderDiagnostics("alice")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDiagnostics, diagnostics)
+}
+
+func TestNodeFacade_GetTransactionsPoolSendersDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	expectedDiagnostics := &apiData.SendersDiagnostics{CacheID: "0", NumSenders: 3}
+	node := &mock.NodeStub{
+		GetTransactionsPoolSendersDiagnosticsCalled: func(page uint32, pageSize uint32) (*apiData.SendersDiagnostics, error) {
+			assert.Equal(t, uint32(1), page)
+			assert.Equal(t, uint32(10), pageSize)
+			return expectedDiagnostics, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	diagnostics, err := nf.GetTransactionsPoolSendersDiagnostics(1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedDiagnostics, diagnostics)
+}
@@ -260,17 +260,20 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		return nil, err
 	}
 	argsNewVMContainerFactory := metachain.ArgsNewVMContainerFactory{
-		ArgBlockChainHook:   argsHook,
-		Economics:           arg.Economics,
-		MessageSignVerifier: pubKeyVerifier,
-		GasSchedule:         arg.GasSchedule,
-		NodesConfigProvider: arg.InitialNodesSetup,
-		Hasher:              arg.Hasher,
-		Marshalizer:         arg.Marshalizer,
-		SystemSCConfig:      &arg.SystemSCConfig,
-		ValidatorAccountsDB: arg.ValidatorAccounts,
-		ChanceComputer:      &disabled.Rater{},
-		EpochNotifier:       epochNotifier,
+		ArgBlockChainHook:             argsHook,
+		Economics:                     arg.Economics,
+		MessageSignVerifier:           pubKeyVerifier,
+		GasSchedule:                   arg.GasSchedule,
+		NodesConfigProvider:           arg.InitialNodesSetup,
+		Hasher:                        arg.Hasher,
+		Marshalizer:                   arg.Marshalizer,
+		SystemSCConfig:                &arg.SystemSCConfig,
+		ValidatorAccountsDB:           arg.ValidatorAccounts,
+		ChanceComputer:                &disabled.Rater{},
+		EpochNotifier:                 epochNotifier,
+		ESDTNFTEnableEpoch:            generalConfig.ESDTNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   generalConfig.ESDTSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: generalConfig.ESDTSupplyTrackingEnableEpoch,
 	}
 	virtualMachineFactory, err := metachain.NewVMContainerFactory(argsNewVMContainerFactory)
 	if err != nil {
@@ -312,7 +315,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  arg.PubkeyConv,
 		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -350,8 +353,9 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		BuiltinEnableEpoch:             generalConfig.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 		RepairCallbackEnableEpoch:      generalConfig.RepairCallbackEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch:  generalConfig.ESDTSupplyTrackingEnableEpoch,
 		IsGenesisProcessing:            true,
-		StakingV2EnableEpoch: arg.SystemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
+		StakingV2EnableEpoch:           arg.SystemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
 	if err != nil {
@@ -45,6 +45,7 @@ func createGenesisConfig() config.GeneralSettingsConfig {
 		BuiltInFunctionsEnableEpoch:            0,
 		SCDeployEnableEpoch:                    unreachableEpoch,
 		RelayedTransactionsEnableEpoch:         0,
+		RelayedTransactionsV2EnableEpoch:       0,
 		PenalizedTooMuchGasEnableEpoch:         0,
 		AheadOfTimeGasUsageEnableEpoch:         unreachableEpoch,
 		BelowSignedThresholdEnableEpoch:        unreachableEpoch,
@@ -54,6 +55,10 @@ func createGenesisConfig() config.GeneralSettingsConfig {
 		SwitchHysteresisForMinNodesEnableEpoch: unreachableEpoch,
 		SwitchJailWaitingEnableEpoch:           unreachableEpoch,
 		BlockGasAndFeesReCheckEnableEpoch:      unreachableEpoch,
+		ESDTNFTEnableEpoch:                     0,
+		ESDTSpecialRolesEnableEpoch:            0,
+		ESDTSupplyTrackingEnableEpoch:          0,
+		ESDTMultiTransferEnableEpoch:           0,
 	}
 }
 
@@ -254,12 +259,21 @@ func setBalanceToTrie(arg ArgsGenesisBlockCreator, accnt genesis.InitialAccountH
 }
 
 func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalConfig config.GeneralSettingsConfig) (*genesisProcessors, error) {
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(arg.StartEpochNum)
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:          arg.GasSchedule,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          arg.Marshalizer,
-		Accounts:             arg.Accounts,
+		GasSchedule:                   arg.GasSchedule,
+		MapDNSAddresses:               make(map[string]struct{}),
+		EnableUserNameChange:          false,
+		Marshalizer:                   arg.Marshalizer,
+		Accounts:                      arg.Accounts,
+		ShardCoordinator:              arg.ShardCoordinator,
+		EpochNotifier:                 epochNotifier,
+		ESDTNFTEnableEpoch:            generalConfig.ESDTNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   generalConfig.ESDTSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: generalConfig.ESDTSupplyTrackingEnableEpoch,
+		ESDTMultiTransferEnableEpoch:  generalConfig.ESDTMultiTransferEnableEpoch,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	if err != nil {
@@ -342,7 +356,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  arg.PubkeyConv,
 		ShardCoordinator: arg.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -350,9 +364,6 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		return nil, err
 	}
 
-	epochNotifier := forking.NewGenericEpochNotifier()
-	epochNotifier.CheckEpoch(arg.StartEpochNum)
-
 	gasHandler, err := preprocess.NewGasComputation(arg.Economics, txTypeHandler, epochNotifier, generalConfig.SCDeployEnableEpoch)
 	if err != nil {
 		return nil, err
@@ -382,6 +393,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		DeployEnableEpoch:              generalConfig.SCDeployEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 		RepairCallbackEnableEpoch:      generalConfig.RepairCallbackEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch:  generalConfig.ESDTSupplyTrackingEnableEpoch,
 		IsGenesisProcessing:            true,
 		StakingV2EnableEpoch:           arg.SystemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
 	}
@@ -416,6 +428,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, generalCo
 		ScrForwarder:                   scForwarder,
 		EpochNotifier:                  epochNotifier,
 		RelayedTxEnableEpoch:           generalConfig.RelayedTransactionsEnableEpoch,
+		RelayedTxV2EnableEpoch:         generalConfig.RelayedTransactionsV2EnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      generalConfig.MetaProtectionEnableEpoch,
 	}
@@ -53,11 +53,11 @@ type NetworkShardingUpdater interface {
 
 // Facade is the node facade used to decouple the node implementation with the web server. Used in integration tests
 type Facade interface {
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options dataApi.AccountQueryOptions) (*big.Int, error)
 	GetUsername(address string) (string, error)
 	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
-	GetCode(account state.UserAccountHandler) []byte
+	GetAccount(address string, options dataApi.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetAccountWithCode(address string, options dataApi.AccountQueryOptions) (state.UserAccountHandler, []byte, error)
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
@@ -11,6 +11,10 @@ type BlockChainHookHandlerMock struct {
 	NewAddressCalled         func(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
 	IsPayableCalled          func(address []byte) (bool, error)
 	DeleteCompiledCodeCalled func(codeHash []byte)
+	GetHeaderByNonceCalled   func(nonce uint64) (data.HeaderHandler, error)
+	GetHeaderByHashCalled    func(hash []byte) (data.HeaderHandler, error)
+	UseStateAtRootHashCalled func(rootHash []byte) error
+	UseCurrentStateCalled    func()
 }
 
 // IsPayable -
@@ -49,6 +53,40 @@ func (e *BlockChainHookHandlerMock) DeleteCompiledCode(codeHash []byte) {
 	}
 }
 
+// GetHeaderByNonce -
+func (e *BlockChainHookHandlerMock) GetHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	if e.GetHeaderByNonceCalled != nil {
+		return e.GetHeaderByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHeaderByHash -
+func (e *BlockChainHookHandlerMock) GetHeaderByHash(hash []byte) (data.HeaderHandler, error) {
+	if e.GetHeaderByHashCalled != nil {
+		return e.GetHeaderByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// UseStateAtRootHash -
+func (e *BlockChainHookHandlerMock) UseStateAtRootHash(rootHash []byte) error {
+	if e.UseStateAtRootHashCalled != nil {
+		return e.UseStateAtRootHashCalled(rootHash)
+	}
+
+	return nil
+}
+
+// UseCurrentState -
+func (e *BlockChainHookHandlerMock) UseCurrentState() {
+	if e.UseCurrentStateCalled != nil {
+		e.UseCurrentStateCalled()
+	}
+}
+
 // IsInterfaceNil -
 func (e *BlockChainHookHandlerMock) IsInterfaceNil() bool {
 	return e == nil
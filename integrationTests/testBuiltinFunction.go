@@ -20,6 +20,11 @@ func (bf *TestBuiltinFunction) ProcessBuiltinFunction(acntSnd, acntDst state.Use
 func (bf *TestBuiltinFunction) SetNewGasConfig(_ *process.GasCost) {
 }
 
+// IsActive -
+func (bf *TestBuiltinFunction) IsActive() bool {
+	return true
+}
+
 // IsInterfaceNil --
 func (bf *TestBuiltinFunction) IsInterfaceNil() bool {
 	return bf == nil
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	nodeFacade "github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/node/esdtSupplyAPI"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
@@ -93,7 +94,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo"},
 		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier"},
 		"hardfork":    {"/trigger"},
-		"network":     {"/status", "/total-staked", "/economics", "/config"},
+		"network":     {"/status", "/total-staked", "/economics", "/config", "/esdt/:token/supply", "/esdt/:token/holders"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
@@ -126,10 +127,12 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	defaults.FillGasMapInternal(gasMap, 1)
 	gasScheduleNotifier := mock.NewGasScheduleNotifierMock(gasMap)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:     gasScheduleNotifier,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasSchedule:      gasScheduleNotifier,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      TestMarshalizer,
+		Accounts:         tpn.AccntState,
+		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
 	log.LogIfError(err)
@@ -140,13 +143,19 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  TestAddressPubkeyConverter,
 		ShardCoordinator: tpn.ShardCoordinator,
-		BuiltInFuncNames: builtInFuncs.Keys(),
+		BuiltInFunctions: builtInFuncs,
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, err := coordinator.NewTxTypeHandler(argsTxTypeHandler)
 	log.LogIfError(err)
 
-	txCostHandler, err := transaction.NewTransactionCostEstimator(txTypeHandler, tpn.EconomicsData, tpn.SCQueryService, gasScheduleNotifier)
+	txCostHandler, err := transaction.NewTransactionCostEstimator(
+		txTypeHandler,
+		tpn.EconomicsData,
+		tpn.SCQueryService,
+		parsers.NewCallArgsParser(),
+		gasScheduleNotifier,
+	)
 	log.LogIfError(err)
 
 	args := &totalStakedAPI.ArgsTotalStakedValueHandler{
@@ -158,7 +167,16 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	totalStakedValueHandler, err := totalStakedAPI.CreateTotalStakedValueHandler(args)
 	log.LogIfError(err)
 
-	apiResolver, err := external.NewNodeApiResolver(tpn.SCQueryService, &mock.StatusMetricsStub{}, txCostHandler, totalStakedValueHandler)
+	argsESDTSupply := &esdtSupplyAPI.ArgsESDTSupplyHandler{
+		ShardID:             tpn.ShardCoordinator.SelfId(),
+		InternalMarshalizer: TestMarshalizer,
+		Accounts:            tpn.AccntState,
+		BlockChain:          tpn.BlockChain,
+	}
+	esdtSupplyHandler, err := esdtSupplyAPI.CreateESDTSupplyHandler(argsESDTSupply)
+	log.LogIfError(err)
+
+	apiResolver, err := external.NewNodeApiResolver(tpn.SCQueryService, &mock.StatusMetricsStub{}, txCostHandler, totalStakedValueHandler, esdtSupplyHandler)
 	log.LogIfError(err)
 
 	argSimulator := txsimulator.ArgsTxSimulator{
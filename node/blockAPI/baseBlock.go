@@ -6,13 +6,16 @@ import (
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // BlockStatus is the status of a block
@@ -32,6 +35,7 @@ type baseAPIBockProcessor struct {
 	marshalizer              marshal.Marshalizer
 	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	historyRepo              dblookupext.HistoryRepository
+	nodesCoordinator         sharding.NodesCoordinator
 	// TODO: use an interface instead of this function
 	unmarshalTx              func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 }
@@ -163,3 +167,116 @@ func (bap *baseAPIBockProcessor) computeStatusAndPutInBlock(blockAPI *api.Block,
 
 	return blockAPI, nil
 }
+
+// computeConsensusGroup returns the public keys of the consensus group that produced the block, in the order used by
+// the public keys bitmap (the first one is the leader). The start of epoch blocks are produced by the consensus group
+// of the previous epoch. The nodes coordinator only keeps the recent epochs, thus the consensus group of an older
+// block cannot be computed.
+func (bap *baseAPIBockProcessor) computeConsensusGroup(header data.HeaderHandler) []string {
+	if check.IfNil(bap.nodesCoordinator) || len(header.GetPubKeysBitmap()) == 0 {
+		return nil
+	}
+
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	validators, err := bap.nodesCoordinator.ComputeConsensusGroup(header.GetPrevRandSeed(), header.GetRound(), header.GetShardID(), epoch)
+	if err != nil {
+		log.Debug("cannot compute the consensus group",
+			"shard", header.GetShardID(),
+			"nonce", header.GetNonce(),
+			"error", err.Error())
+		return nil
+	}
+
+	consensusGroup := make([]string, 0, len(validators))
+	for _, validator := range validators {
+		consensusGroup = append(consensusGroup, hex.EncodeToString(validator.PubKey()))
+	}
+
+	return consensusGroup
+}
+
+// getBlocksByNonceRange returns the blocks having the nonces in the provided range, in increasing order of nonces. The
+// nonce to hash mappings and the headers are read in bulk instead of one by one.
+func (bap *baseAPIBockProcessor) getBlocksByNonceRange(
+	nonceUnit dataRetriever.UnitType,
+	headerUnit dataRetriever.UnitType,
+	from uint64,
+	to uint64,
+	withTxs bool,
+	convertBlockBytes func(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error),
+) ([]*api.Block, error) {
+	nonceKeys := make([][]byte, 0, to-from+1)
+	for nonce := from; nonce <= to; nonce++ {
+		nonceKeys = append(nonceKeys, bap.uint64ByteSliceConverter.ToByteSlice(nonce))
+	}
+
+	hashesByNonce, err := bap.store.GetAll(nonceUnit, nonceKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	headersHashes := make([][]byte, 0, len(nonceKeys))
+	for _, nonceKey := range nonceKeys {
+		headersHashes = append(headersHashes, hashesByNonce[string(nonceKey)])
+	}
+
+	headersBytes, err := bap.getBulkFromStorer(headerUnit, headersHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*api.Block, 0, len(headersHashes))
+	for _, headerHash := range headersHashes {
+		// the bulk read from an epoch skips the missing keys
+		blockBytes, ok := headersBytes[string(headerHash)]
+		if !ok {
+			return nil, fmt.Errorf("%w for hash %s", ErrHeaderNotFound, hex.EncodeToString(headerHash))
+		}
+
+		blockAPI, errConvert := convertBlockBytes(headerHash, blockBytes, withTxs)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		blocks = append(blocks, blockAPI)
+	}
+
+	return blocks, nil
+}
+
+// getBulkFromStorer reads the provided keys from the storer. When the DbLookupExtensions are enabled, the keys are
+// grouped by their epoch so that each persister is read only once.
+func (bap *baseAPIBockProcessor) getBulkFromStorer(unit dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error) {
+	if !bap.hasDbLookupExtensions {
+		return bap.store.GetAll(unit, keys)
+	}
+
+	keysByEpoch := make(map[uint32][][]byte)
+	for _, key := range keys {
+		epoch, err := bap.historyRepo.GetEpochByHash(key)
+		if err != nil {
+			return nil, err
+		}
+
+		keysByEpoch[epoch] = append(keysByEpoch[epoch], key)
+	}
+
+	storer := bap.store.GetStorer(unit)
+	values := make(map[string][]byte, len(keys))
+	for epoch, epochKeys := range keysByEpoch {
+		epochValues, err := storer.GetBulkFromEpoch(epochKeys, epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range epochValues {
+			values[key] = value
+		}
+	}
+
+	return values, nil
+}
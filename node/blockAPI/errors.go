@@ -0,0 +1,6 @@
+package blockAPI
+
+import "errors"
+
+// ErrHeaderNotFound signals that a block header could not be found in the storage
+var ErrHeaderNotFound = errors.New("header not found")
@@ -8,4 +8,5 @@ import (
 type APIBlockHandler interface {
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByHash(hash []byte, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
 }
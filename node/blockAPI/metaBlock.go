@@ -2,6 +2,7 @@ package blockAPI
 
 import (
 	"encoding/hex"
+	"math/big"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -25,6 +26,7 @@ func NewMetaApiBlockProcessor(arg *APIBlockProcessorArg) *metaAPIBlockProcessor
 			marshalizer:              arg.Marshalizer,
 			uint64ByteSliceConverter: arg.Uint64ByteSliceConverter,
 			historyRepo:              arg.HistoryRepo,
+			nodesCoordinator:         arg.NodesCoordinator,
 			unmarshalTx:              arg.UnmarshalTx,
 		},
 	}
@@ -63,6 +65,11 @@ func (mbp *metaAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*ap
 	return mbp.computeStatusAndPutInBlock(blockAPI, dataRetriever.MetaHdrNonceHashDataUnit)
 }
 
+// GetBlocksByNonceRange will return the meta APIBlocks having the nonces in the provided range
+func (mbp *metaAPIBlockProcessor) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	return mbp.getBlocksByNonceRange(dataRetriever.MetaHdrNonceHashDataUnit, dataRetriever.MetaBlockUnit, from, to, withTxs, mbp.convertMetaBlockBytesToAPIBlock)
+}
+
 func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error) {
 	blockHeader := &block.MetaBlock{}
 	err := mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
@@ -122,5 +129,70 @@ func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, b
 		DeveloperFeesInEpoch:   blockHeader.DevFeesInEpoch.String(),
 		Timestamp:              time.Duration(blockHeader.GetTimeStamp()),
 		Status:                 BlockStatusOnChain,
+		StateRootHash:          hex.EncodeToString(blockHeader.RootHash),
+		ValidatorStatsRootHash: hex.EncodeToString(blockHeader.ValidatorStatsRootHash),
+		ReceiptsHash:           hex.EncodeToString(blockHeader.ReceiptsHash),
+		PrevRandSeed:           hex.EncodeToString(blockHeader.PrevRandSeed),
+		RandSeed:               hex.EncodeToString(blockHeader.RandSeed),
+		PubKeysBitmap:          hex.EncodeToString(blockHeader.PubKeysBitmap),
+		Signature:              hex.EncodeToString(blockHeader.Signature),
+		LeaderSignature:        hex.EncodeToString(blockHeader.LeaderSignature),
+		ChainID:                string(blockHeader.ChainID),
+		SoftwareVersion:        hex.EncodeToString(blockHeader.SoftwareVersion),
+		EpochStartInfo:         createEpochStartInfo(blockHeader),
+		ConsensusGroup:         mbp.computeConsensusGroup(blockHeader),
 	}, nil
 }
+
+func createEpochStartInfo(blockHeader *block.MetaBlock) *api.EpochStartInfo {
+	if !blockHeader.IsStartOfEpochBlock() {
+		return nil
+	}
+
+	epochStart := blockHeader.EpochStart
+	lastFinalizedHeaders := make([]*api.EpochStartShardData, 0, len(epochStart.LastFinalizedHeaders))
+	for _, shardData := range epochStart.LastFinalizedHeaders {
+		pendingMiniBlockHeaders := make([]*api.MiniBlock, 0, len(shardData.PendingMiniBlockHeaders))
+		for _, mb := range shardData.PendingMiniBlockHeaders {
+			pendingMiniBlockHeaders = append(pendingMiniBlockHeaders, &api.MiniBlock{
+				Hash:             hex.EncodeToString(mb.Hash),
+				Type:             mb.Type.String(),
+				SourceShard:      mb.SenderShardID,
+				DestinationShard: mb.ReceiverShardID,
+			})
+		}
+
+		lastFinalizedHeaders = append(lastFinalizedHeaders, &api.EpochStartShardData{
+			ShardID:                 shardData.ShardID,
+			Epoch:                   shardData.Epoch,
+			Round:                   shardData.Round,
+			Nonce:                   shardData.Nonce,
+			HeaderHash:              hex.EncodeToString(shardData.HeaderHash),
+			RootHash:                hex.EncodeToString(shardData.RootHash),
+			FirstPendingMetaBlock:   hex.EncodeToString(shardData.FirstPendingMetaBlock),
+			LastFinishedMetaBlock:   hex.EncodeToString(shardData.LastFinishedMetaBlock),
+			PendingMiniBlockHeaders: pendingMiniBlockHeaders,
+		})
+	}
+
+	economics := epochStart.Economics
+	return &api.EpochStartInfo{
+		LastFinalizedHeaders:             lastFinalizedHeaders,
+		TotalSupply:                      bigIntToString(economics.TotalSupply),
+		TotalToDistribute:                bigIntToString(economics.TotalToDistribute),
+		TotalNewlyMinted:                 bigIntToString(economics.TotalNewlyMinted),
+		RewardsPerBlock:                  bigIntToString(economics.RewardsPerBlock),
+		RewardsForProtocolSustainability: bigIntToString(economics.RewardsForProtocolSustainability),
+		NodePrice:                        bigIntToString(economics.NodePrice),
+		PrevEpochStartRound:              economics.PrevEpochStartRound,
+		PrevEpochStartHash:               hex.EncodeToString(economics.PrevEpochStartHash),
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -280,3 +281,76 @@ func TestMetaAPIBlockProcessor_GetBlockByHashFromHistoryNodeStatusReverted(t *te
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestMetaAPIBlockProcessor_GetBlockByHashEpochStartBlockShouldReturnTheEpochStartInfo(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00")
+	storerMock := mock.NewStorerMock()
+	metaAPIBlockProcessor := createMockMetaAPIProcessor(headerHash, storerMock, false, false)
+	metaAPIBlockProcessor.nodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+			assert.Equal(t, core.MetachainShardId, shardId)
+			// the epoch start block is produced by the consensus group of the previous epoch
+			assert.Equal(t, uint32(4), epoch)
+
+			return []sharding.Validator{mock.NewValidatorMock([]byte("leader"), 1, 0)}, nil
+		},
+	}
+
+	header := &block.MetaBlock{
+		Nonce:                  100,
+		Round:                  101,
+		Epoch:                  5,
+		PubKeysBitmap:          []byte{1},
+		ValidatorStatsRootHash: []byte("validatorStatsRootHash"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:    0,
+					Nonce:      90,
+					HeaderHash: []byte("shardHeaderHash"),
+					PendingMiniBlockHeaders: []block.MiniBlockHeader{
+						{Hash: []byte("pending"), SenderShardID: 0, ReceiverShardID: 1},
+					},
+				},
+			},
+			Economics: block.Economics{
+				TotalSupply:        big.NewInt(1000),
+				PrevEpochStartHash: []byte("prevEpochStartHash"),
+			},
+		},
+		AccumulatedFees:        big.NewInt(0),
+		DeveloperFees:          big.NewInt(0),
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+	headerBytes, _ := json.Marshal(header)
+	_ = storerMock.Put(headerHash, headerBytes)
+
+	blk, err := metaAPIBlockProcessor.GetBlockByHash(headerHash, false)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("validatorStatsRootHash")), blk.ValidatorStatsRootHash)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("leader"))}, blk.ConsensusGroup)
+
+	expectedEpochStartInfo := &api.EpochStartInfo{
+		LastFinalizedHeaders: []*api.EpochStartShardData{
+			{
+				ShardID:    0,
+				Nonce:      90,
+				HeaderHash: hex.EncodeToString([]byte("shardHeaderHash")),
+				PendingMiniBlockHeaders: []*api.MiniBlock{
+					{Hash: hex.EncodeToString([]byte("pending")), Type: block.TxBlock.String(), SourceShard: 0, DestinationShard: 1},
+				},
+			},
+		},
+		TotalSupply:                      "1000",
+		TotalToDistribute:                "0",
+		TotalNewlyMinted:                 "0",
+		RewardsPerBlock:                  "0",
+		RewardsForProtocolSustainability: "0",
+		NodePrice:                        "0",
+		PrevEpochStartHash:               hex.EncodeToString([]byte("prevEpochStartHash")),
+	}
+	assert.Equal(t, expectedEpochStartInfo, blk.EpochStartInfo)
+}
@@ -24,6 +24,7 @@ func NewShardApiBlockProcessor(arg *APIBlockProcessorArg) *shardAPIBlockProcesso
 			marshalizer:              arg.Marshalizer,
 			uint64ByteSliceConverter: arg.Uint64ByteSliceConverter,
 			historyRepo:              arg.HistoryRepo,
+			nodesCoordinator:         arg.NodesCoordinator,
 			unmarshalTx:              arg.UnmarshalTx,
 		},
 	}
@@ -64,6 +65,13 @@ func (sbp *shardAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*a
 	return sbp.computeStatusAndPutInBlock(blockAPI, storerUnit)
 }
 
+// GetBlocksByNonceRange will return the shard APIBlocks having the nonces in the provided range
+func (sbp *shardAPIBlockProcessor) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	storerUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(sbp.selfShardID)
+
+	return sbp.getBlocksByNonceRange(storerUnit, dataRetriever.BlockHeaderUnit, from, to, withTxs, sbp.convertShardBlockBytesToAPIBlock)
+}
+
 func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error) {
 	blockHeader := &block.Header{}
 	err := sbp.marshalizer.Unmarshal(blockHeader, blockBytes)
@@ -97,17 +105,28 @@ func (sbp *shardAPIBlockProcessor) convertShardBlockBytesToAPIBlock(hash []byte,
 	}
 
 	return &api.Block{
-		Nonce:           blockHeader.Nonce,
-		Round:           blockHeader.Round,
-		Epoch:           blockHeader.Epoch,
-		Shard:           blockHeader.ShardID,
-		Hash:            hex.EncodeToString(hash),
-		PrevBlockHash:   hex.EncodeToString(blockHeader.PrevHash),
-		NumTxs:          numOfTxs,
-		MiniBlocks:      miniblocks,
-		AccumulatedFees: blockHeader.AccumulatedFees.String(),
-		DeveloperFees:   blockHeader.DeveloperFees.String(),
-		Timestamp:       time.Duration(blockHeader.GetTimeStamp()),
-		Status:          BlockStatusOnChain,
+		Nonce:              blockHeader.Nonce,
+		Round:              blockHeader.Round,
+		Epoch:              blockHeader.Epoch,
+		Shard:              blockHeader.ShardID,
+		Hash:               hex.EncodeToString(hash),
+		PrevBlockHash:      hex.EncodeToString(blockHeader.PrevHash),
+		NumTxs:             numOfTxs,
+		MiniBlocks:         miniblocks,
+		AccumulatedFees:    blockHeader.AccumulatedFees.String(),
+		DeveloperFees:      blockHeader.DeveloperFees.String(),
+		Timestamp:          time.Duration(blockHeader.GetTimeStamp()),
+		Status:             BlockStatusOnChain,
+		StateRootHash:      hex.EncodeToString(blockHeader.RootHash),
+		ReceiptsHash:       hex.EncodeToString(blockHeader.ReceiptsHash),
+		PrevRandSeed:       hex.EncodeToString(blockHeader.PrevRandSeed),
+		RandSeed:           hex.EncodeToString(blockHeader.RandSeed),
+		PubKeysBitmap:      hex.EncodeToString(blockHeader.PubKeysBitmap),
+		Signature:          hex.EncodeToString(blockHeader.Signature),
+		LeaderSignature:    hex.EncodeToString(blockHeader.LeaderSignature),
+		ChainID:            string(blockHeader.ChainID),
+		SoftwareVersion:    hex.EncodeToString(blockHeader.SoftwareVersion),
+		EpochStartMetaHash: hex.EncodeToString(blockHeader.EpochStartMetaHash),
+		ConsensusGroup:     sbp.computeConsensusGroup(blockHeader),
 	}, nil
 }
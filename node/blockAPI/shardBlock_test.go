@@ -3,6 +3,8 @@ package blockAPI
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -268,3 +271,193 @@ func TestShardAPIBlockProcessor_GetBlockByHashFromHistoryNodeStatusReverted(t *t
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestShardAPIBlockProcessor_GetBlockByHashShouldReturnTheSignaturesAndTheConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint32(3)
+	headerHash := []byte("d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00")
+	storerMock := mock.NewStorerMock()
+	shardAPIBlockProcessor := createMockShardAPIProcessor(shardID, headerHash, storerMock, false, false)
+	shardAPIBlockProcessor.nodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+			assert.Equal(t, []byte("prevRandSeed"), randomness)
+			assert.Equal(t, uint64(2), round)
+			assert.Equal(t, shardID, shardId)
+			assert.Equal(t, uint32(1), epoch)
+
+			return []sharding.Validator{
+				mock.NewValidatorMock([]byte("leader"), 1, 0),
+				mock.NewValidatorMock([]byte("validator"), 1, 1),
+			}, nil
+		},
+	}
+
+	header := &block.Header{
+		Nonce:           1,
+		Round:           2,
+		ShardID:         shardID,
+		Epoch:           1,
+		RootHash:        []byte("rootHash"),
+		ReceiptsHash:    []byte("receiptsHash"),
+		PrevRandSeed:    []byte("prevRandSeed"),
+		RandSeed:        []byte("randSeed"),
+		PubKeysBitmap:   []byte{3},
+		Signature:       []byte("signature"),
+		LeaderSignature: []byte("leaderSignature"),
+		ChainID:         []byte("1"),
+		AccumulatedFees: big.NewInt(0),
+		DeveloperFees:   big.NewInt(0),
+	}
+	headerBytes, _ := json.Marshal(header)
+	_ = storerMock.Put(headerHash, headerBytes)
+
+	blk, err := shardAPIBlockProcessor.GetBlockByHash(headerHash, false)
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("rootHash")), blk.StateRootHash)
+	assert.Equal(t, hex.EncodeToString([]byte("receiptsHash")), blk.ReceiptsHash)
+	assert.Equal(t, hex.EncodeToString([]byte("prevRandSeed")), blk.PrevRandSeed)
+	assert.Equal(t, hex.EncodeToString([]byte("randSeed")), blk.RandSeed)
+	assert.Equal(t, "03", blk.PubKeysBitmap)
+	assert.Equal(t, hex.EncodeToString([]byte("signature")), blk.Signature)
+	assert.Equal(t, hex.EncodeToString([]byte("leaderSignature")), blk.LeaderSignature)
+	assert.Equal(t, "1", blk.ChainID)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("leader")), hex.EncodeToString([]byte("validator"))}, blk.ConsensusGroup)
+}
+
+func TestShardAPIBlockProcessor_GetBlockByHashConsensusGroupNotAvailableShouldOmitIt(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00")
+	storerMock := mock.NewStorerMock()
+	shardAPIBlockProcessor := createMockShardAPIProcessor(0, headerHash, storerMock, false, false)
+	shardAPIBlockProcessor.nodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			return nil, errors.New("epoch not available")
+		},
+	}
+
+	header := &block.Header{
+		Nonce:           1,
+		PubKeysBitmap:   []byte{3},
+		AccumulatedFees: big.NewInt(0),
+		DeveloperFees:   big.NewInt(0),
+	}
+	headerBytes, _ := json.Marshal(header)
+	_ = storerMock.Put(headerHash, headerBytes)
+
+	blk, err := shardAPIBlockProcessor.GetBlockByHash(headerHash, false)
+	assert.Nil(t, err)
+	assert.Nil(t, blk.ConsensusGroup)
+}
+
+func TestShardAPIBlockProcessor_GetBlocksByNonceRangeShouldReadTheHeadersInBulkByEpoch(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint32(3)
+	uint64Converter := mock.NewNonceHashConverterMock()
+
+	hashesByNonce := make(map[string][]byte)
+	headersByHash := make(map[string][]byte)
+	for nonce := uint64(10); nonce <= 12; nonce++ {
+		headerHash := []byte(fmt.Sprintf("hash%d", nonce))
+		header := &block.Header{
+			Nonce:           nonce,
+			ShardID:         shardID,
+			Epoch:           uint32(nonce % 2),
+			AccumulatedFees: big.NewInt(0),
+			DeveloperFees:   big.NewInt(0),
+		}
+		headerBytes, _ := json.Marshal(header)
+
+		hashesByNonce[string(uint64Converter.ToByteSlice(nonce))] = headerHash
+		headersByHash[string(headerHash)] = headerBytes
+	}
+
+	bulkReadsByEpoch := make(map[uint32]int)
+	headersStorer := &mock.StorerStub{
+		GetBulkFromEpochCalled: func(keys [][]byte, epoch uint32) (map[string][]byte, error) {
+			bulkReadsByEpoch[epoch]++
+
+			values := make(map[string][]byte)
+			for _, key := range keys {
+				values[string(key)] = headersByHash[string(key)]
+			}
+
+			return values, nil
+		},
+	}
+	shardAPIBlockProcessor := NewShardApiBlockProcessor(
+		&APIBlockProcessorArg{
+			SelfShardID: shardID,
+			Marshalizer: &mock.MarshalizerFake{},
+			Store: &mock.ChainStorerMock{
+				GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+					return headersStorer
+				},
+				GetAllCalled: func(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error) {
+					assert.Equal(t, dataRetriever.ShardHdrNonceHashDataUnit+dataRetriever.UnitType(shardID), unitType)
+
+					values := make(map[string][]byte)
+					for _, key := range keys {
+						values[string(key)] = hashesByNonce[string(key)]
+					}
+
+					return values, nil
+				},
+			},
+			Uint64ByteSliceConverter: uint64Converter,
+			HistoryRepo: &testscommon.HistoryRepositoryStub{
+				GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+					return uint32(hash[len(hash)-1]-'0') % 2, nil
+				},
+				IsEnabledCalled: func() bool {
+					return true
+				},
+			},
+		},
+	)
+
+	blocks, err := shardAPIBlockProcessor.GetBlocksByNonceRange(10, 12, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(blocks))
+	for i, blk := range blocks {
+		assert.Equal(t, uint64(10+i), blk.Nonce)
+		assert.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("hash%d", 10+i))), blk.Hash)
+	}
+	assert.Equal(t, map[uint32]int{0: 1, 1: 1}, bulkReadsByEpoch)
+}
+
+func TestShardAPIBlockProcessor_GetBlocksByNonceRangeMissingHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardAPIBlockProcessor := NewShardApiBlockProcessor(
+		&APIBlockProcessorArg{
+			Marshalizer: &mock.MarshalizerFake{},
+			Store: &mock.ChainStorerMock{
+				GetAllCalled: func(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error) {
+					values := make(map[string][]byte)
+					if unitType == dataRetriever.BlockHeaderUnit {
+						return values, nil
+					}
+
+					for _, key := range keys {
+						values[string(key)] = []byte("hash")
+					}
+
+					return values, nil
+				},
+			},
+			Uint64ByteSliceConverter: mock.NewNonceHashConverterMock(),
+			HistoryRepo: &testscommon.HistoryRepositoryStub{
+				IsEnabledCalled: func() bool {
+					return false
+				},
+			},
+		},
+	)
+
+	blocks, err := shardAPIBlockProcessor.GetBlocksByNonceRange(10, 12, false)
+	assert.Nil(t, blocks)
+	assert.True(t, errors.Is(err, ErrHeaderNotFound))
+}
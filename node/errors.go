@@ -228,3 +228,45 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilNodeRedundancyHandler signals that provided node redundancy handler is nil
 var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrInvalidTransactionsPoolCacheID signals that an invalid transactions pool cache identifier has been provided
+var ErrInvalidTransactionsPoolCacheID = errors.New("invalid transactions pool cache identifier")
+
+// ErrTransactionsPoolDiagnosticsNotAvailable signals that the transactions pool cache does not provide diagnostics
+var ErrTransactionsPoolDiagnosticsNotAvailable = errors.New("transactions pool diagnostics not available")
+
+// ErrSenderNotFoundInTransactionsPool signals that the sender has no transactions in the transactions pool
+var ErrSenderNotFoundInTransactionsPool = errors.New("sender not found in transactions pool")
+
+// ErrHistoricalStateNotAvailable signals that the state at the requested block cannot be read, e.g. it was pruned
+var ErrHistoricalStateNotAvailable = errors.New("state not available for the requested block")
+
+// ErrDBLookupExtensionsNotEnabled signals that the db lookup extensions (required by the requested operation) are not enabled
+var ErrDBLookupExtensionsNotEnabled = errors.New("db lookup extensions not enabled")
+
+// ErrMissingEventsQueryFromBlock signals that the first block of an events query is missing
+var ErrMissingEventsQueryFromBlock = errors.New("missing first block of the events query")
+
+// ErrInvalidEventsQueryRange signals that the first block of an events query is after the last one
+var ErrInvalidEventsQueryRange = errors.New("invalid events query range")
+
+// ErrEventNotFound signals that an indexed event cannot be found in the transaction log
+var ErrEventNotFound = errors.New("event not found")
+
+// ErrNilBlockHeader signals that a nil block header has been found
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrInvalidBlocksRange signals that the first block of a blocks range is after the last one
+var ErrInvalidBlocksRange = errors.New("invalid blocks range")
+
+// ErrBlocksRangeTooLarge signals that a blocks range holds more blocks than allowed for a single request
+var ErrBlocksRangeTooLarge = errors.New("blocks range too large")
+
+// ErrTrieStatisticsNotSupported signals that the accounts adapter cannot report the statistics of its tries
+var ErrTrieStatisticsNotSupported = errors.New("trie statistics not supported by the accounts adapter")
+
+// ErrMerkleProofsNotSupported signals that the accounts adapter cannot compute Merkle proofs
+var ErrMerkleProofsNotSupported = errors.New("merkle proofs not supported by the accounts adapter")
+
+// ErrCannotComputeMerkleProof signals that the Merkle proof could not be computed
+var ErrCannotComputeMerkleProof = errors.New("cannot compute the merkle proof")
@@ -0,0 +1,32 @@
+package esdtSupplyAPI
+
+import "github.com/ElrondNetwork/elrond-go/data/esdt"
+
+type disabledESDTHoldersProcessor struct {
+}
+
+// NewDisabledESDTHoldersProcessor returns a holders processor to be used on a shard node when the esdt holders
+// counting is disabled from the configuration
+func NewDisabledESDTHoldersProcessor() *disabledESDTHoldersProcessor {
+	return &disabledESDTHoldersProcessor{}
+}
+
+// GetESDTSupply returns error as the supply is kept by the ESDT system smart contract, on the metachain
+func (dehp *disabledESDTHoldersProcessor) GetESDTSupply(_ string) (*esdt.ESDTSupply, error) {
+	return nil, ErrCannotReturnSupplyFromShardNode
+}
+
+// GetESDTHolders returns error as the esdt holders counting is disabled
+func (dehp *disabledESDTHoldersProcessor) GetESDTHolders(_ string) (uint64, error) {
+	return 0, ErrESDTHoldersCountingDisabled
+}
+
+// Close does nothing
+func (dehp *disabledESDTHoldersProcessor) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dehp *disabledESDTHoldersProcessor) IsInterfaceNil() bool {
+	return dehp == nil
+}
@@ -0,0 +1,39 @@
+package esdtSupplyAPI
+
+import "errors"
+
+// ErrInvalidHoldersRefreshInterval signals that an invalid holders refresh interval has been provided
+var ErrInvalidHoldersRefreshInterval = errors.New("invalid esdt holders refresh interval")
+
+// ErrCannotCastAccountHandlerToUserAccount signal that returned account is wrong
+var ErrCannotCastAccountHandlerToUserAccount = errors.New("cannot cast AccountHandler to UserAccount")
+
+// ErrCannotReturnSupplyFromShardNode signals that the esdt supply cannot be returned by a shard node
+var ErrCannotReturnSupplyFromShardNode = errors.New("esdt supply cannot be returned by a shard node")
+
+// ErrCannotReturnHoldersFromMetachainNode signals that the esdt holders cannot be returned by a metachain node
+var ErrCannotReturnHoldersFromMetachainNode = errors.New("esdt holders cannot be returned by a metachain node")
+
+// ErrNotFungibleESDT signals that the supply was requested for a token which is not fungible
+var ErrNotFungibleESDT = errors.New("supply is tracked only for fungible esdt tokens")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("trying to set nil marshalizer")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("trying to set nil accounts adapter")
+
+// ErrESDTTokenNotFound signals that the requested esdt token was not issued
+var ErrESDTTokenNotFound = errors.New("esdt token not found")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrAccountsViewNotSupported signals that the provided accounts adapter cannot open a view over an older state
+var ErrAccountsViewNotSupported = errors.New("accounts adapter does not support views at a root hash")
+
+// ErrESDTHoldersNotCountedYet signals that the first counting of the esdt holders did not finish yet
+var ErrESDTHoldersNotCountedYet = errors.New("esdt holders were not counted yet")
+
+// ErrESDTHoldersCountingDisabled signals that the esdt holders counting is disabled from the node's configuration
+var ErrESDTHoldersCountingDisabled = errors.New("esdt holders counting is disabled")
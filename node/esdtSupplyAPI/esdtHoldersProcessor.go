@@ -0,0 +1,224 @@
+package esdtSupplyAPI
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("node/esdtSupplyAPI")
+
+// ArgsESDTHoldersProcessor holds the arguments needed to create an esdtHoldersProcessor
+type ArgsESDTHoldersProcessor struct {
+	Marshalizer     marshal.Marshalizer
+	Accounts        state.AccountsAdapter
+	BlockChain      data.ChainHandler
+	RefreshInterval time.Duration
+}
+
+type esdtHoldersProcessor struct {
+	marshalizer     marshal.Marshalizer
+	viewCreator     state.AccountsViewCreator
+	blockChain      data.ChainHandler
+	refreshInterval time.Duration
+	keyPrefix       []byte
+	holders         map[string]uint64
+	mutHolders      sync.RWMutex
+	cancelFunc      func()
+}
+
+// NewESDTHoldersProcessor will create a new instance of esdtHoldersProcessor, which periodically counts, in background,
+// the accounts holding a positive balance of each fungible token. A shard node only knows the accounts from its own
+// shard, so the returned numbers are per shard. The counting walks a read-only view over the state at the root hash of
+// the last committed block, so neither the blocks processing nor the API requests wait for it
+func NewESDTHoldersProcessor(args ArgsESDTHoldersProcessor) (*esdtHoldersProcessor, error) {
+	if args.RefreshInterval <= 0 {
+		return nil, ErrInvalidHoldersRefreshInterval
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+	viewCreator, ok := args.Accounts.(state.AccountsViewCreator)
+	if !ok {
+		return nil, ErrAccountsViewNotSupported
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	ehp := &esdtHoldersProcessor{
+		marshalizer:     args.Marshalizer,
+		viewCreator:     viewCreator,
+		blockChain:      args.BlockChain,
+		refreshInterval: args.RefreshInterval,
+		keyPrefix:       []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		cancelFunc:      cancelFunc,
+	}
+
+	go ehp.countHoldersPeriodically(ctx)
+
+	return ehp, nil
+}
+
+// GetESDTSupply returns error as the supply is kept by the ESDT system smart contract, on the metachain
+func (ehp *esdtHoldersProcessor) GetESDTSupply(_ string) (*esdt.ESDTSupply, error) {
+	return nil, ErrCannotReturnSupplyFromShardNode
+}
+
+// GetESDTHolders returns the number of accounts from the node's shard holding the given token, as found by the last
+// finished counting
+func (ehp *esdtHoldersProcessor) GetESDTHolders(tokenIdentifier string) (uint64, error) {
+	ehp.mutHolders.RLock()
+	defer ehp.mutHolders.RUnlock()
+
+	if ehp.holders == nil {
+		return 0, ErrESDTHoldersNotCountedYet
+	}
+
+	return ehp.holders[tokenIdentifier], nil
+}
+
+func (ehp *esdtHoldersProcessor) countHoldersPeriodically(ctx context.Context) {
+	for {
+		ehp.refreshHolders(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Debug("esdtHoldersProcessor's go routine is stopping...")
+			return
+		case <-time.After(ehp.refreshInterval):
+		}
+	}
+}
+
+func (ehp *esdtHoldersProcessor) refreshHolders(ctx context.Context) {
+	rootHash := ehp.getLastCommittedRootHash()
+	if len(rootHash) == 0 {
+		return
+	}
+
+	holders, err := ehp.countHolders(ctx, rootHash)
+	if err != nil {
+		log.Debug("esdtHoldersProcessor.countHolders", "root hash", rootHash, "error", err.Error())
+		return
+	}
+
+	ehp.mutHolders.Lock()
+	ehp.holders = holders
+	ehp.mutHolders.Unlock()
+}
+
+func (ehp *esdtHoldersProcessor) getLastCommittedRootHash() []byte {
+	header := ehp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		header = ehp.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		return nil
+	}
+
+	return header.GetRootHash()
+}
+
+func (ehp *esdtHoldersProcessor) countHolders(ctx context.Context, rootHash []byte) (map[string]uint64, error) {
+	accountsView, err := ehp.viewCreator.CreateViewAtRootHash(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves, err := accountsView.GetAllLeaves(rootHash, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make(map[string]uint64)
+	for leaf := range chLeaves {
+		err = ehp.addHeldTokens(ctx, accountsView, leaf.Key(), holders)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the leaves channel is closed early if the context is done
+	err = ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return holders, nil
+}
+
+func (ehp *esdtHoldersProcessor) addHeldTokens(
+	ctx context.Context,
+	accountsView state.AccountsAdapter,
+	address []byte,
+	holders map[string]uint64,
+) error {
+	ah, err := accountsView.GetExistingAccount(address)
+	if err != nil {
+		return nil
+	}
+	account, ok := ah.(state.UserAccountHandler)
+	if !ok || len(account.GetRootHash()) == 0 || check.IfNil(account.DataTrie()) {
+		return nil
+	}
+
+	chLeaves, err := account.DataTrie().GetAllLeavesOnChannel(account.GetRootHash(), ctx)
+	if err != nil {
+		return err
+	}
+
+	for leaf := range chLeaves {
+		if !bytes.HasPrefix(leaf.Key(), ehp.keyPrefix) {
+			continue
+		}
+		if !ehp.holdsFungibleToken(leaf, address) {
+			continue
+		}
+
+		tokenIdentifier := string(leaf.Key()[len(ehp.keyPrefix):])
+		holders[tokenIdentifier]++
+	}
+
+	return nil
+}
+
+func (ehp *esdtHoldersProcessor) holdsFungibleToken(leaf core.KeyValueHolder, address []byte) bool {
+	marshaledData, err := leaf.ValueWithoutSuffix(append(leaf.Key(), address...))
+	if err != nil || len(marshaledData) == 0 {
+		return false
+	}
+
+	esdtData := &esdt.ESDigitalToken{}
+	err = ehp.marshalizer.Unmarshal(esdtData, marshaledData)
+	if err != nil {
+		return false
+	}
+
+	return esdtData.Type == uint32(core.Fungible) && esdtData.Value != nil && esdtData.Value.Sign() > 0
+}
+
+// Close stops the background counting
+func (ehp *esdtHoldersProcessor) Close() error {
+	ehp.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ehp *esdtHoldersProcessor) IsInterfaceNil() bool {
+	return ehp == nil
+}
@@ -0,0 +1,274 @@
+package esdtSupplyAPI
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type accountsViewCreatorStub struct {
+	*mock.AccountsStub
+	CreateViewAtRootHashCalled func(rootHash []byte) (state.AccountsAdapter, error)
+}
+
+func (a *accountsViewCreatorStub) CreateViewAtRootHash(rootHash []byte) (state.AccountsAdapter, error) {
+	return a.CreateViewAtRootHashCalled(rootHash)
+}
+
+func createAccountWithESDTBalance(t *testing.T, address []byte, tokenIdentifier string, tokenType core.ESDTType, value int64) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(address)
+	acc.SetRootHash([]byte("data trie root hash"))
+
+	marshaledData, err := (&mock.MarshalizerFake{}).Marshal(&esdt.ESDigitalToken{Type: uint32(tokenType), Value: big.NewInt(value)})
+	require.Nil(t, err)
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenIdentifier)
+	leafValue := append(marshaledData, esdtTokenKey...)
+	leafValue = append(leafValue, address...)
+
+	acc.SetDataTrie(&mock.TrieStub{
+		GetAllLeavesOnChannelCalled: func(_ []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder, 2)
+			ch <- keyValStorage.NewKeyValStorage([]byte("other key"), []byte("other value"))
+			ch <- keyValStorage.NewKeyValStorage(esdtTokenKey, leafValue)
+			close(ch)
+
+			return ch, nil
+		},
+	})
+
+	return acc
+}
+
+func createAccountsViewWithHolders(accounts map[string]state.UserAccountHandler, numViews *int) *accountsViewCreatorStub {
+	accountsView := &mock.AccountsStub{
+		GetAllLeavesCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder, len(accounts))
+			for address := range accounts {
+				ch <- keyValStorage.NewKeyValStorage([]byte(address), nil)
+			}
+			close(ch)
+
+			return ch, nil
+		},
+		GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+			return accounts[string(addressContainer)], nil
+		},
+	}
+
+	return &accountsViewCreatorStub{
+		AccountsStub: &mock.AccountsStub{},
+		CreateViewAtRootHashCalled: func(_ []byte) (state.AccountsAdapter, error) {
+			*numViews++
+			return accountsView, nil
+		},
+	}
+}
+
+func createBlockChainWithRootHash(rootHash []byte) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: rootHash}
+		},
+	}
+}
+
+func createMockArgsESDTHoldersProcessor() ArgsESDTHoldersProcessor {
+	return ArgsESDTHoldersProcessor{
+		Marshalizer: &mock.MarshalizerFake{},
+		Accounts: &accountsViewCreatorStub{
+			AccountsStub: &mock.AccountsStub{},
+			CreateViewAtRootHashCalled: func(_ []byte) (state.AccountsAdapter, error) {
+				return nil, errors.New("no view")
+			},
+		},
+		BlockChain:      createBlockChainWithRootHash([]byte("root hash")),
+		RefreshInterval: time.Second,
+	}
+}
+
+func waitForHoldersCounting(holdersProc *esdtHoldersProcessor) {
+	for i := 0; i < 100; i++ {
+		_, err := holdersProc.GetESDTHolders("")
+		if err == nil {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNewESDTHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTHoldersProcessor()
+	args.RefreshInterval = 0
+	holdersProc, err := NewESDTHoldersProcessor(args)
+	require.Nil(t, holdersProc)
+	require.Equal(t, ErrInvalidHoldersRefreshInterval, err)
+
+	args = createMockArgsESDTHoldersProcessor()
+	args.Marshalizer = nil
+	holdersProc, err = NewESDTHoldersProcessor(args)
+	require.Nil(t, holdersProc)
+	require.Equal(t, ErrNilMarshalizer, err)
+
+	args = createMockArgsESDTHoldersProcessor()
+	args.Accounts = nil
+	holdersProc, err = NewESDTHoldersProcessor(args)
+	require.Nil(t, holdersProc)
+	require.Equal(t, ErrNilAccountsAdapter, err)
+
+	args = createMockArgsESDTHoldersProcessor()
+	args.BlockChain = nil
+	holdersProc, err = NewESDTHoldersProcessor(args)
+	require.Nil(t, holdersProc)
+	require.Equal(t, ErrNilBlockChain, err)
+
+	args = createMockArgsESDTHoldersProcessor()
+	args.Accounts = &mock.AccountsStub{}
+	holdersProc, err = NewESDTHoldersProcessor(args)
+	require.Nil(t, holdersProc)
+	require.Equal(t, ErrAccountsViewNotSupported, err)
+
+	args = createMockArgsESDTHoldersProcessor()
+	holdersProc, err = NewESDTHoldersProcessor(args)
+	require.Nil(t, err)
+	require.False(t, holdersProc.IsInterfaceNil())
+	require.Nil(t, holdersProc.Close())
+}
+
+func TestESDTHoldersProcessor_GetESDTHolders_NotCountedYetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	holdersProc, _ := NewESDTHoldersProcessor(createMockArgsESDTHoldersProcessor())
+	defer func() {
+		_ = holdersProc.Close()
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	numHolders, err := holdersProc.GetESDTHolders("TKN-abcdef")
+	require.Equal(t, uint64(0), numHolders)
+	require.Equal(t, ErrESDTHoldersNotCountedYet, err)
+}
+
+func TestESDTHoldersProcessor_GetESDTHolders_ShouldCountOnlyPositiveFungibleBalances(t *testing.T) {
+	t.Parallel()
+
+	tokenIdentifier := "TKN-abcdef"
+	accounts := map[string]state.UserAccountHandler{
+		"address1": createAccountWithESDTBalance(t, []byte("address1"), tokenIdentifier, core.Fungible, 10),
+		"address2": createAccountWithESDTBalance(t, []byte("address2"), tokenIdentifier, core.Fungible, 0),
+		"address3": createAccountWithESDTBalance(t, []byte("address3"), "OTHER-abcdef", core.Fungible, 10),
+		"address4": createAccountWithESDTBalance(t, []byte("address4"), tokenIdentifier, core.Fungible, 5),
+		"address5": createAccountWithESDTBalance(t, []byte("address5"), tokenIdentifier, core.NonFungible, 1),
+	}
+	numViews := 0
+	args := createMockArgsESDTHoldersProcessor()
+	args.Accounts = createAccountsViewWithHolders(accounts, &numViews)
+	holdersProc, _ := NewESDTHoldersProcessor(args)
+	defer func() {
+		_ = holdersProc.Close()
+	}()
+
+	waitForHoldersCounting(holdersProc)
+
+	numHolders, err := holdersProc.GetESDTHolders(tokenIdentifier)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), numHolders)
+
+	numHolders, err = holdersProc.GetESDTHolders("OTHER-abcdef")
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), numHolders)
+
+	supply, err := holdersProc.GetESDTSupply(tokenIdentifier)
+	require.Nil(t, supply)
+	require.Equal(t, ErrCannotReturnSupplyFromShardNode, err)
+}
+
+func TestESDTHoldersProcessor_GetESDTHolders_ShouldNotCountOnRequest(t *testing.T) {
+	t.Parallel()
+
+	tokenIdentifier := "TKN-abcdef"
+	accounts := map[string]state.UserAccountHandler{
+		"address1": createAccountWithESDTBalance(t, []byte("address1"), tokenIdentifier, core.Fungible, 10),
+	}
+	numViews := 0
+	args := createMockArgsESDTHoldersProcessor()
+	args.Accounts = createAccountsViewWithHolders(accounts, &numViews)
+	args.RefreshInterval = time.Hour
+	holdersProc, _ := NewESDTHoldersProcessor(args)
+	defer func() {
+		_ = holdersProc.Close()
+	}()
+
+	waitForHoldersCounting(holdersProc)
+
+	for i := 0; i < 10; i++ {
+		numHolders, err := holdersProc.GetESDTHolders(tokenIdentifier)
+		require.Nil(t, err)
+		require.Equal(t, uint64(1), numHolders)
+	}
+
+	holdersProc.mutHolders.RLock()
+	require.Equal(t, 1, numViews)
+	holdersProc.mutHolders.RUnlock()
+}
+
+func TestESDTHoldersProcessor_CountHoldersShouldUseTheLastCommittedRootHash(t *testing.T) {
+	t.Parallel()
+
+	committedRootHash := []byte("committed root hash")
+	var requestedRootHash []byte
+	args := createMockArgsESDTHoldersProcessor()
+	args.BlockChain = createBlockChainWithRootHash(committedRootHash)
+	args.RefreshInterval = time.Hour
+	args.Accounts = &accountsViewCreatorStub{
+		AccountsStub: &mock.AccountsStub{},
+		CreateViewAtRootHashCalled: func(rootHash []byte) (state.AccountsAdapter, error) {
+			requestedRootHash = rootHash
+			return &mock.AccountsStub{
+				GetAllLeavesCalled: func(_ []byte) (chan core.KeyValueHolder, error) {
+					ch := make(chan core.KeyValueHolder)
+					close(ch)
+
+					return ch, nil
+				},
+			}, nil
+		},
+	}
+	holdersProc, _ := NewESDTHoldersProcessor(args)
+	defer func() {
+		_ = holdersProc.Close()
+	}()
+
+	waitForHoldersCounting(holdersProc)
+
+	holdersProc.mutHolders.RLock()
+	require.Equal(t, committedRootHash, requestedRootHash)
+	holdersProc.mutHolders.RUnlock()
+}
+
+func TestDisabledESDTHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	holdersProc := NewDisabledESDTHoldersProcessor()
+	require.False(t, holdersProc.IsInterfaceNil())
+
+	numHolders, err := holdersProc.GetESDTHolders("TKN-abcdef")
+	require.Equal(t, uint64(0), numHolders)
+	require.Equal(t, ErrESDTHoldersCountingDisabled, err)
+
+	supply, err := holdersProc.GetESDTSupply("TKN-abcdef")
+	require.Nil(t, supply)
+	require.Equal(t, ErrCannotReturnSupplyFromShardNode, err)
+	require.Nil(t, holdersProc.Close())
+}
@@ -0,0 +1,38 @@
+package esdtSupplyAPI
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// ArgsESDTSupplyHandler is struct that contains components that are needed to create an ESDTSupplyHandler
+type ArgsESDTSupplyHandler struct {
+	ShardID             uint32
+	InternalMarshalizer marshal.Marshalizer
+	Accounts            state.AccountsAdapter
+	BlockChain          data.ChainHandler
+	Config              config.ESDTHoldersConfig
+}
+
+// CreateESDTSupplyHandler will create a new instance of ESDTSupplyHandler. The metachain node returns the supply
+// of a token while a shard node returns the number of holders from its own shard, if enabled from the configuration
+func CreateESDTSupplyHandler(args *ArgsESDTSupplyHandler) (ClosableESDTSupplyHandler, error) {
+	if args.ShardID == core.MetachainShardId {
+		return NewESDTSupplyProcessor(args.InternalMarshalizer, args.Accounts)
+	}
+	if !args.Config.Enabled {
+		return NewDisabledESDTHoldersProcessor(), nil
+	}
+
+	return NewESDTHoldersProcessor(ArgsESDTHoldersProcessor{
+		Marshalizer:     args.InternalMarshalizer,
+		Accounts:        args.Accounts,
+		BlockChain:      args.BlockChain,
+		RefreshInterval: time.Duration(args.Config.RefreshIntervalInSec) * time.Second,
+	})
+}
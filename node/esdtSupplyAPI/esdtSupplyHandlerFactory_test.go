@@ -0,0 +1,74 @@
+package esdtSupplyAPI
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateESDTSupplyHandler_ESDTHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsESDTSupplyHandler{
+		ShardID:             0,
+		InternalMarshalizer: &mock.MarshalizerMock{},
+		Accounts:            createMockArgsESDTHoldersProcessor().Accounts,
+		BlockChain:          &mock.BlockChainMock{},
+		Config: config.ESDTHoldersConfig{
+			Enabled:              true,
+			RefreshIntervalInSec: 60,
+		},
+	}
+
+	esdtSupplyHandler, err := CreateESDTSupplyHandler(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = esdtSupplyHandler.Close()
+	}()
+
+	holdersProc, ok := esdtSupplyHandler.(*esdtHoldersProcessor)
+	require.True(t, ok)
+	require.NotNil(t, holdersProc)
+}
+
+func TestCreateESDTSupplyHandler_DisabledESDTHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsESDTSupplyHandler{
+		ShardID:             0,
+		InternalMarshalizer: &mock.MarshalizerMock{},
+		Accounts:            &mock.AccountsStub{},
+		BlockChain:          &mock.BlockChainMock{},
+		Config: config.ESDTHoldersConfig{
+			Enabled: false,
+		},
+	}
+
+	esdtSupplyHandler, err := CreateESDTSupplyHandler(args)
+	require.Nil(t, err)
+
+	holdersProc, ok := esdtSupplyHandler.(*disabledESDTHoldersProcessor)
+	require.True(t, ok)
+	require.NotNil(t, holdersProc)
+}
+
+func TestCreateESDTSupplyHandler_ESDTSupplyProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsESDTSupplyHandler{
+		ShardID:             core.MetachainShardId,
+		InternalMarshalizer: &mock.MarshalizerMock{},
+		Accounts:            &mock.AccountsStub{},
+		BlockChain:          &mock.BlockChainMock{},
+	}
+
+	esdtSupplyHandler, err := CreateESDTSupplyHandler(args)
+	require.Nil(t, err)
+
+	supplyProc, ok := esdtSupplyHandler.(*esdtSupplyProcessor)
+	require.True(t, ok)
+	require.NotNil(t, supplyProc)
+}
@@ -0,0 +1,101 @@
+package esdtSupplyAPI
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+type esdtSupplyProcessor struct {
+	marshalizer marshal.Marshalizer
+	accounts    state.AccountsAdapter
+}
+
+// NewESDTSupplyProcessor will create a new instance of esdtSupplyProcessor, which reads the token supply from the
+// ESDT system smart contract and can only be used on a metachain node
+func NewESDTSupplyProcessor(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+) (*esdtSupplyProcessor, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return &esdtSupplyProcessor{
+		marshalizer: marshalizer,
+		accounts:    accounts,
+	}, nil
+}
+
+// GetESDTSupply will return the minted, burnt and wiped values of the given token, together with the resulting supply
+func (esp *esdtSupplyProcessor) GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+	ah, err := esp.accounts.GetExistingAccount(vm.ESDTSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := ah.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccount
+	}
+
+	marshaledData, err := account.DataTrieTracker().RetrieveValue([]byte(tokenIdentifier))
+	if err != nil {
+		return nil, err
+	}
+	if len(marshaledData) == 0 {
+		return nil, ErrESDTTokenNotFound
+	}
+
+	token := &systemSmartContracts.ESDTData{}
+	err = esp.marshalizer.Unmarshal(token, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if len(token.TokenType) > 0 && !bytes.Equal(token.TokenType, []byte(core.FungibleESDT)) {
+		return nil, ErrNotFungibleESDT
+	}
+
+	supply := &esdt.ESDTSupply{
+		Minted: valueOrZero(token.MintedValue),
+		Burnt:  valueOrZero(token.BurntValue),
+		Wiped:  big.NewInt(0).SetBytes(token.WipedValue),
+	}
+	supply.Supply = big.NewInt(0).Sub(supply.Minted, supply.Burnt)
+	supply.Supply.Sub(supply.Supply, supply.Wiped)
+
+	return supply, nil
+}
+
+// GetESDTHolders returns error as the holders are kept in the shards
+func (esp *esdtSupplyProcessor) GetESDTHolders(_ string) (uint64, error) {
+	return 0, ErrCannotReturnHoldersFromMetachainNode
+}
+
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (esp *esdtSupplyProcessor) IsInterfaceNil() bool {
+	return esp == nil
+}
+
+// Close does nothing as the supply is read on request
+func (esp *esdtSupplyProcessor) Close() error {
+	return nil
+}
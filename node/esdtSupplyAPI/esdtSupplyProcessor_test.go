@@ -0,0 +1,156 @@
+package esdtSupplyAPI
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/require"
+)
+
+func createESDTSCAccountWithToken(t *testing.T, tokenIdentifier string, token *systemSmartContracts.ESDTData) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(vm.ESDTSCAddress)
+	acc.SetDataTrie(&mock.TrieStub{})
+
+	if token != nil {
+		marshaledData, err := (&mock.MarshalizerFake{}).Marshal(token)
+		require.Nil(t, err)
+		err = acc.DataTrieTracker().SaveKeyValue([]byte(tokenIdentifier), marshaledData)
+		require.Nil(t, err)
+	}
+
+	return acc
+}
+
+func TestNewESDTSupplyProcessor(t *testing.T) {
+	t.Parallel()
+
+	supplyProc, err := NewESDTSupplyProcessor(nil, &mock.AccountsStub{})
+	require.Nil(t, supplyProc)
+	require.Equal(t, ErrNilMarshalizer, err)
+
+	supplyProc, err = NewESDTSupplyProcessor(&mock.MarshalizerMock{}, nil)
+	require.Nil(t, supplyProc)
+	require.Equal(t, ErrNilAccountsAdapter, err)
+
+	supplyProc, err = NewESDTSupplyProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{})
+	require.Nil(t, err)
+	require.False(t, supplyProc.IsInterfaceNil())
+}
+
+func TestESDTSupplyProcessor_GetESDTSupply_CannotGetAccount(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	supplyProc, _ := NewESDTSupplyProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+			return nil, expectedErr
+		},
+	})
+
+	supply, err := supplyProc.GetESDTSupply("TKN-abcdef")
+	require.Nil(t, supply)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestESDTSupplyProcessor_GetESDTSupply_CannotCastAccount(t *testing.T) {
+	t.Parallel()
+
+	supplyProc, _ := NewESDTSupplyProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+			return nil, nil
+		},
+	})
+
+	supply, err := supplyProc.GetESDTSupply("TKN-abcdef")
+	require.Nil(t, supply)
+	require.Equal(t, ErrCannotCastAccountHandlerToUserAccount, err)
+}
+
+func TestESDTSupplyProcessor_GetESDTSupply_TokenNotFound(t *testing.T) {
+	t.Parallel()
+
+	acc := createESDTSCAccountWithToken(t, "", nil)
+	supplyProc, _ := NewESDTSupplyProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	})
+
+	supply, err := supplyProc.GetESDTSupply("TKN-abcdef")
+	require.Nil(t, supply)
+	require.Equal(t, ErrESDTTokenNotFound, err)
+}
+
+func TestESDTSupplyProcessor_GetESDTSupply_NotFungibleToken(t *testing.T) {
+	t.Parallel()
+
+	tokenIdentifier := "NFT-abcdef"
+	acc := createESDTSCAccountWithToken(t, tokenIdentifier, &systemSmartContracts.ESDTData{
+		TokenType: []byte(core.NonFungibleESDT),
+	})
+	supplyProc, _ := NewESDTSupplyProcessor(&mock.MarshalizerFake{}, &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	})
+
+	supply, err := supplyProc.GetESDTSupply(tokenIdentifier)
+	require.Nil(t, supply)
+	require.Equal(t, ErrNotFungibleESDT, err)
+}
+
+func TestESDTSupplyProcessor_GetESDTSupply_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tokenIdentifier := "TKN-abcdef"
+	acc := createESDTSCAccountWithToken(t, tokenIdentifier, &systemSmartContracts.ESDTData{
+		TokenType:   []byte(core.FungibleESDT),
+		MintedValue: big.NewInt(1000),
+		BurntValue:  big.NewInt(100),
+		WipedValue:  big.NewInt(50).Bytes(),
+	})
+	supplyProc, _ := NewESDTSupplyProcessor(&mock.MarshalizerFake{}, &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+			require.Equal(t, vm.ESDTSCAddress, addressContainer)
+			return acc, nil
+		},
+	})
+
+	supply, err := supplyProc.GetESDTSupply(tokenIdentifier)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(1000), supply.Minted)
+	require.Equal(t, big.NewInt(100), supply.Burnt)
+	require.Equal(t, big.NewInt(50), supply.Wiped)
+	require.Equal(t, big.NewInt(850), supply.Supply)
+
+	numHolders, err := supplyProc.GetESDTHolders(tokenIdentifier)
+	require.Equal(t, uint64(0), numHolders)
+	require.Equal(t, ErrCannotReturnHoldersFromMetachainNode, err)
+}
+
+func TestESDTSupplyProcessor_GetESDTSupply_TokenWithoutWipedValue(t *testing.T) {
+	t.Parallel()
+
+	tokenIdentifier := "TKN-abcdef"
+	acc := createESDTSCAccountWithToken(t, tokenIdentifier, &systemSmartContracts.ESDTData{
+		TokenType:   []byte(core.FungibleESDT),
+		MintedValue: big.NewInt(1000),
+		BurntValue:  big.NewInt(100),
+	})
+	supplyProc, _ := NewESDTSupplyProcessor(&mock.MarshalizerFake{}, &mock.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	})
+
+	supply, err := supplyProc.GetESDTSupply(tokenIdentifier)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0), supply.Wiped)
+	require.Equal(t, big.NewInt(900), supply.Supply)
+}
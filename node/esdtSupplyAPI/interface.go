@@ -0,0 +1,9 @@
+package esdtSupplyAPI
+
+import "github.com/ElrondNetwork/elrond-go/node/external"
+
+// ClosableESDTSupplyHandler defines an ESDTSupplyHandler which has to be closed when the node stops
+type ClosableESDTSupplyHandler interface {
+	external.ESDTSupplyHandler
+	Close() error
+}
@@ -13,3 +13,6 @@ var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
 
 // ErrNilTotalStakedValueHandler signals that a nil total staked value handler has been provided
 var ErrNilTotalStakedValueHandler = errors.New("nil total staked value handler")
+
+// ErrNilESDTSupplyHandler signals that a nil esdt supply handler has been provided
+var ErrNilESDTSupplyHandler = errors.New("nil esdt supply handler")
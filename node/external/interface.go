@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -37,3 +38,10 @@ type TotalStakedValueHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
 	IsInterfaceNil() bool
 }
+
+// ESDTSupplyHandler defines the behavior of a component able to return the supply and the number of holders of an ESDT token
+type ESDTSupplyHandler interface {
+	GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error)
+	GetESDTHolders(tokenIdentifier string) (uint64, error)
+	IsInterfaceNil() bool
+}
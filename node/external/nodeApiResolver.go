@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -15,6 +16,7 @@ type NodeApiResolver struct {
 	statusMetricsHandler    StatusMetricsHandler
 	txCostHandler           TransactionCostHandler
 	totalStakedValueHandler TotalStakedValueHandler
+	esdtSupplyHandler       ESDTSupplyHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -23,6 +25,7 @@ func NewNodeApiResolver(
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
 	totalStakedValueHandler TotalStakedValueHandler,
+	esdtSupplyHandler ESDTSupplyHandler,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -36,12 +39,16 @@ func NewNodeApiResolver(
 	if check.IfNil(totalStakedValueHandler) {
 		return nil, ErrNilTotalStakedValueHandler
 	}
+	if check.IfNil(esdtSupplyHandler) {
+		return nil, ErrNilESDTSupplyHandler
+	}
 
 	return &NodeApiResolver{
 		scQueryService:          scQueryService,
 		statusMetricsHandler:    statusMetricsHandler,
 		txCostHandler:           txCostHandler,
 		totalStakedValueHandler: totalStakedValueHandler,
+		esdtSupplyHandler:       esdtSupplyHandler,
 	}, nil
 }
 
@@ -65,6 +72,16 @@ func (nar *NodeApiResolver) GetTotalStakedValue() (*big.Int, error) {
 	return nar.totalStakedValueHandler.GetTotalStakedValue()
 }
 
+// GetESDTSupply will return the supply of the given esdt token
+func (nar *NodeApiResolver) GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+	return nar.esdtSupplyHandler.GetESDTSupply(tokenIdentifier)
+}
+
+// GetESDTHolders will return the number of holders of the given esdt token
+func (nar *NodeApiResolver) GetESDTHolders(tokenIdentifier string) (uint64, error) {
+	return nar.esdtSupplyHandler.GetESDTHolders(tokenIdentifier)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
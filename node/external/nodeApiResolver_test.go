@@ -1,10 +1,12 @@
 package external_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
@@ -16,7 +18,7 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, &mock.ESDTSupplyHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -26,7 +28,7 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, &mock.ESDTSupplyHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -36,7 +38,7 @@ func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, totalStakedAPIHandler)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, totalStakedAPIHandler, &mock.ESDTSupplyHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
@@ -45,17 +47,27 @@ func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 func TestNewNodeApiResolver_NilTotalStakedValueHandler(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil, &mock.ESDTSupplyHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTotalStakedValueHandler, err)
 }
 
+func TestNewNodeApiResolver_NilESDTSupplyHandler(t *testing.T) {
+	t.Parallel()
+
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilESDTSupplyHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler, &mock.ESDTSupplyHandlerStub{})
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -74,6 +86,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	},
 		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		&mock.ESDTSupplyHandlerStub{},
 	)
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
@@ -99,6 +112,7 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		&mock.ESDTSupplyHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -120,6 +134,7 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		&mock.ESDTSupplyHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -141,6 +156,7 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		&mock.ESDTSupplyHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -162,6 +178,7 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		&mock.ESDTSupplyHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -183,8 +200,40 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 		},
 		&mock.TransactionCostEstimatorMock{},
 		totalStakedAPIHandler,
+		&mock.ESDTSupplyHandlerStub{},
 	)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetESDTSupplyAndHoldersShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+	expectedSupply := &esdt.ESDTSupply{Supply: big.NewInt(100)}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		totalStakedAPIHandler,
+		&mock.ESDTSupplyHandlerStub{
+			GetESDTSupplyCalled: func(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+				assert.Equal(t, "TKN-010203", tokenIdentifier)
+				return expectedSupply, nil
+			},
+			GetESDTHoldersCalled: func(tokenIdentifier string) (uint64, error) {
+				assert.Equal(t, "TKN-010203", tokenIdentifier)
+				return 7, nil
+			},
+		},
+	)
+
+	supply, err := nar.GetESDTSupply("TKN-010203")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSupply, supply)
+
+	numHolders, err := nar.GetESDTHolders("TKN-010203")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), numHolders)
+}
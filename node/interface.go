@@ -7,6 +7,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -83,3 +85,15 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// TxCacheDiagnosticsHandler defines the diagnostics capabilities of a transactions cache
+type TxCacheDiagnosticsHandler interface {
+	GetSenderDiagnostics(sender []byte) (*txcache.SenderDiagnostics, bool)
+	GetSendersDiagnostics() []*txcache.SenderDiagnostics
+	IsInterfaceNil() bool
+}
+
+// ExistingShardDataStoreHandler defines a pool able to return its caches without creating the missing ones
+type ExistingShardDataStoreHandler interface {
+	ExistingShardDataStore(cacheID string) (storage.Cacher, bool)
+}
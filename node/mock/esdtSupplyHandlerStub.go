@@ -0,0 +1,30 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/esdt"
+
+// ESDTSupplyHandlerStub -
+type ESDTSupplyHandlerStub struct {
+	GetESDTSupplyCalled  func(tokenIdentifier string) (*esdt.ESDTSupply, error)
+	GetESDTHoldersCalled func(tokenIdentifier string) (uint64, error)
+}
+
+// GetESDTSupply -
+func (ess *ESDTSupplyHandlerStub) GetESDTSupply(tokenIdentifier string) (*esdt.ESDTSupply, error) {
+	if ess.GetESDTSupplyCalled != nil {
+		return ess.GetESDTSupplyCalled(tokenIdentifier)
+	}
+	return &esdt.ESDTSupply{}, nil
+}
+
+// GetESDTHolders -
+func (ess *ESDTSupplyHandlerStub) GetESDTHolders(tokenIdentifier string) (uint64, error) {
+	if ess.GetESDTHoldersCalled != nil {
+		return ess.GetESDTHoldersCalled(tokenIdentifier)
+	}
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (ess *ESDTSupplyHandlerStub) IsInterfaceNil() bool {
+	return ess == nil
+}
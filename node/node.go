@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	disabledSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/singlesig"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -413,9 +414,9 @@ func (n *Node) addCloserInstances(closers ...update.Closer) error {
 	return nil
 }
 
-// GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	account, err := n.getAccountHandler(address)
+// GetBalance gets the balance for a specific address, optionally at an older block
+func (n *Node) GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +431,7 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 
 // GetUsername gets the username for a specific address
 func (n *Node) GetUsername(address string) (string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, api.AccountQueryOptions{})
 	if err != nil {
 		return "", err
 	}
@@ -444,9 +445,9 @@ func (n *Node) GetUsername(address string) (string, error) {
 	return string(username), nil
 }
 
-// GetKeyValuePairs returns all the key-value pairs under the address
-func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
-	account, err := n.getAccountHandler(address)
+// GetKeyValuePairs returns all the key-value pairs under the address, optionally at an older block
+func (n *Node) GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -486,7 +487,7 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, api.AccountQueryOptions{})
 	if err != nil {
 		return "", err
 	}
@@ -506,7 +507,7 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 
 // GetESDTBalance returns the esdt balance and properties from a given account
 func (n *Node) GetESDTBalance(address string, tokenName string) (string, string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, api.AccountQueryOptions{})
 	if err != nil {
 		return "", "", err
 	}
@@ -533,7 +534,7 @@ func (n *Node) GetESDTBalance(address string, tokenName string) (string, string,
 
 // GetAllESDTTokens returns the value of a key from a given account
 func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, api.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -574,7 +575,7 @@ func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
 	return foundTokens, nil
 }
 
-func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
+func (n *Node) getAccountHandler(address string, options api.AccountQueryOptions) (state.AccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -583,7 +584,13 @@ func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
-	return n.accounts.GetExistingAccount(addr)
+
+	accounts, err := n.getAccountsAdapterForQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts.GetExistingAccount(addr)
 }
 
 func (n *Node) castAccountToUserAccount(ah state.AccountHandler) (state.UserAccountHandler, bool) {
@@ -964,6 +971,51 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 	return txValidator.CheckTxValidity(intTx)
 }
 
+// AddTransactionsToPool revalidates the provided transactions, with the same checks as the interceptors, and adds the
+// valid ones to the local transactions pool, without broadcasting them. It returns the number of added transactions.
+func (n *Node) AddTransactionsToPool(txs []*transaction.Transaction) (uint64, error) {
+	if n.dataPool == nil {
+		return 0, ErrNilDataPool
+	}
+
+	numAdded := uint64(0)
+	for _, tx := range txs {
+		err := n.addTransactionToPool(tx)
+		if err != nil {
+			log.Trace("node.AddTransactionsToPool: transaction not added",
+				"nonce", tx.Nonce,
+				"error", err.Error())
+			continue
+		}
+
+		numAdded++
+	}
+
+	return numAdded, nil
+}
+
+func (n *Node) addTransactionToPool(tx *transaction.Transaction) error {
+	err := n.checkSenderIsInShard(tx)
+	if err != nil {
+		return err
+	}
+
+	txValidator, intTx, err := n.commonTransactionValidation(tx, n.whiteListerVerifiedTxs, n.whiteListRequest, true)
+	if err != nil {
+		return err
+	}
+
+	err = txValidator.CheckTxValidity(intTx)
+	if err != nil {
+		return err
+	}
+
+	cacherIdentifier := process.ShardCacherIdentifier(intTx.SenderShardId(), intTx.ReceiverShardId())
+	n.dataPool.Transactions().AddData(intTx.Hash(), intTx.Transaction(), intTx.Transaction().Size(), cacherIdentifier)
+
+	return nil
+}
+
 // ValidateTransactionForSimulation will validate a transaction for use in transaction simulation process
 func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error {
 	disabledWhiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
@@ -986,7 +1038,7 @@ func (n *Node) commonTransactionValidation(
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	whiteListRequest process.WhiteListHandler,
 	checkSignature bool,
-) (process.TxValidator, process.TxValidatorHandler, error) {
+) (process.TxValidator, *procTx.InterceptedTransaction, error) {
 	txValidator, err := dataValidators.NewTxValidator(
 		n.accounts,
 		n.shardCoordinator,
@@ -1186,39 +1238,56 @@ func (n *Node) CreateTransaction(
 	return tx, txHash, nil
 }
 
-// GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+// GetAccount will return account details for a given address, optionally at an older block
+func (n *Node) GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+	account, _, err := n.getAccountAndAdapter(address, options)
+	return account, err
+}
+
+// GetAccountWithCode will return account details and the code for a given address, optionally at an older block. Both
+// are read from the same accounts adapter, thus a historical view is created only once
+func (n *Node) GetAccountWithCode(address string, options api.AccountQueryOptions) (state.UserAccountHandler, []byte, error) {
+	account, accounts, err := n.getAccountAndAdapter(address, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, accounts.GetCode(account.GetCodeHash()), nil
+}
+
+func (n *Node) getAccountAndAdapter(address string, options api.AccountQueryOptions) (state.UserAccountHandler, state.AccountsAdapter, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
-		return nil, ErrNilPubkeyConverter
+		return nil, nil, ErrNilPubkeyConverter
 	}
 	if check.IfNil(n.accounts) {
-		return nil, ErrNilAccountsAdapter
+		return nil, nil, ErrNilAccountsAdapter
 	}
 
 	addr, err := n.addressPubkeyConverter.Decode(address)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	accounts, err := n.getAccountsAdapterForQuery(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
-			return state.NewUserAccount(addr)
+			account, errNew := state.NewUserAccount(addr)
+			return account, accounts, errNew
 		}
-		return nil, errors.New("could not fetch sender address from provided param: " + err.Error())
+		return nil, nil, errors.New("could not fetch sender address from provided param: " + err.Error())
 	}
 
 	account, ok := accWrp.(state.UserAccountHandler)
 	if !ok {
-		return nil, errors.New("account is not of type with balance and nonce")
+		return nil, nil, errors.New("account is not of type with balance and nonce")
 	}
 
-	return account, nil
-}
-
-// GetCode returns the code for the given account
-func (n *Node) GetCode(account state.UserAccountHandler) []byte {
-	return n.accounts.GetCode(account.GetCodeHash())
+	return account, accounts, nil
 }
 
 // StartHeartbeat starts the node's heartbeat processing/signaling module
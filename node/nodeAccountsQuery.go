@@ -0,0 +1,159 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// getAccountsAdapterForQuery returns the current accounts adapter or, for historical queries, a read-only view over
+// the state at the root hash of the requested block
+func (n *Node) getAccountsAdapterForQuery(options api.AccountQueryOptions) (state.AccountsAdapter, error) {
+	if check.IfNil(n.accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if !options.IsHistorical() {
+		return n.accounts, nil
+	}
+
+	viewCreator, ok := n.accounts.(state.AccountsViewCreator)
+	if !ok {
+		return nil, process.ErrHistoricalStateNotSupported
+	}
+
+	rootHash, err := n.getBlockRootHash(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsView, err := viewCreator.CreateViewAtRootHash(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrHistoricalStateNotAvailable, err.Error())
+	}
+
+	return accountsView, nil
+}
+
+// getBlockRootHash returns the state root hash of the block requested through the query options
+func (n *Node) getBlockRootHash(options api.AccountQueryOptions) ([]byte, error) {
+	header, _, err := n.getHistoricalBlockHeader(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.GetRootHash(), nil
+}
+
+// getBlockHeaderForQuery returns the header and the hash of the block requested through the query options or, when no
+// block is requested, the current block header and its hash
+func (n *Node) getBlockHeaderForQuery(options api.AccountQueryOptions) (data.HeaderHandler, []byte, error) {
+	if options.IsHistorical() {
+		return n.getHistoricalBlockHeader(options)
+	}
+
+	currentHeader, err := n.getCurrentBlockHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentHeaderHash := n.blkc.GetCurrentBlockHeaderHash()
+	if check.IfNil(n.blkc.GetCurrentBlockHeader()) {
+		currentHeaderHash = n.blkc.GetGenesisHeaderHash()
+	}
+
+	return currentHeader, currentHeaderHash, nil
+}
+
+// getHistoricalBlockHeader returns the header and the hash of the block requested through the query options. The
+// block hash takes precedence over the block nonce
+func (n *Node) getHistoricalBlockHeader(options api.AccountQueryOptions) (data.HeaderHandler, []byte, error) {
+	headerHash := options.BlockHash
+	if len(headerHash) == 0 {
+		var err error
+		headerHash, err = n.getHeaderHashByNonce(options.BlockNonce)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	header, err := n.getHeaderByHash(headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, headerHash, nil
+}
+
+// getCurrentBlockHeader returns the current block header or, before the first committed block, the genesis header
+func (n *Node) getCurrentBlockHeader() (data.HeaderHandler, error) {
+	currentHeader := n.blkc.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		currentHeader = n.blkc.GetGenesisHeader()
+	}
+	if check.IfNil(currentHeader) {
+		return nil, ErrNilBlockHeader
+	}
+
+	return currentHeader, nil
+}
+
+func (n *Node) getHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	headerHash, err := n.getHeaderHashByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getHeaderByHash(headerHash)
+}
+
+func (n *Node) getHeaderHashByNonce(nonce uint64) ([]byte, error) {
+	storerUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(n.shardCoordinator.SelfId())
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		storerUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	nonceToByteSlice := n.uint64ByteSliceConverter.ToByteSlice(nonce)
+	return n.store.Get(storerUnit, nonceToByteSlice)
+}
+
+func (n *Node) getHeaderByHash(headerHash []byte) (data.HeaderHandler, error) {
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		headerBytes, err := n.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
+		if err != nil {
+			return nil, err
+		}
+
+		header := &block.MetaBlock{}
+		err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+		return header, err
+	}
+
+	headerBytes, err := n.getFromStorer(dataRetriever.BlockHeaderUnit, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &block.Header{}
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	return header, err
+}
+
+func (n *Node) getFromStorer(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
+	if check.IfNil(n.historyRepository) || !n.historyRepository.IsEnabled() {
+		return n.store.Get(unit, key)
+	}
+
+	epoch, err := n.historyRepository.GetEpochByHash(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.store.GetStorer(unit).GetFromEpoch(key, epoch)
+}
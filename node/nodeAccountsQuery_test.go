@@ -0,0 +1,214 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var accountsQueryAddress = make([]byte, 32)
+
+// createAccountsDBWithTwoStates commits a balance of 10 and then a balance of 15 for the same account,
+// returning the root hash of the first state
+func createAccountsDBWithTwoStates(t *testing.T) (*state.AccountsDB, []byte) {
+	marshalizer := &mock.MarshalizerFake{}
+	hasher := &mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	adb, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	account, _ := adb.LoadAccount(accountsQueryAddress)
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(account)
+	oldRootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	account, _ = adb.LoadAccount(accountsQueryAddress)
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(5))
+	_ = adb.SaveAccount(account)
+	_, err = adb.Commit()
+	require.Nil(t, err)
+
+	return adb, oldRootHash
+}
+
+func createNodeForAccountsQuery(accounts state.AccountsAdapter, headerNonce uint64, headerHash []byte, rootHash []byte) *node.Node {
+	uint64Converter := mock.NewNonceHashConverterMock()
+	marshalizer := &mock.MarshalizerFake{}
+	storerMock := mock.NewStorerMock()
+
+	header := &block.Header{
+		Nonce:    headerNonce,
+		RootHash: rootHash,
+	}
+	headerBytes, _ := marshalizer.Marshal(header)
+	_ = storerMock.Put(headerHash, headerBytes)
+	_ = storerMock.Put(uint64Converter.ToByteSlice(headerNonce), headerHash)
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, testSizeCheckDelta),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithUint64ByteSliceConverter(uint64Converter),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(_ dataRetriever.UnitType) storage.Storer {
+				return storerMock
+			},
+			GetCalled: func(_ dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return storerMock.Get(key)
+			},
+		}),
+	)
+
+	return n
+}
+
+func TestNode_GetBalanceAtBlockNonceShouldReadTheHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	adb, oldRootHash := createAccountsDBWithTwoStates(t)
+	n := createNodeForAccountsQuery(adb, 7, []byte("header hash"), oldRootHash)
+	address := hex.EncodeToString(accountsQueryAddress)
+
+	balance, err := n.GetBalance(address, api.AccountQueryOptions{BlockNonce: 7, HasBlockNonce: true})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), balance)
+
+	balance, err = n.GetBalance(address, api.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(15), balance)
+}
+
+func TestNode_GetAccountAtBlockHashShouldReadTheHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	adb, oldRootHash := createAccountsDBWithTwoStates(t)
+	headerHash := []byte("header hash")
+	n := createNodeForAccountsQuery(adb, 7, headerHash, oldRootHash)
+	address := hex.EncodeToString(accountsQueryAddress)
+
+	account, err := n.GetAccount(address, api.AccountQueryOptions{BlockHash: headerHash})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), account.GetBalance())
+
+	currentRootHash, _ := adb.RootHash()
+	assert.NotEqual(t, oldRootHash, currentRootHash)
+}
+
+func TestNode_GetKeyValuePairsAtBlockShouldReadTheHistoricalDataTrie(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := createAccountsDBWithTwoStates(t)
+
+	account, _ := adb.LoadAccount(accountsQueryAddress)
+	_ = account.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key"), []byte("old value"))
+	_ = adb.SaveAccount(account)
+	oldRootHash, _ := adb.Commit()
+
+	account, _ = adb.LoadAccount(accountsQueryAddress)
+	_ = account.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key"), []byte("new value"))
+	_ = adb.SaveAccount(account)
+	_, _ = adb.Commit()
+
+	n := createNodeForAccountsQuery(adb, 8, []byte("header hash"), oldRootHash)
+	address := hex.EncodeToString(accountsQueryAddress)
+
+	// the data trie leaves hold the value suffixed by the key and the account address
+	suffix := append([]byte("key"), accountsQueryAddress...)
+
+	pairs, err := n.GetKeyValuePairs(address, api.AccountQueryOptions{BlockNonce: 8, HasBlockNonce: true})
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(append([]byte("old value"), suffix...)), pairs[hex.EncodeToString([]byte("key"))])
+
+	pairs, err = n.GetKeyValuePairs(address, api.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(append([]byte("new value"), suffix...)), pairs[hex.EncodeToString([]byte("key"))])
+}
+
+func TestNode_GetBalanceAtMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, oldRootHash := createAccountsDBWithTwoStates(t)
+	n := createNodeForAccountsQuery(adb, 7, []byte("header hash"), oldRootHash)
+
+	balance, err := n.GetBalance(hex.EncodeToString(accountsQueryAddress), api.AccountQueryOptions{BlockNonce: 8, HasBlockNonce: true})
+	assert.NotNil(t, err)
+	assert.Nil(t, balance)
+}
+
+func TestNode_GetBalanceAtPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := createAccountsDBWithTwoStates(t)
+	n := createNodeForAccountsQuery(adb, 7, []byte("header hash"), []byte("pruned root hash"))
+
+	balance, err := n.GetBalance(hex.EncodeToString(accountsQueryAddress), api.AccountQueryOptions{BlockNonce: 7, HasBlockNonce: true})
+	assert.True(t, errors.Is(err, node.ErrHistoricalStateNotAvailable))
+	assert.Nil(t, balance)
+}
+
+func TestNode_GetBalanceAtBlockWithoutViewSupportShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForAccountsQuery(getAccAdapter(big.NewInt(100)), 7, []byte("header hash"), []byte("root hash"))
+
+	balance, err := n.GetBalance(hex.EncodeToString(accountsQueryAddress), api.AccountQueryOptions{BlockNonce: 7, HasBlockNonce: true})
+	assert.Equal(t, process.ErrHistoricalStateNotSupported, err)
+	assert.Nil(t, balance)
+}
+
+func TestNode_GetAccountWithCodeAtBlockShouldReadTheHistoricalCode(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := createAccountsDBWithTwoStates(t)
+
+	account, _ := adb.LoadAccount(accountsQueryAddress)
+	account.(state.UserAccountHandler).SetCode([]byte("old code"))
+	_ = adb.SaveAccount(account)
+	oldRootHash, _ := adb.Commit()
+
+	account, _ = adb.LoadAccount(accountsQueryAddress)
+	account.(state.UserAccountHandler).SetCode([]byte("new code"))
+	_ = adb.SaveAccount(account)
+	_, _ = adb.Commit()
+
+	n := createNodeForAccountsQuery(adb, 8, []byte("header hash"), oldRootHash)
+	address := hex.EncodeToString(accountsQueryAddress)
+
+	_, code, err := n.GetAccountWithCode(address, api.AccountQueryOptions{BlockNonce: 8, HasBlockNonce: true})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("old code"), code)
+
+	_, code, err = n.GetAccountWithCode(address, api.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new code"), code)
+}
+
+func TestNode_GetAccountWithCodeAtPrunedStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := createAccountsDBWithTwoStates(t)
+	n := createNodeForAccountsQuery(adb, 7, []byte("header hash"), []byte("pruned root hash"))
+
+	account, code, err := n.GetAccountWithCode(hex.EncodeToString(accountsQueryAddress), api.AccountQueryOptions{BlockNonce: 7, HasBlockNonce: true})
+	assert.True(t, errors.Is(err, node.ErrHistoricalStateNotAvailable))
+	assert.Nil(t, account)
+	assert.Nil(t, code)
+}
@@ -0,0 +1,162 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+const (
+	defaultAddressTransactionsPageSize = 100
+	maxAddressTransactionsPageSize     = 1000
+)
+
+// GetTransactionsForAddress returns a page of the transactions sent and/or received by the provided address, most
+// recent first, as recorded by the (optional) address transactions index of the db lookup extensions
+func (n *Node) GetTransactionsForAddress(query api.AddressTransactionsQuery) (*api.AddressTransactions, error) {
+	if check.IfNil(n.historyRepository) || !n.historyRepository.IsEnabled() {
+		return nil, ErrDBLookupExtensionsNotEnabled
+	}
+
+	address, err := n.addressPubkeyConverter.Decode(query.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHeader, err := n.getCurrentBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := computeAddressTransactionsPageSize(query.PageSize)
+	transactions, err := n.getAddressTransactionsPage(address, query, currentHeader.GetEpoch(), pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.AddressTransactions{
+		Address:      query.Address,
+		Direction:    query.Direction,
+		Status:       query.Status,
+		Page:         query.Page,
+		PageSize:     pageSize,
+		Transactions: transactions,
+	}, nil
+}
+
+// getAddressTransactionsPage walks the epochs backwards, starting with the current one, and stops as soon as the
+// page is filled or at the first epoch recorded by the index. The recorded transactions of an epoch are read in chunks, the most recent chunk first. Without
+// filters, the transactions to be skipped are not read at all. When filtering by status, each candidate transaction
+// has to be loaded in order to be counted.
+func (n *Node) getAddressTransactionsPage(
+	address []byte,
+	query api.AddressTransactionsQuery,
+	currentEpoch uint32,
+	pageSize uint32,
+) ([]*transaction.ApiTransactionResult, error) {
+	transactions := make([]*transaction.ApiTransactionResult, 0)
+	toSkip := uint64(query.Page) * uint64(pageSize)
+	isFiltered := query.Status != "" || query.Direction == api.AddressTransactionsSent || query.Direction == api.AddressTransactionsReceived
+
+	firstEpoch, err := n.historyRepository.GetAddressTransactionsFirstEpoch(currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for epoch := int64(currentEpoch); epoch >= int64(firstEpoch); epoch-- {
+		numTransactions, err := n.historyRepository.GetNumAddressTransactions(address, uint32(epoch))
+		if err != nil {
+			return nil, err
+		}
+
+		if !isFiltered {
+			skippedInEpoch := core.MinUint64(toSkip, numTransactions)
+			numTransactions -= skippedInEpoch
+			toSkip -= skippedInEpoch
+		}
+
+		for toIndex := numTransactions; toIndex > 0; {
+			fromIndex := toIndex - core.MinUint64(toIndex, uint64(pageSize))
+			record, errGet := n.historyRepository.GetAddressTransactions(address, uint32(epoch), fromIndex, toIndex)
+			if errGet != nil {
+				return nil, errGet
+			}
+
+			transactions, toSkip = n.appendAddressTransactions(transactions, record, query, toSkip, pageSize)
+			if len(transactions) == int(pageSize) {
+				return transactions, nil
+			}
+
+			toIndex = fromIndex
+		}
+	}
+
+	return transactions, nil
+}
+
+// appendAddressTransactions appends the matching transactions of the record, most recent first, until the page is
+// filled. It returns the number of transactions still to be skipped.
+func (n *Node) appendAddressTransactions(
+	transactions []*transaction.ApiTransactionResult,
+	record *dblookupext.AddressTransactions,
+	query api.AddressTransactionsQuery,
+	toSkip uint64,
+	pageSize uint32,
+) ([]*transaction.ApiTransactionResult, uint64) {
+	for i := len(record.Transactions) - 1; i >= 0; i-- {
+		entry := record.Transactions[i]
+		if !matchesAddressTransactionsDirection(entry.Direction, query.Direction) {
+			continue
+		}
+		if query.Status == "" && toSkip > 0 {
+			toSkip--
+			continue
+		}
+
+		tx, errLookup := n.lookupHistoricalTransaction(entry.TxHash, false)
+		if errLookup != nil {
+			log.Warn("GetTransactionsForAddress cannot get the transaction",
+				"txHash", hex.EncodeToString(entry.TxHash),
+				"error", errLookup.Error())
+			continue
+		}
+		if query.Status != "" && tx.Status.String() != query.Status {
+			continue
+		}
+		if toSkip > 0 {
+			toSkip--
+			continue
+		}
+
+		tx.Hash = hex.EncodeToString(entry.TxHash)
+		transactions = append(transactions, tx)
+		if len(transactions) == int(pageSize) {
+			break
+		}
+	}
+
+	return transactions, toSkip
+}
+
+func matchesAddressTransactionsDirection(direction uint32, filter string) bool {
+	switch filter {
+	case api.AddressTransactionsSent:
+		return direction&dblookupext.DirectionSent != 0
+	case api.AddressTransactionsReceived:
+		return direction&dblookupext.DirectionReceived != 0
+	default:
+		return true
+	}
+}
+
+func computeAddressTransactionsPageSize(pageSize uint32) uint32 {
+	if pageSize == 0 {
+		return defaultAddressTransactionsPageSize
+	}
+
+	return core.MinUint32(pageSize, maxAddressTransactionsPageSize)
+}
@@ -0,0 +1,200 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createNodeForAddressTransactions creates a node in shard 1, at epoch 2, where "alice" sent "t1" (cross-shard, still
+// pending) and received "t2" in epoch 2, then sent "t3" to herself in epoch 1
+func createNodeForAddressTransactions(t *testing.T) (*Node, string) {
+	n, chainStorer, _, historyRepo := createNode(t, 2, true)
+	n.blkc = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 20, Epoch: 2}
+		},
+	}
+
+	alice := []byte("alice")
+	metadataByTxHash := map[string]*dblookupext.MiniblockMetadata{
+		"t1": {Type: int32(block.TxBlock), SourceShardID: 1, DestinationShardID: 2, Epoch: 2, HeaderNonce: 18},
+		"t2": {Type: int32(block.TxBlock), SourceShardID: 2, DestinationShardID: 1, Epoch: 2, HeaderNonce: 19},
+		"t3": {Type: int32(block.TxBlock), SourceShardID: 1, DestinationShardID: 1, Epoch: 1, HeaderNonce: 7},
+	}
+	putTx := func(hash string, tx *transaction.Transaction) {
+		txBytes, _ := n.internalMarshalizer.Marshal(tx)
+		_ = chainStorer.Transactions.PutInEpoch([]byte(hash), txBytes, metadataByTxHash[hash].Epoch)
+	}
+	putTx("t1", &transaction.Transaction{Nonce: 1, SndAddr: alice, RcvAddr: []byte("bob")})
+	putTx("t2", &transaction.Transaction{Nonce: 5, SndAddr: []byte("bob"), RcvAddr: alice})
+	putTx("t3", &transaction.Transaction{Nonce: 0, SndAddr: alice, RcvAddr: alice})
+
+	historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+		metadata, ok := metadataByTxHash[string(hash)]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return metadata, nil
+	}
+	transactionsByEpoch := map[uint32][]*dblookupext.AddressTransaction{
+		2: {
+			{TxHash: []byte("t1"), BlockNonce: 18, Direction: dblookupext.DirectionSent},
+			{TxHash: []byte("t2"), BlockNonce: 19, Direction: dblookupext.DirectionReceived},
+		},
+		1: {
+			{TxHash: []byte("t3"), BlockNonce: 7, Direction: dblookupext.DirectionSent | dblookupext.DirectionReceived},
+		},
+	}
+	historyRepo.GetNumAddressTransactionsCalled = func(address []byte, epoch uint32) (uint64, error) {
+		require.Equal(t, alice, address)
+
+		return uint64(len(transactionsByEpoch[epoch])), nil
+	}
+	historyRepo.GetAddressTransactionsCalled = func(address []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.AddressTransactions, error) {
+		require.Equal(t, alice, address)
+		require.True(t, toIndex <= uint64(len(transactionsByEpoch[epoch])))
+
+		return &dblookupext.AddressTransactions{Transactions: transactionsByEpoch[epoch][fromIndex:toIndex]}, nil
+	}
+
+	return n, hex.EncodeToString(alice)
+}
+
+func getAddressTransactionsHashes(history *api.AddressTransactions) []string {
+	hashes := make([]string, 0, len(history.Transactions))
+	for _, tx := range history.Transactions {
+		txHash, _ := hex.DecodeString(tx.Hash)
+		hashes = append(hashes, string(txHash))
+	}
+
+	return hashes
+}
+
+func TestNode_GetTransactionsForAddressShouldReturnTheMostRecentFirst(t *testing.T) {
+	t.Parallel()
+
+	n, alice := createNodeForAddressTransactions(t)
+
+	history, err := n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(defaultAddressTransactionsPageSize), history.PageSize)
+	assert.Equal(t, []string{"t2", "t1", "t3"}, getAddressTransactionsHashes(history))
+	assert.Equal(t, transaction.TxStatusSuccess, history.Transactions[0].Status)
+	assert.Equal(t, transaction.TxStatusPending, history.Transactions[1].Status)
+	assert.Equal(t, uint32(1), history.Transactions[2].Epoch)
+
+	history, err = n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice, Page: 1, PageSize: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t3"}, getAddressTransactionsHashes(history))
+}
+
+func TestNode_GetTransactionsForAddressShouldNotReadTheSkippedTransactions(t *testing.T) {
+	t.Parallel()
+
+	n, alice := createNodeForAddressTransactions(t)
+	historyRepo := n.historyRepository.(*testscommon.HistoryRepositoryStub)
+	getAddressTransactions := historyRepo.GetAddressTransactionsCalled
+	readEpochs := make([]uint32, 0)
+	historyRepo.GetAddressTransactionsCalled = func(address []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.AddressTransactions, error) {
+		readEpochs = append(readEpochs, epoch)
+		return getAddressTransactions(address, epoch, fromIndex, toIndex)
+	}
+
+	history, err := n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice, Page: 2, PageSize: 1})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t3"}, getAddressTransactionsHashes(history))
+	assert.Equal(t, []uint32{1}, readEpochs)
+
+	readEpochs = readEpochs[:0]
+	history, err = n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice, PageSize: 1})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t2"}, getAddressTransactionsHashes(history))
+	assert.Equal(t, []uint32{2}, readEpochs)
+}
+
+func TestNode_GetTransactionsForAddressShouldStopAtTheFirstRecordedEpoch(t *testing.T) {
+	t.Parallel()
+
+	n, alice := createNodeForAddressTransactions(t)
+	historyRepo := n.historyRepository.(*testscommon.HistoryRepositoryStub)
+	historyRepo.GetAddressTransactionsFirstEpochCalled = func(epoch uint32) (uint32, error) {
+		require.Equal(t, uint32(2), epoch)
+		return 2, nil
+	}
+	getNumAddressTransactions := historyRepo.GetNumAddressTransactionsCalled
+	readEpochs := make([]uint32, 0)
+	historyRepo.GetNumAddressTransactionsCalled = func(address []byte, epoch uint32) (uint64, error) {
+		readEpochs = append(readEpochs, epoch)
+		return getNumAddressTransactions(address, epoch)
+	}
+
+	history, err := n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t2", "t1"}, getAddressTransactionsHashes(history))
+	assert.Equal(t, []uint32{2}, readEpochs)
+}
+
+func TestNode_GetTransactionsForAddressFilteredByDirection(t *testing.T) {
+	t.Parallel()
+
+	n, alice := createNodeForAddressTransactions(t)
+
+	history, err := n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice, Direction: api.AddressTransactionsSent})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t1", "t3"}, getAddressTransactionsHashes(history))
+
+	history, err = n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice, Direction: api.AddressTransactionsReceived})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t2", "t3"}, getAddressTransactionsHashes(history))
+}
+
+func TestNode_GetTransactionsForAddressFilteredByStatus(t *testing.T) {
+	t.Parallel()
+
+	n, alice := createNodeForAddressTransactions(t)
+	query := api.AddressTransactionsQuery{Address: alice, Status: string(transaction.TxStatusSuccess)}
+
+	history, err := n.GetTransactionsForAddress(query)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t2", "t3"}, getAddressTransactionsHashes(history))
+
+	query.Page, query.PageSize = 1, 1
+	history, err = n.GetTransactionsForAddress(query)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"t3"}, getAddressTransactionsHashes(history))
+}
+
+func TestNode_GetTransactionsForAddressWhenIndexNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, alice := createNodeForAddressTransactions(t)
+	historyRepo := n.historyRepository.(*testscommon.HistoryRepositoryStub)
+	historyRepo.GetNumAddressTransactionsCalled = func(_ []byte, _ uint32) (uint64, error) {
+		return 0, dblookupext.ErrAddressTransactionsIndexNotEnabled
+	}
+
+	history, err := n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: alice})
+	assert.Nil(t, history)
+	assert.Equal(t, dblookupext.ErrAddressTransactionsIndexNotEnabled, err)
+}
+
+func TestNode_GetTransactionsForAddressWithoutDBLookupExtensionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 0, false)
+
+	history, err := n.GetTransactionsForAddress(api.AddressTransactionsQuery{Address: hex.EncodeToString([]byte("alice"))})
+	assert.Nil(t, history)
+	assert.Equal(t, ErrDBLookupExtensionsNotEnabled, err)
+}
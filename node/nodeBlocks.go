@@ -2,8 +2,10 @@ package node
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
 )
@@ -26,6 +28,55 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+const (
+	maxBlocksInRange        = 100
+	maxBlocksInRangeWithTxs = 10
+)
+
+// GetBlocksByNonceRange returns the blocks having the nonces in the provided range, bounds included. The range ends
+// at most at the current block and cannot hold more than maxBlocksInRange blocks (maxBlocksInRangeWithTxs when the
+// transactions are requested)
+func (n *Node) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	if from > to {
+		return nil, ErrInvalidBlocksRange
+	}
+
+	maxBlocks := uint64(maxBlocksInRange)
+	if withTxs {
+		maxBlocks = maxBlocksInRangeWithTxs
+	}
+	// the range holds to-from+1 blocks, compared without the +1 so that it can not overflow
+	if to-from >= maxBlocks {
+		return nil, fmt.Errorf("%w: at most %d blocks can be requested", ErrBlocksRangeTooLarge, maxBlocks)
+	}
+
+	currentHeader, err := n.getCurrentBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	to = core.MinUint64(to, currentHeader.GetNonce())
+	if from > to {
+		return make([]*api.Block, 0), nil
+	}
+
+	apiBlockProcessor := n.createAPIBlockProcessor()
+
+	return apiBlockProcessor.GetBlocksByNonceRange(from, to, withTxs)
+}
+
+// GetLatestBlock returns the current block of the node
+func (n *Node) GetLatestBlock(withTxs bool) (*api.Block, error) {
+	currentHeaderHash := n.blkc.GetCurrentBlockHeaderHash()
+	if len(currentHeaderHash) == 0 || check.IfNil(n.blkc.GetCurrentBlockHeader()) {
+		return nil, ErrNilBlockHeader
+	}
+
+	apiBlockProcessor := n.createAPIBlockProcessor()
+
+	return apiBlockProcessor.GetBlockByHash(currentHeaderHash, withTxs)
+}
+
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 	blockApiArgs := &blockAPI.APIBlockProcessorArg{
 		SelfShardID:              n.shardCoordinator.SelfId(),
@@ -33,6 +84,7 @@ func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 		Marshalizer:              n.internalMarshalizer,
 		Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
 		HistoryRepo:              n.historyRepository,
+		NodesCoordinator:         n.nodesCoordinator,
 		UnmarshalTx:              n.unmarshalTransaction,
 	}
 
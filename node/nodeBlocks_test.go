@@ -3,10 +3,13 @@ package node_test
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"math"
 	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -378,3 +381,53 @@ func TestGetBlockByHashFromHistoryNode_StatusReverted(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestGetBlocksByNonceRange_InvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	blocks, err := n.GetBlocksByNonceRange(10, 9, false)
+	assert.Equal(t, node.ErrInvalidBlocksRange, err)
+	assert.Nil(t, blocks)
+
+	blocks, err = n.GetBlocksByNonceRange(1, 101, false)
+	assert.True(t, errors.Is(err, node.ErrBlocksRangeTooLarge))
+	assert.Nil(t, blocks)
+
+	blocks, err = n.GetBlocksByNonceRange(1, 11, true)
+	assert.True(t, errors.Is(err, node.ErrBlocksRangeTooLarge))
+	assert.Nil(t, blocks)
+
+	blocks, err = n.GetBlocksByNonceRange(0, math.MaxUint64, false)
+	assert.True(t, errors.Is(err, node.ErrBlocksRangeTooLarge))
+	assert.Nil(t, blocks)
+}
+
+func TestGetBlocksByNonceRange_RangeAfterTheCurrentBlockShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 5}
+			},
+		}),
+	)
+
+	blocks, err := n.GetBlocksByNonceRange(10, 20, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(blocks))
+}
+
+func TestGetLatestBlock_NoCurrentBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithBlockChain(&mock.BlockChainMock{}),
+	)
+
+	blk, err := n.GetLatestBlock(false)
+	assert.Equal(t, node.ErrNilBlockHeader, err)
+	assert.Nil(t, blk)
+}
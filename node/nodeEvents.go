@@ -0,0 +1,283 @@
+package node
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+const (
+	defaultEventsPageSize = 100
+	maxEventsPageSize     = 1000
+)
+
+type eventLocationInEpoch struct {
+	*dblookupext.EventLocation
+	epoch uint32
+}
+
+// eventLocationsRange holds the positions, in the [fromIndex, toIndex) range, of the locations of an epoch that fall
+// within the blocks range of a query
+type eventLocationsRange struct {
+	epoch     uint32
+	fromIndex uint64
+	toIndex   uint64
+}
+
+// GetEvents returns a page of the events emitted by the provided address, having the provided identifier, within the
+// requested blocks range. The events are sorted by block nonce and, within a block, by their order in the logs. The
+// first block of the range is mandatory, so that the scanned epochs are bounded by the query. Only the locations of
+// the requested page are read.
+func (n *Node) GetEvents(query api.EventsQuery) (*api.Events, error) {
+	if check.IfNil(n.historyRepository) || !n.historyRepository.IsEnabled() {
+		return nil, ErrDBLookupExtensionsNotEnabled
+	}
+
+	if !query.HasFromBlock {
+		return nil, ErrMissingEventsQueryFromBlock
+	}
+
+	address, err := n.addressPubkeyConverter.Decode(query.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	toBlock, toEpoch, err := n.getEventsQueryUpperBound(query.ToBlock, query.HasToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	fromBlock := query.FromBlock
+	if fromBlock > toBlock {
+		return nil, ErrInvalidEventsQueryRange
+	}
+
+	fromHeader, err := n.getHeaderByNonce(fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	fromEpoch := fromHeader.GetEpoch()
+
+	identifier := []byte(query.Identifier)
+	ranges, err := n.getEventLocationsRanges(address, identifier, fromEpoch, toEpoch, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	numEvents := uint64(0)
+	for _, locationsRange := range ranges {
+		numEvents += locationsRange.toIndex - locationsRange.fromIndex
+	}
+
+	pageSize := computeEventsPageSize(query.PageSize)
+	locations, err := n.getEventLocationsPage(address, identifier, ranges, uint64(query.Page)*uint64(pageSize), uint64(pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	events := &api.Events{
+		Address:    query.Address,
+		Identifier: query.Identifier,
+		FromBlock:  fromBlock,
+		ToBlock:    toBlock,
+		NumEvents:  uint32(numEvents),
+		Page:       query.Page,
+		PageSize:   pageSize,
+		Events:     make([]*api.Event, 0, len(locations)),
+	}
+
+	for _, location := range locations {
+		event, errGet := n.getEventAtLocation(location)
+		if errGet != nil {
+			log.Warn("GetEvents cannot get the event from storage",
+				"txHash", hex.EncodeToString(location.TxHash),
+				"error", errGet.Error())
+			continue
+		}
+
+		events.Events = append(events.Events, event)
+	}
+
+	return events, nil
+}
+
+func (n *Node) getEventsQueryUpperBound(toBlock uint64, hasToBlock bool) (uint64, uint32, error) {
+	if !hasToBlock {
+		currentHeader, err := n.getCurrentBlockHeader()
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return currentHeader.GetNonce(), currentHeader.GetEpoch(), nil
+	}
+
+	header, err := n.getHeaderByNonce(toBlock)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return toBlock, header.GetEpoch(), nil
+}
+
+// getEventLocationsRanges returns, for each epoch of the query, the positions of the locations within the blocks range.
+// Only the first and the last epochs can hold locations outside the range, their bounds being found by binary search.
+func (n *Node) getEventLocationsRanges(
+	address []byte,
+	identifier []byte,
+	fromEpoch uint32,
+	toEpoch uint32,
+	fromBlock uint64,
+	toBlock uint64,
+) ([]*eventLocationsRange, error) {
+	ranges := make([]*eventLocationsRange, 0)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		numLocations, err := n.historyRepository.GetNumEventLocations(address, identifier, epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		locationsRange := &eventLocationsRange{
+			epoch:     epoch,
+			fromIndex: 0,
+			toIndex:   numLocations,
+		}
+		if epoch == fromEpoch {
+			locationsRange.fromIndex, err = n.searchEventLocation(address, identifier, epoch, numLocations, func(location *dblookupext.EventLocation) bool {
+				return location.BlockNonce >= fromBlock
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if epoch == toEpoch {
+			locationsRange.toIndex, err = n.searchEventLocation(address, identifier, epoch, numLocations, func(location *dblookupext.EventLocation) bool {
+				return location.BlockNonce > toBlock
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if locationsRange.fromIndex < locationsRange.toIndex {
+			ranges = append(ranges, locationsRange)
+		}
+	}
+
+	return ranges, nil
+}
+
+// searchEventLocation returns the position of the first location of the epoch satisfying the predicate, the locations
+// of an epoch being sorted by block nonce. Each probe reads a single location.
+func (n *Node) searchEventLocation(
+	address []byte,
+	identifier []byte,
+	epoch uint32,
+	numLocations uint64,
+	predicate func(location *dblookupext.EventLocation) bool,
+) (uint64, error) {
+	var searchErr error
+	index := sort.Search(int(numLocations), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+
+		locations, err := n.historyRepository.GetEventLocations(address, identifier, epoch, uint64(i), uint64(i)+1)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		if len(locations.Locations) == 0 {
+			searchErr = ErrEventNotFound
+			return true
+		}
+
+		return predicate(locations.Locations[0])
+	})
+
+	return uint64(index), searchErr
+}
+
+// getEventLocationsPage skips the requested number of locations without reading them, then reads the locations of
+// the page, stopping as soon as the page is filled
+func (n *Node) getEventLocationsPage(
+	address []byte,
+	identifier []byte,
+	ranges []*eventLocationsRange,
+	toSkip uint64,
+	pageSize uint64,
+) ([]*eventLocationInEpoch, error) {
+	locations := make([]*eventLocationInEpoch, 0)
+	for _, locationsRange := range ranges {
+		if uint64(len(locations)) == pageSize {
+			break
+		}
+
+		numLocations := locationsRange.toIndex - locationsRange.fromIndex
+		if toSkip >= numLocations {
+			toSkip -= numLocations
+			continue
+		}
+
+		fromIndex := locationsRange.fromIndex + toSkip
+		toIndex := core.MinUint64(locationsRange.toIndex, fromIndex+pageSize-uint64(len(locations)))
+		toSkip = 0
+
+		epochLocations, err := n.historyRepository.GetEventLocations(address, identifier, locationsRange.epoch, fromIndex, toIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, location := range epochLocations.Locations {
+			locations = append(locations, &eventLocationInEpoch{
+				EventLocation: location,
+				epoch:         locationsRange.epoch,
+			})
+		}
+	}
+
+	return locations, nil
+}
+
+func (n *Node) getEventAtLocation(location *eventLocationInEpoch) (*api.Event, error) {
+	logBytes, err := n.store.GetStorer(dataRetriever.TxLogsUnit).GetFromEpoch(location.TxHash, location.epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	txLog := &transaction.Log{}
+	err = n.internalMarshalizer.Unmarshal(txLog, logBytes)
+	if err != nil {
+		return nil, err
+	}
+	if int(location.EventIndex) >= len(txLog.Events) {
+		return nil, ErrEventNotFound
+	}
+
+	event := txLog.Events[location.EventIndex]
+
+	return &api.Event{
+		Address:    n.addressPubkeyConverter.Encode(event.Address),
+		Identifier: string(event.Identifier),
+		Topics:     event.Topics,
+		Data:       event.Data,
+		TxHash:     hex.EncodeToString(location.TxHash),
+		Index:      location.EventIndex,
+		BlockNonce: location.BlockNonce,
+		BlockHash:  hex.EncodeToString(location.BlockHash),
+		Epoch:      location.epoch,
+	}, nil
+}
+
+func computeEventsPageSize(pageSize uint32) uint32 {
+	if pageSize == 0 {
+		return defaultEventsPageSize
+	}
+
+	return core.MinUint32(pageSize, maxEventsPageSize)
+}
@@ -0,0 +1,300 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var eventsEmitter = make([]byte, 32)
+
+// createNodeForEvents creates a node having the blocks 5 (epoch 1), 12 and 15 (epoch 2, current block) and the
+// following indexed events: epoch 1 - txA (block 5, two events), epoch 2 - txB (block 12) and txC (block 16, not yet
+// final, thus outside the default range)
+func createNodeForEvents(historyRepositoryEnabled bool) (*node.Node, *testscommon.HistoryRepositoryStub) {
+	marshalizer := &mock.MarshalizerFake{}
+	uint64Converter := mock.NewNonceHashConverterMock()
+	headersStorer := genericMocks.NewStorerMock("BlockHeaders", 2)
+	logsStorer := genericMocks.NewStorerMock("TxLogs", 2)
+	nonceToHash := make(map[string][]byte)
+	epochByHash := make(map[string]uint32)
+
+	var currentHeader data.HeaderHandler
+	for _, header := range []*block.Header{{Nonce: 5, Epoch: 1}, {Nonce: 12, Epoch: 2}, {Nonce: 15, Epoch: 2}} {
+		headerHash := []byte(hex.EncodeToString(uint64Converter.ToByteSlice(header.Nonce)))
+		headerBytes, _ := marshalizer.Marshal(header)
+		_ = headersStorer.PutInEpoch(headerHash, headerBytes, header.Epoch)
+		nonceToHash[string(uint64Converter.ToByteSlice(header.Nonce))] = headerHash
+		epochByHash[string(headerHash)] = header.Epoch
+		currentHeader = header
+	}
+
+	txLog := &transaction.Log{
+		Address: eventsEmitter,
+		Events: []*transaction.Event{
+			{Address: eventsEmitter, Identifier: []byte("deposit"), Topics: [][]byte{[]byte("first")}},
+			{Address: eventsEmitter, Identifier: []byte("deposit"), Topics: [][]byte{[]byte("second")}},
+		},
+	}
+	logBytes, _ := marshalizer.Marshal(txLog)
+	_ = logsStorer.PutInEpoch([]byte("txA"), logBytes, 1)
+	_ = logsStorer.PutInEpoch([]byte("txB"), logBytes, 2)
+	_ = logsStorer.PutInEpoch([]byte("txC"), logBytes, 2)
+
+	locationsByEpoch := map[uint32]*dblookupext.EventLocations{
+		1: {Locations: []*dblookupext.EventLocation{
+			{TxHash: []byte("txA"), BlockNonce: 5, BlockHash: []byte("h5"), EventIndex: 0},
+			{TxHash: []byte("txA"), BlockNonce: 5, BlockHash: []byte("h5"), EventIndex: 1},
+		}},
+		2: {Locations: []*dblookupext.EventLocation{
+			{TxHash: []byte("txB"), BlockNonce: 12, BlockHash: []byte("h12"), EventIndex: 1},
+			{TxHash: []byte("txC"), BlockNonce: 16, BlockHash: []byte("h16"), EventIndex: 0},
+		}},
+	}
+
+	historyRepository := &testscommon.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return historyRepositoryEnabled
+		},
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			return epochByHash[string(hash)], nil
+		},
+		GetNumEventLocationsCalled: func(address []byte, identifier []byte, epoch uint32) (uint64, error) {
+			locations, ok := locationsByEpoch[epoch]
+			if !ok || string(identifier) != "deposit" {
+				return 0, nil
+			}
+			return uint64(len(locations.Locations)), nil
+		},
+		GetEventLocationsCalled: func(address []byte, identifier []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.EventLocations, error) {
+			return &dblookupext.EventLocations{Locations: locationsByEpoch[epoch].Locations[fromIndex:toIndex]}, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, testSizeCheckDelta),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithUint64ByteSliceConverter(uint64Converter),
+		node.WithHistoryRepository(historyRepository),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+		}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				if unitType == dataRetriever.TxLogsUnit {
+					return logsStorer
+				}
+				return headersStorer
+			},
+			GetCalled: func(_ dataRetriever.UnitType, key []byte) ([]byte, error) {
+				headerHash, ok := nonceToHash[string(key)]
+				if !ok {
+					return nil, storage.ErrKeyNotFound
+				}
+				return headerHash, nil
+			},
+		}),
+	)
+
+	return n, historyRepository
+}
+
+func TestNode_GetEventsMissingFromBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(true)
+
+	events, err := n.GetEvents(api.EventsQuery{Address: hex.EncodeToString(eventsEmitter), Identifier: "deposit", ToBlock: 12, HasToBlock: true})
+	assert.Nil(t, events)
+	assert.Equal(t, node.ErrMissingEventsQueryFromBlock, err)
+}
+
+func TestNode_GetEventsDefaultUpperBoundShouldBeTheCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(true)
+	address := hex.EncodeToString(eventsEmitter)
+
+	events, err := n.GetEvents(api.EventsQuery{Address: address, Identifier: "deposit", FromBlock: 12, HasFromBlock: true})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(12), events.FromBlock)
+	assert.Equal(t, uint64(15), events.ToBlock)
+	assert.Equal(t, uint32(1), events.NumEvents)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, &api.Event{
+		Address:    address,
+		Identifier: "deposit",
+		Topics:     [][]byte{[]byte("second")},
+		TxHash:     hex.EncodeToString([]byte("txB")),
+		Index:      1,
+		BlockNonce: 12,
+		BlockHash:  hex.EncodeToString([]byte("h12")),
+		Epoch:      2,
+	}, events.Events[0])
+}
+
+func TestNode_GetEventsExplicitZeroUpperBoundShouldNotBeTheCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(true)
+	query := api.EventsQuery{
+		Address:      hex.EncodeToString(eventsEmitter),
+		Identifier:   "deposit",
+		HasFromBlock: true,
+		HasToBlock:   true,
+	}
+
+	// there is no block 0 in the storage
+	events, err := n.GetEvents(query)
+	assert.Nil(t, events)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetEventsWithBlocksRangeShouldSortAndPaginate(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(true)
+	query := api.EventsQuery{
+		Address:      hex.EncodeToString(eventsEmitter),
+		Identifier:   "deposit",
+		FromBlock:    5,
+		HasFromBlock: true,
+		ToBlock:      12,
+		HasToBlock:   true,
+		PageSize:     2,
+	}
+
+	events, err := n.GetEvents(query)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(3), events.NumEvents)
+	require.Len(t, events.Events, 2)
+	assert.Equal(t, [][]byte{[]byte("first")}, events.Events[0].Topics)
+	assert.Equal(t, uint64(5), events.Events[0].BlockNonce)
+	assert.Equal(t, uint32(1), events.Events[0].Epoch)
+	assert.Equal(t, [][]byte{[]byte("second")}, events.Events[1].Topics)
+	assert.Equal(t, uint64(5), events.Events[1].BlockNonce)
+
+	query.Page = 1
+	events, err = n.GetEvents(query)
+	require.Nil(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, uint64(12), events.Events[0].BlockNonce)
+
+	query.Page = 2
+	events, err = n.GetEvents(query)
+	require.Nil(t, err)
+	assert.Len(t, events.Events, 0)
+}
+
+func TestNode_GetEventsShouldNotReadTheSkippedLocations(t *testing.T) {
+	t.Parallel()
+
+	type readRange struct {
+		epoch     uint32
+		fromIndex uint64
+		toIndex   uint64
+	}
+
+	n, historyRepository := createNodeForEvents(true)
+	getEventLocations := historyRepository.GetEventLocationsCalled
+	reads := make([]readRange, 0)
+	historyRepository.GetEventLocationsCalled = func(address []byte, identifier []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.EventLocations, error) {
+		reads = append(reads, readRange{epoch: epoch, fromIndex: fromIndex, toIndex: toIndex})
+		return getEventLocations(address, identifier, epoch, fromIndex, toIndex)
+	}
+	query := api.EventsQuery{
+		Address:      hex.EncodeToString(eventsEmitter),
+		Identifier:   "deposit",
+		FromBlock:    5,
+		HasFromBlock: true,
+		ToBlock:      12,
+		HasToBlock:   true,
+		PageSize:     2,
+	}
+
+	events, err := n.GetEvents(query)
+	require.Nil(t, err)
+	require.Len(t, events.Events, 2)
+	// the page is filled with the locations of the first epoch, the other reads being single location probes
+	assert.Equal(t, readRange{epoch: 1, fromIndex: 0, toIndex: 2}, reads[len(reads)-1])
+
+	reads = reads[:0]
+	query.Page = 1
+	events, err = n.GetEvents(query)
+	require.Nil(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, uint64(12), events.Events[0].BlockNonce)
+	assert.NotContains(t, reads, readRange{epoch: 1, fromIndex: 0, toIndex: 2})
+	assert.Equal(t, readRange{epoch: 2, fromIndex: 0, toIndex: 1}, reads[len(reads)-1])
+}
+
+func TestNode_GetEventsUnknownIdentifierShouldReturnNoEvents(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(true)
+
+	events, err := n.GetEvents(api.EventsQuery{Address: hex.EncodeToString(eventsEmitter), Identifier: "withdraw", FromBlock: 5, HasFromBlock: true})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(0), events.NumEvents)
+	assert.Len(t, events.Events, 0)
+}
+
+func TestNode_GetEventsInvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(true)
+	query := api.EventsQuery{
+		Address:      hex.EncodeToString(eventsEmitter),
+		Identifier:   "deposit",
+		FromBlock:    12,
+		HasFromBlock: true,
+		ToBlock:      5,
+		HasToBlock:   true,
+	}
+
+	events, err := n.GetEvents(query)
+	assert.Nil(t, events)
+	assert.Equal(t, node.ErrInvalidEventsQueryRange, err)
+}
+
+func TestNode_GetEventsMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(true)
+	query := api.EventsQuery{
+		Address:      hex.EncodeToString(eventsEmitter),
+		Identifier:   "deposit",
+		FromBlock:    6,
+		HasFromBlock: true,
+	}
+
+	events, err := n.GetEvents(query)
+	assert.Nil(t, events)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetEventsWithoutDBLookupExtensionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForEvents(false)
+
+	events, err := n.GetEvents(api.EventsQuery{Address: hex.EncodeToString(eventsEmitter), Identifier: "deposit", FromBlock: 5, HasFromBlock: true})
+	assert.Nil(t, events)
+	assert.Equal(t, node.ErrDBLookupExtensionsNotEnabled, err)
+}
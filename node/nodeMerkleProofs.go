@@ -0,0 +1,110 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetAccountProof returns the Merkle proof of the given account in the accounts trie having the root hash of the
+// block requested through the query options, or of the current block if no block is requested
+func (n *Node) GetAccountProof(address string, options api.AccountQueryOptions) (*api.AccountProof, error) {
+	proofProvider, addressBytes, err := n.prepareMerkleProofQuery(address)
+	if err != nil {
+		return nil, err
+	}
+
+	header, headerHash, err := n.getBlockHeaderForQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, err := proofProvider.GetAccountProof(header.GetRootHash(), addressBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrCannotComputeMerkleProof, err.Error())
+	}
+
+	return createAPIAccountProof(address, header, headerHash, accountProof), nil
+}
+
+// GetAccountKeyProof returns the Merkle proof of the given account in the accounts trie having the root hash of the
+// block requested through the query options, together with the Merkle proof of the given key in the account data trie
+func (n *Node) GetAccountKeyProof(address string, key string, options api.AccountQueryOptions) (*api.AccountKeyProof, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	proofProvider, addressBytes, err := n.prepareMerkleProofQuery(address)
+	if err != nil {
+		return nil, err
+	}
+
+	header, headerHash, err := n.getBlockHeaderForQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, dataTrieProof, err := proofProvider.GetDataTrieProof(header.GetRootHash(), addressBytes, keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrCannotComputeMerkleProof, err.Error())
+	}
+
+	return &api.AccountKeyProof{
+		AccountProof: *createAPIAccountProof(address, header, headerHash, accountProof),
+		DataTrie:     createAPIMerkleProof(dataTrieProof),
+	}, nil
+}
+
+func (n *Node) prepareMerkleProofQuery(address string) (state.MerkleProofProvider, []byte, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.accounts) {
+		return nil, nil, ErrNilAccountsAdapter
+	}
+
+	proofProvider, ok := n.accounts.(state.MerkleProofProvider)
+	if !ok {
+		return nil, nil, ErrMerkleProofsNotSupported
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proofProvider, addressBytes, nil
+}
+
+func createAPIAccountProof(
+	address string,
+	header data.HeaderHandler,
+	headerHash []byte,
+	accountProof *state.MerkleProof,
+) *api.AccountProof {
+	return &api.AccountProof{
+		Address:    address,
+		BlockNonce: header.GetNonce(),
+		BlockHash:  hex.EncodeToString(headerHash),
+		Account:    createAPIMerkleProof(accountProof),
+	}
+}
+
+func createAPIMerkleProof(proof *state.MerkleProof) *api.MerkleProof {
+	apiProof := &api.MerkleProof{
+		RootHash: hex.EncodeToString(proof.RootHash),
+		Key:      hex.EncodeToString(proof.Key),
+		Value:    hex.EncodeToString(proof.Value),
+		Proof:    make([]string, 0, len(proof.Proof)),
+	}
+	for _, encodedNode := range proof.Proof {
+		apiProof.Proof = append(apiProof.Proof, hex.EncodeToString(encodedNode))
+	}
+
+	return apiProof
+}
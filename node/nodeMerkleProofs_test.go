@@ -0,0 +1,122 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeProof(t *testing.T, proof []string) [][]byte {
+	decodedProof := make([][]byte, 0, len(proof))
+	for _, encodedNode := range proof {
+		decodedNode, err := hex.DecodeString(encodedNode)
+		require.Nil(t, err)
+		decodedProof = append(decodedProof, decodedNode)
+	}
+
+	return decodedProof
+}
+
+func TestNode_GetAccountProofNotSupportedAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTrieStatistics(&mock.AccountsStub{}, &block.Header{})
+	address := createMockPubkeyConverter().Encode(accountsQueryAddress)
+
+	proof, err := n.GetAccountProof(address, api.AccountQueryOptions{})
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrMerkleProofsNotSupported, err)
+
+	keyProof, err := n.GetAccountKeyProof(address, "aa", api.AccountQueryOptions{})
+	assert.Nil(t, keyProof)
+	assert.Equal(t, node.ErrMerkleProofsNotSupported, err)
+}
+
+func TestNode_GetAccountProofAtCurrentBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := createAccountsDBWithTwoStates(t)
+	rootHash, _ := adb.RootHash()
+	n := createNodeForTrieStatistics(adb, &block.Header{Nonce: 3, RootHash: rootHash})
+	address := createMockPubkeyConverter().Encode(accountsQueryAddress)
+
+	proof, err := n.GetAccountProof(address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), proof.BlockNonce)
+	assert.Equal(t, hex.EncodeToString(rootHash), proof.Account.RootHash)
+
+	verifier, _ := trie.NewTrie(createTrieStorageManager(), &mock.MarshalizerFake{}, &mock.HasherMock{}, 5)
+	ok, err := verifier.VerifyProof(rootHash, accountsQueryAddress, decodeProof(t, proof.Account.Proof))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestNode_GetAccountProofAtOlderBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	adb, oldRootHash := createAccountsDBWithTwoStates(t)
+	headerHash := []byte("header hash")
+	n := createNodeForAccountsQuery(adb, 2, headerHash, oldRootHash)
+	address := createMockPubkeyConverter().Encode(accountsQueryAddress)
+
+	proof, err := n.GetAccountProof(address, api.AccountQueryOptions{BlockNonce: 2, HasBlockNonce: true})
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(headerHash), proof.BlockHash)
+	assert.Equal(t, hex.EncodeToString(oldRootHash), proof.Account.RootHash)
+
+	verifier, _ := trie.NewTrie(createTrieStorageManager(), &mock.MarshalizerFake{}, &mock.HasherMock{}, 5)
+	ok, err := verifier.VerifyProof(oldRootHash, accountsQueryAddress, decodeProof(t, proof.Account.Proof))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestNode_GetAccountKeyProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	hasher := &mock.HasherMock{}
+	tr, _ := trie.NewTrie(createTrieStorageManager(), marshalizer, hasher, 5)
+	adb, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	account, _ := adb.LoadAccount(accountsQueryAddress)
+	_ = account.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = adb.SaveAccount(account)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	n := createNodeForTrieStatistics(adb, &block.Header{Nonce: 7, RootHash: rootHash})
+	address := createMockPubkeyConverter().Encode(accountsQueryAddress)
+
+	proof, err := n.GetAccountKeyProof(address, hex.EncodeToString([]byte("key")), api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("key")), proof.DataTrie.Key)
+
+	dataTrieRootHash, _ := hex.DecodeString(proof.DataTrie.RootHash)
+	ok, err := tr.VerifyProof(dataTrieRootHash, []byte("key"), decodeProof(t, proof.DataTrie.Proof))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	_, err = n.GetAccountKeyProof(address, hex.EncodeToString([]byte("missing")), api.AccountQueryOptions{})
+	assert.True(t, errors.Is(err, node.ErrCannotComputeMerkleProof))
+
+	_, err = n.GetAccountKeyProof(address, "not hex", api.AccountQueryOptions{})
+	assert.NotNil(t, err)
+}
+
+func createTrieStorageManager() data.StorageManager {
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	return storageManager
+}
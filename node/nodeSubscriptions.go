@@ -0,0 +1,30 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// RegisterHeadersHandlers registers the handlers of the new headers of the node's shard and of the finalized ones:
+// the shard headers notarized by the metachain or, on the metachain, the final metachain headers
+func (n *Node) RegisterHeadersHandlers(
+	newHeadersHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte),
+	finalizedHeadersHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte),
+) error {
+	if check.IfNil(n.blockTracker) {
+		return ErrNilBlockTracker
+	}
+	if check.IfNil(n.shardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+
+	n.blockTracker.RegisterSelfNotarizedHeadersHandler(newHeadersHandler)
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		n.blockTracker.RegisterFinalMetachainHeadersHandler(finalizedHeadersHandler)
+		return nil
+	}
+
+	n.blockTracker.RegisterSelfNotarizedFromCrossHeadersHandler(finalizedHeadersHandler)
+	return nil
+}
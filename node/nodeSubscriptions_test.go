@@ -0,0 +1,81 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_RegisterHeadersHandlersNilBlockTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	err := n.RegisterHeadersHandlers(
+		func(_ uint32, _ []data.HeaderHandler, _ [][]byte) {},
+		func(_ uint32, _ []data.HeaderHandler, _ [][]byte) {},
+	)
+	assert.Equal(t, node.ErrNilBlockTracker, err)
+}
+
+func TestNode_RegisterHeadersHandlersOnShardShouldRegisterTheSelfNotarizedFromCrossHandler(t *testing.T) {
+	t.Parallel()
+
+	newHeadersRegistered, finalizedRegistered, finalMetaRegistered := false, false, false
+	blockTracker := &mock.BlockTrackerStub{
+		RegisterSelfNotarizedHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			newHeadersRegistered = true
+		},
+		RegisterSelfNotarizedFromCrossHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			finalizedRegistered = true
+		},
+		RegisterFinalMetachainHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			finalMetaRegistered = true
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithBlockTracker(blockTracker),
+	)
+
+	err := n.RegisterHeadersHandlers(
+		func(_ uint32, _ []data.HeaderHandler, _ [][]byte) {},
+		func(_ uint32, _ []data.HeaderHandler, _ [][]byte) {},
+	)
+	assert.Nil(t, err)
+	assert.True(t, newHeadersRegistered)
+	assert.True(t, finalizedRegistered)
+	assert.False(t, finalMetaRegistered)
+}
+
+func TestNode_RegisterHeadersHandlersOnMetachainShouldRegisterTheFinalMetachainHandler(t *testing.T) {
+	t.Parallel()
+
+	finalizedRegistered, finalMetaRegistered := false, false
+	blockTracker := &mock.BlockTrackerStub{
+		RegisterSelfNotarizedFromCrossHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			finalizedRegistered = true
+		},
+		RegisterFinalMetachainHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			finalMetaRegistered = true
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId, NumOfShards: 1}),
+		node.WithBlockTracker(blockTracker),
+	)
+
+	err := n.RegisterHeadersHandlers(
+		func(_ uint32, _ []data.HeaderHandler, _ [][]byte) {},
+		func(_ uint32, _ []data.HeaderHandler, _ [][]byte) {},
+	)
+	assert.Nil(t, err)
+	assert.False(t, finalizedRegistered)
+	assert.True(t, finalMetaRegistered)
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	rewardTxData "github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 )
 
 // GetTransaction gets the transaction based on the given hash. It will search in the cache and the storage and
@@ -78,6 +82,7 @@ func (n *Node) lookupHistoricalTransaction(hash []byte, withResults bool) (*tran
 	}
 
 	putMiniblockFieldsInTransaction(tx, miniblockMetadata)
+	n.putFinalityFieldsInTransaction(tx)
 
 	if ok := (&transaction.StatusComputer{
 		SelfShard:                n.shardCoordinator.SelfId(),
@@ -125,6 +130,54 @@ func putMiniblockFieldsInTransaction(tx *transaction.ApiTransactionResult, minib
 	return tx
 }
 
+// putFinalityFieldsInTransaction sets the hyperblock of the transaction - the metachain block notarizing it at
+// destination, which completes both intra and cross shard transactions - and the number of final metachain blocks
+// starting with the hyperblock, as known by the block tracker. A transaction is final once its hyperblock is final.
+func (n *Node) putFinalityFieldsInTransaction(tx *transaction.ApiTransactionResult) {
+	if tx.NotarizedAtDestinationInMetaNonce == 0 {
+		return
+	}
+
+	tx.HyperblockNonce = tx.NotarizedAtDestinationInMetaNonce
+	tx.HyperblockHash = tx.NotarizedAtDestinationInMetaHash
+
+	lastFinalMetaHeader, err := n.getLastFinalMetachainHeader()
+	if err != nil {
+		log.Debug("putFinalityFieldsInTransaction(): cannot get the last final metachain header", "error", err.Error())
+		return
+	}
+	if lastFinalMetaHeader.GetNonce() < tx.HyperblockNonce {
+		return
+	}
+
+	tx.Confirmations = lastFinalMetaHeader.GetNonce() - tx.HyperblockNonce + 1
+	tx.IsFinal = true
+}
+
+// getLastFinalMetachainHeader returns the last metachain header notarized by the node's shard or, on the metachain,
+// the last final metachain header
+func (n *Node) getLastFinalMetachainHeader() (data.HeaderHandler, error) {
+	if check.IfNil(n.blockTracker) {
+		return nil, ErrNilBlockTracker
+	}
+
+	var header data.HeaderHandler
+	var err error
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		header, _, err = n.blockTracker.GetLastSelfNotarizedHeader(core.MetachainShardId)
+	} else {
+		header, _, err = n.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(header) {
+		return nil, ErrNilBlockHeader
+	}
+
+	return header, nil
+}
+
 func (n *Node) getTransactionFromStorage(hash []byte) (*transaction.ApiTransactionResult, error) {
 	txBytes, txType, found := n.getTxBytesFromStorage(hash)
 	if !found {
@@ -288,6 +341,7 @@ func (n *Node) prepareNormalTx(tx *transaction.Transaction) (*transaction.ApiTra
 		GasLimit:         tx.GasLimit,
 		Data:             tx.Data,
 		Signature:        hex.EncodeToString(tx.Signature),
+		InnerTransaction: n.prepareRelayedTxV2InnerTx(tx),
 	}, nil
 }
 
@@ -305,9 +359,38 @@ func (n *Node) prepareInvalidTx(tx *transaction.Transaction) (*transaction.ApiTr
 		GasLimit:         tx.GasLimit,
 		Data:             tx.Data,
 		Signature:        hex.EncodeToString(tx.Signature),
+		InnerTransaction: n.prepareRelayedTxV2InnerTx(tx),
 	}, nil
 }
 
+// prepareRelayedTxV2InnerTx returns the user transaction carried by a relayed transaction v2 or nil for any other
+// transaction. The gas limit of the user transaction is the gas left after the relayer paid the move balance cost
+func (n *Node) prepareRelayedTxV2InnerTx(tx *transaction.Transaction) *transaction.ApiInnerTransaction {
+	funcName, args, err := parsers.NewCallArgsParser().ParseData(string(tx.Data))
+	if err != nil || funcName != core.RelayedTransactionV2 {
+		return nil
+	}
+	userTx, err := procTx.CreateRelayedTxV2UserTx(tx, args)
+	if err != nil || len(userTx.RcvAddr) != n.addressPubkeyConverter.Len() {
+		return nil
+	}
+
+	if !check.IfNil(n.feeHandler) {
+		userTx.GasLimit, _ = core.SafeSubUint64(tx.GasLimit, n.feeHandler.ComputeGasLimit(tx))
+	}
+
+	return &transaction.ApiInnerTransaction{
+		Nonce:     userTx.Nonce,
+		Value:     userTx.Value.String(),
+		Receiver:  n.addressPubkeyConverter.Encode(userTx.RcvAddr),
+		Sender:    n.addressPubkeyConverter.Encode(userTx.SndAddr),
+		GasPrice:  userTx.GasPrice,
+		GasLimit:  userTx.GasLimit,
+		Data:      userTx.Data,
+		Signature: hex.EncodeToString(userTx.Signature),
+	}
+}
+
 func (n *Node) prepareRewardTx(tx *rewardTxData.RewardTx) (*transaction.ApiTransactionResult, error) {
 	return &transaction.ApiTransactionResult{
 		Tx:          tx,
@@ -0,0 +1,433 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+const (
+	defaultTransactionsPoolPageSize = 100
+	maxTransactionsPoolPageSize     = 1000
+)
+
+type poolTransactionEntry struct {
+	hash []byte
+	tx   data.TransactionHandler
+}
+
+// GetTransactionsPoolForSender returns a page of the pending transactions of the provided sender, sorted by nonce.
+// If the sender belongs to the current shard, the nonce gaps are computed with respect to the account's nonce as well.
+func (n *Node) GetTransactionsPoolForSender(sender string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+	err := n.checkTransactionsPoolDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	senderBytes, err := n.addressPubkeyConverter.Decode(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	senderShardID := n.shardCoordinator.ComputeId(senderBytes)
+	cacheID := process.ShardCacherIdentifier(senderShardID, n.shardCoordinator.SelfId())
+	entries := n.getTransactionsPoolEntries(cacheID, func(tx data.TransactionHandler) bool {
+		return bytes.Equal(tx.GetSndAddr(), senderBytes)
+	})
+
+	accountsNonces := make(map[string]uint64)
+	accountNonce, ok := n.getAccountNonceForTransactionsPool(senderBytes, senderShardID)
+	if ok {
+		accountsNonces[string(senderBytes)] = accountNonce
+	}
+
+	txsPool := n.createTransactionsPoolPage(entries, accountsNonces, page, pageSize)
+	txsPool.CacheID = cacheID
+	txsPool.Sender = sender
+
+	return txsPool, nil
+}
+
+// GetTransactionsPoolForCache returns a page of the pending transactions held in the provided cache
+// (identified by the source and destination shards), sorted by sender and nonce
+func (n *Node) GetTransactionsPoolForCache(cacheID string, page uint32, pageSize uint32) (*api.TransactionsPool, error) {
+	err := n.checkTransactionsPoolDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	sourceShardID, destinationShardID, err := n.parseTransactionsPoolCacheID(cacheID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the pool holds all the transactions having the current shard as source in a single cache (union),
+	// so the ones destined to other shards than the requested one have to be filtered out
+	isCacheUnion := sourceShardID == n.shardCoordinator.SelfId()
+	entries := n.getTransactionsPoolEntries(cacheID, func(tx data.TransactionHandler) bool {
+		if !isCacheUnion {
+			return true
+		}
+
+		return n.shardCoordinator.ComputeId(tx.GetRcvAddr()) == destinationShardID
+	})
+
+	txsPool := n.createTransactionsPoolPage(entries, make(map[string]uint64), page, pageSize)
+	txsPool.CacheID = cacheID
+
+	return txsPool, nil
+}
+
+func (n *Node) checkTransactionsPoolDependencies() error {
+	if check.IfNil(n.dataPool) {
+		return ErrNilDataPool
+	}
+	if check.IfNil(n.shardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(n.addressPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+
+	return nil
+}
+
+func (n *Node) parseTransactionsPoolCacheID(cacheID string) (uint32, uint32, error) {
+	sourceShardID, destinationShardID, err := process.ParseShardCacherIdentifier(cacheID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	isSourceValid := n.isShardIDValidForTransactionsPool(sourceShardID)
+	isDestinationValid := n.isShardIDValidForTransactionsPool(destinationShardID)
+	if !isSourceValid || !isDestinationValid {
+		return 0, 0, ErrInvalidTransactionsPoolCacheID
+	}
+
+	selfShardID := n.shardCoordinator.SelfId()
+	isCacheHeldBySelf := sourceShardID == selfShardID || destinationShardID == selfShardID
+	if !isCacheHeldBySelf {
+		return 0, 0, ErrInvalidTransactionsPoolCacheID
+	}
+
+	return sourceShardID, destinationShardID, nil
+}
+
+func (n *Node) isShardIDValidForTransactionsPool(shardID uint32) bool {
+	return shardID < n.shardCoordinator.NumberOfShards() || shardID == core.MetachainShardId
+}
+
+func (n *Node) getTransactionsPoolEntries(cacheID string, filter func(tx data.TransactionHandler) bool) []*poolTransactionEntry {
+	entries := make([]*poolTransactionEntry, 0)
+
+	cache, ok := n.getExistingTransactionsCache(cacheID)
+	if !ok {
+		return entries
+	}
+
+	for _, key := range cache.Keys() {
+		value, ok := cache.Peek(key)
+		if !ok {
+			continue
+		}
+
+		tx, ok := value.(data.TransactionHandler)
+		if !ok || check.IfNil(tx) {
+			continue
+		}
+		if !filter(tx) {
+			continue
+		}
+
+		entries = append(entries, &poolTransactionEntry{
+			hash: key,
+			tx:   tx,
+		})
+	}
+
+	sortTransactionsPoolEntries(entries)
+
+	return entries
+}
+
+// getExistingTransactionsCache does not create the requested cache if it is missing, as ShardDataStore would do
+func (n *Node) getExistingTransactionsCache(cacheID string) (storage.Cacher, bool) {
+	txPool := n.dataPool.Transactions()
+	existingStoreHandler, ok := txPool.(ExistingShardDataStoreHandler)
+	if ok {
+		return existingStoreHandler.ExistingShardDataStore(cacheID)
+	}
+
+	cache := txPool.ShardDataStore(cacheID)
+	return cache, !check.IfNil(cache)
+}
+
+// sortTransactionsPoolEntries sorts the entries by sender, then by nonce and, for the same nonce, descending by gas price
+func sortTransactionsPoolEntries(entries []*poolTransactionEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		senderComparison := bytes.Compare(entries[i].tx.GetSndAddr(), entries[j].tx.GetSndAddr())
+		if senderComparison != 0 {
+			return senderComparison < 0
+		}
+
+		nonceI := entries[i].tx.GetNonce()
+		nonceJ := entries[j].tx.GetNonce()
+		if nonceI != nonceJ {
+			return nonceI < nonceJ
+		}
+
+		return entries[i].tx.GetGasPrice() > entries[j].tx.GetGasPrice()
+	})
+}
+
+func (n *Node) getAccountNonceForTransactionsPool(address []byte, shardID uint32) (uint64, bool) {
+	if shardID != n.shardCoordinator.SelfId() || check.IfNil(n.accounts) {
+		return 0, false
+	}
+
+	account, err := n.accounts.GetExistingAccount(address)
+	if err == state.ErrAccNotFound {
+		return 0, true
+	}
+	if err != nil {
+		log.Debug("getAccountNonceForTransactionsPool", "address", address, "error", err)
+		return 0, false
+	}
+
+	return account.GetNonce(), true
+}
+
+// createTransactionsPoolPage computes the nonce gaps over all the (sorted) entries, then selects the requested page.
+// If an account nonce is provided for a sender, the gap of its first pending transaction is computed against it.
+func (n *Node) createTransactionsPoolPage(
+	entries []*poolTransactionEntry,
+	accountsNonces map[string]uint64,
+	page uint32,
+	pageSize uint32,
+) *api.TransactionsPool {
+	pageSize = computeTransactionsPoolPageSize(pageSize)
+	nonceGaps := computeTransactionsPoolNonceGaps(entries, accountsNonces)
+	numNonceGaps := uint32(0)
+	for _, gap := range nonceGaps {
+		if gap > 0 {
+			numNonceGaps++
+		}
+	}
+
+	txsPool := &api.TransactionsPool{
+		NumTxs:       uint32(len(entries)),
+		NumNonceGaps: numNonceGaps,
+		Page:         page,
+		PageSize:     pageSize,
+		Transactions: make([]*api.PoolTransaction, 0),
+	}
+
+	start := uint64(page) * uint64(pageSize)
+	end := core.MinUint64(start+uint64(pageSize), uint64(len(entries)))
+	for i := start; i < end; i++ {
+		poolTx := n.prepareTransactionsPoolTransaction(entries[i])
+		poolTx.NonceGap = nonceGaps[i]
+		txsPool.Transactions = append(txsPool.Transactions, poolTx)
+	}
+
+	return txsPool
+}
+
+// computeTransactionsPoolNonceGaps expects the entries to be sorted by sender and nonce
+func computeTransactionsPoolNonceGaps(entries []*poolTransactionEntry, accountsNonces map[string]uint64) []uint64 {
+	nonceGaps := make([]uint64, len(entries))
+
+	var previousSender []byte
+	expectedNonce := uint64(0)
+	isExpectedNonceKnown := false
+	for i, entry := range entries {
+		sender := entry.tx.GetSndAddr()
+		nonce := entry.tx.GetNonce()
+
+		if !bytes.Equal(sender, previousSender) {
+			previousSender = sender
+			expectedNonce, isExpectedNonceKnown = accountsNonces[string(sender)]
+		}
+
+		if isExpectedNonceKnown && nonce > expectedNonce {
+			nonceGaps[i] = nonce - expectedNonce
+		}
+		if !isExpectedNonceKnown || nonce+1 > expectedNonce {
+			expectedNonce = nonce + 1
+			isExpectedNonceKnown = true
+		}
+	}
+
+	return nonceGaps
+}
+
+func (n *Node) prepareTransactionsPoolTransaction(entry *poolTransactionEntry) *api.PoolTransaction {
+	tx := entry.tx
+	value := "0"
+	if tx.GetValue() != nil {
+		value = tx.GetValue().String()
+	}
+
+	return &api.PoolTransaction{
+		Hash:             hex.EncodeToString(entry.hash),
+		Nonce:            tx.GetNonce(),
+		Sender:           n.addressPubkeyConverter.Encode(tx.GetSndAddr()),
+		Receiver:         n.addressPubkeyConverter.Encode(tx.GetRcvAddr()),
+		Value:            value,
+		GasPrice:         tx.GetGasPrice(),
+		GasLimit:         tx.GetGasLimit(),
+		Data:             tx.GetData(),
+		SourceShard:      n.shardCoordinator.ComputeId(tx.GetSndAddr()),
+		DestinationShard: n.shardCoordinator.ComputeId(tx.GetRcvAddr()),
+	}
+}
+
+// GetTransactionsPoolSenderDiagnostics returns the selection diagnostics of a sender belonging to the current shard
+func (n *Node) GetTransactionsPoolSenderDiagnostics(sender string) (*api.SenderDiagnostics, error) {
+	err := n.checkTransactionsPoolDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	senderBytes, err := n.addressPubkeyConverter.Decode(sender)
+	if err != nil {
+		return nil, err
+	}
+	if n.shardCoordinator.ComputeId(senderBytes) != n.shardCoordinator.SelfId() {
+		return nil, ErrDifferentSenderShardId
+	}
+
+	diagnosticsHandler, exists, err := n.getTxCacheDiagnosticsHandler()
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrSenderNotFoundInTransactionsPool
+	}
+
+	diagnostics, ok := diagnosticsHandler.GetSenderDiagnostics(senderBytes)
+	if !ok {
+		return nil, ErrSenderNotFoundInTransactionsPool
+	}
+
+	return n.prepareSenderDiagnostics(diagnostics), nil
+}
+
+// GetTransactionsPoolSendersDiagnostics returns a page of selection diagnostics for the senders belonging to the current
+// shard. Senders skipped for more selection passes come first, then the ones having larger nonce gaps.
+func (n *Node) GetTransactionsPoolSendersDiagnostics(page uint32, pageSize uint32) (*api.SendersDiagnostics, error) {
+	err := n.checkTransactionsPoolDependencies()
+	if err != nil {
+		return nil, err
+	}
+
+	diagnosticsHandler, exists, err := n.getTxCacheDiagnosticsHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	allDiagnostics := make([]*api.SenderDiagnostics, 0)
+	if exists {
+		for _, diagnostics := range diagnosticsHandler.GetSendersDiagnostics() {
+			allDiagnostics = append(allDiagnostics, n.prepareSenderDiagnostics(diagnostics))
+		}
+	}
+
+	// the nonce gaps are only known after filling in the account nonces (observers never notify them to the cache),
+	// so the senders are ordered again before paging
+	sortSendersDiagnostics(allDiagnostics)
+
+	pageSize = computeTransactionsPoolPageSize(pageSize)
+	sendersDiagnostics := &api.SendersDiagnostics{
+		CacheID:    process.ShardCacherIdentifier(n.shardCoordinator.SelfId(), n.shardCoordinator.SelfId()),
+		NumSenders: uint32(len(allDiagnostics)),
+		Page:       page,
+		PageSize:   pageSize,
+		Senders:    make([]*api.SenderDiagnostics, 0),
+	}
+
+	start := uint64(page) * uint64(pageSize)
+	end := core.MinUint64(start+uint64(pageSize), uint64(len(allDiagnostics)))
+	for i := start; i < end; i++ {
+		sendersDiagnostics.Senders = append(sendersDiagnostics.Senders, allDiagnostics[i])
+	}
+
+	return sendersDiagnostics, nil
+}
+
+// sortSendersDiagnostics sorts the senders descending by the number of failed selections, then descending by nonce gap
+func sortSendersDiagnostics(diagnostics []*api.SenderDiagnostics) {
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].NumFailedSelections != diagnostics[j].NumFailedSelections {
+			return diagnostics[i].NumFailedSelections > diagnostics[j].NumFailedSelections
+		}
+
+		return diagnostics[i].NonceGap > diagnostics[j].NonceGap
+	})
+}
+
+// getTxCacheDiagnosticsHandler also returns whether the cache of the current shard exists
+func (n *Node) getTxCacheDiagnosticsHandler() (TxCacheDiagnosticsHandler, bool, error) {
+	selfShardID := n.shardCoordinator.SelfId()
+	cacheID := process.ShardCacherIdentifier(selfShardID, selfShardID)
+	cache, ok := n.getExistingTransactionsCache(cacheID)
+	if !ok {
+		return nil, false, nil
+	}
+
+	diagnosticsHandler, ok := cache.(TxCacheDiagnosticsHandler)
+	if !ok || check.IfNil(diagnosticsHandler) {
+		return nil, false, ErrTransactionsPoolDiagnosticsNotAvailable
+	}
+
+	return diagnosticsHandler, true, nil
+}
+
+// prepareSenderDiagnostics also fills in the account nonce when the cache was not (yet) notified about it,
+// which is the usual case on observers, since they never select transactions
+func (n *Node) prepareSenderDiagnostics(diagnostics *txcache.SenderDiagnostics) *api.SenderDiagnostics {
+	apiDiagnostics := &api.SenderDiagnostics{
+		Sender:              n.addressPubkeyConverter.Encode(diagnostics.Sender),
+		NumTxs:              diagnostics.NumTxs,
+		AccountNonce:        diagnostics.AccountNonce,
+		IsAccountNonceKnown: diagnostics.IsAccountNonceKnown,
+		LowestTxNonce:       diagnostics.LowestTxNonce,
+		NonceGap:            diagnostics.NonceGap,
+		NumFailedSelections: diagnostics.NumFailedSelections,
+		IsInGracePeriod:     diagnostics.IsInGracePeriod,
+		Score:               diagnostics.Score,
+	}
+	if apiDiagnostics.IsAccountNonceKnown {
+		return apiDiagnostics
+	}
+
+	accountNonce, ok := n.getAccountNonceForTransactionsPool(diagnostics.Sender, n.shardCoordinator.SelfId())
+	if !ok {
+		return apiDiagnostics
+	}
+
+	apiDiagnostics.AccountNonce = accountNonce
+	apiDiagnostics.IsAccountNonceKnown = true
+	if diagnostics.NumTxs > 0 && diagnostics.LowestTxNonce > accountNonce {
+		apiDiagnostics.NonceGap = diagnostics.LowestTxNonce - accountNonce
+	}
+
+	return apiDiagnostics
+}
+
+func computeTransactionsPoolPageSize(pageSize uint32) uint32 {
+	if pageSize == 0 {
+		return defaultTransactionsPoolPageSize
+	}
+
+	return core.MinUint32(pageSize, maxTransactionsPoolPageSize)
+}
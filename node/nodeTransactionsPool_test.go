@@ -0,0 +1,340 @@
+package node
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetTransactionsPoolForSender_InvalidSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 0, false)
+	txsPool, err := n.GetTransactionsPoolForSender("zzz", 0, 0)
+	require.Error(t, err)
+	require.Nil(t, txsPool)
+}
+
+func TestNode_GetTransactionsPoolForSender_ShouldHighlightNonceGaps(t *testing.T) {
+	t.Parallel()
+
+	n, _, dataPool, _ := createNode(t, 0, false)
+	n.accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			account.Nonce = 3
+			return account, nil
+		},
+	}
+
+	alice := []byte("alice")
+	bob := []byte("bob")
+	dataPool.Transactions().AddData([]byte("a5"), createPoolTx(alice, bob, 5, 100), 42, "1")
+	dataPool.Transactions().AddData([]byte("a6"), createPoolTx(alice, alice, 6, 100), 42, "1")
+	dataPool.Transactions().AddData([]byte("a9"), createPoolTx(alice, bob, 9, 100), 42, "1")
+	dataPool.Transactions().AddData([]byte("a9bis"), createPoolTx(alice, bob, 9, 200), 42, "1")
+	dataPool.Transactions().AddData([]byte("b1"), createPoolTx(bob, alice, 1, 100), 42, "2_1")
+
+	txsPool, err := n.GetTransactionsPoolForSender(hex.EncodeToString(alice), 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, "1", txsPool.CacheID)
+	require.Equal(t, uint32(4), txsPool.NumTxs)
+	require.Equal(t, uint32(2), txsPool.NumNonceGaps)
+	require.Equal(t, uint32(defaultTransactionsPoolPageSize), txsPool.PageSize)
+	require.Len(t, txsPool.Transactions, 4)
+
+	require.Equal(t, hex.EncodeToString([]byte("a5")), txsPool.Transactions[0].Hash)
+	require.Equal(t, uint64(2), txsPool.Transactions[0].NonceGap)
+	require.Equal(t, uint32(2), txsPool.Transactions[0].DestinationShard)
+	require.Equal(t, hex.EncodeToString([]byte("a6")), txsPool.Transactions[1].Hash)
+	require.Equal(t, uint64(0), txsPool.Transactions[1].NonceGap)
+	require.Equal(t, hex.EncodeToString([]byte("a9bis")), txsPool.Transactions[2].Hash)
+	require.Equal(t, uint64(2), txsPool.Transactions[2].NonceGap)
+	require.Equal(t, hex.EncodeToString([]byte("a9")), txsPool.Transactions[3].Hash)
+	require.Equal(t, uint64(0), txsPool.Transactions[3].NonceGap)
+}
+
+func TestNode_GetTransactionsPoolForSender_Pagination(t *testing.T) {
+	t.Parallel()
+
+	n, _, dataPool, _ := createNode(t, 0, false)
+
+	alice := []byte("alice")
+	for nonce := uint64(0); nonce < 5; nonce++ {
+		dataPool.Transactions().AddData([]byte{byte(nonce)}, createPoolTx(alice, alice, nonce, 100), 42, "1")
+	}
+
+	txsPool, err := n.GetTransactionsPoolForSender(hex.EncodeToString(alice), 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, uint32(5), txsPool.NumTxs)
+	require.Len(t, txsPool.Transactions, 2)
+	require.Equal(t, uint64(2), txsPool.Transactions[0].Nonce)
+	require.Equal(t, uint64(3), txsPool.Transactions[1].Nonce)
+
+	txsPool, err = n.GetTransactionsPoolForSender(hex.EncodeToString(alice), 2, 2)
+	require.Nil(t, err)
+	require.Len(t, txsPool.Transactions, 1)
+	require.Equal(t, uint64(4), txsPool.Transactions[0].Nonce)
+
+	txsPool, err = n.GetTransactionsPoolForSender(hex.EncodeToString(alice), 3, 2)
+	require.Nil(t, err)
+	require.Len(t, txsPool.Transactions, 0)
+}
+
+func TestNode_GetTransactionsPoolForCache_InvalidCacheIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionsPool(t)
+
+	_, err := n.GetTransactionsPoolForCache("abc", 0, 0)
+	require.Error(t, err)
+
+	_, err = n.GetTransactionsPoolForCache("0_2", 0, 0)
+	require.Equal(t, ErrInvalidTransactionsPoolCacheID, err)
+
+	_, err = n.GetTransactionsPoolForCache("7_1", 0, 0)
+	require.Equal(t, ErrInvalidTransactionsPoolCacheID, err)
+}
+
+func TestNode_GetTransactionsPoolForCache_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionsPool(t)
+
+	alice := []byte("alice")
+	bob := []byte("bob")
+	n.dataPool.Transactions().AddData([]byte("b1"), createPoolTx(bob, alice, 1, 100), 42, "2_1")
+	n.dataPool.Transactions().AddData([]byte("b4"), createPoolTx(bob, alice, 4, 100), 42, "2_1")
+	n.dataPool.Transactions().AddData([]byte("a1"), createPoolTx(alice, bob, 1, 100), 42, "1")
+
+	txsPool, err := n.GetTransactionsPoolForCache("2_1", 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, "2_1", txsPool.CacheID)
+	require.Equal(t, uint32(2), txsPool.NumTxs)
+	require.Equal(t, uint32(1), txsPool.NumNonceGaps)
+	require.Equal(t, uint64(0), txsPool.Transactions[0].NonceGap)
+	require.Equal(t, uint64(2), txsPool.Transactions[1].NonceGap)
+	require.Equal(t, "100", txsPool.Transactions[1].Value)
+}
+
+func TestNode_GetTransactionsPoolForCache_SourceSelfShouldFilterByReceiverShard(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionsPool(t)
+
+	alice := []byte("alice")
+	bob := []byte("bob")
+	n.dataPool.Transactions().AddData([]byte("a1"), createPoolTx(alice, alice, 1, 100), 42, "1")
+	n.dataPool.Transactions().AddData([]byte("a2"), createPoolTx(alice, bob, 2, 100), 42, "1_2")
+	n.dataPool.Transactions().AddData([]byte("a3"), createPoolTx(alice, alice, 3, 100), 42, "1")
+
+	txsPool, err := n.GetTransactionsPoolForCache("1_2", 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, "1_2", txsPool.CacheID)
+	require.Equal(t, uint32(1), txsPool.NumTxs)
+	require.Equal(t, hex.EncodeToString([]byte("a2")), txsPool.Transactions[0].Hash)
+
+	txsPool, err = n.GetTransactionsPoolForCache("1", 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), txsPool.NumTxs)
+	require.Equal(t, hex.EncodeToString([]byte("a1")), txsPool.Transactions[0].Hash)
+	require.Equal(t, hex.EncodeToString([]byte("a3")), txsPool.Transactions[1].Hash)
+}
+
+func TestNode_GetTransactionsPoolForCache_MissingCacheShouldNotCreateIt(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTransactionsPool(t)
+
+	txsPool, err := n.GetTransactionsPoolForCache("2_1", 0, 0)
+	require.Nil(t, err)
+	require.Equal(t, uint32(0), txsPool.NumTxs)
+	require.Len(t, txsPool.Transactions, 0)
+
+	diagnostics, err := n.GetTransactionsPoolSendersDiagnostics(0, 0)
+	require.Nil(t, err)
+	require.Equal(t, uint32(0), diagnostics.NumSenders)
+
+	txPool := n.dataPool.Transactions().(ExistingShardDataStoreHandler)
+	_, ok := txPool.ExistingShardDataStore("2_1")
+	require.False(t, ok)
+	_, ok = txPool.ExistingShardDataStore("1")
+	require.False(t, ok)
+}
+
+// createNodeForTransactionsPool returns a node that lives in shard 1 (out of 3), as its pool does
+func createNodeForTransactionsPool(t *testing.T) *Node {
+	shardCoordinator := createShardCoordinator()
+	shardCoordinator.NumOfShards = 3
+
+	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:             1000,
+			SizePerSender:        100,
+			SizeInBytes:          1000000,
+			SizeInBytesPerSender: 100000,
+			Shards:               4,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      100,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 3,
+		SelfShardID:    1,
+	})
+	require.Nil(t, err)
+
+	dataPool := testscommon.NewPoolsHolderMock()
+	dataPool.SetTransactions(txPool)
+
+	n, err := NewNode(
+		WithDataPool(dataPool),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		WithShardCoordinator(shardCoordinator),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func createPoolTx(sender []byte, receiver []byte, nonce uint64, gasPrice uint64) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  sender,
+		RcvAddr:  receiver,
+		GasPrice: gasPrice,
+		GasLimit: 50000,
+		Value:    big.NewInt(100),
+	}
+}
+
+func TestNode_GetTransactionsPoolSenderDiagnostics_DifferentShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 0, false)
+	diagnostics, err := n.GetTransactionsPoolSenderDiagnostics(hex.EncodeToString([]byte("bob")))
+	require.Equal(t, ErrDifferentSenderShardId, err)
+	require.Nil(t, diagnostics)
+}
+
+func TestNode_GetTransactionsPoolSenderDiagnostics_SenderNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, dataPool := createNodeForTransactionsPoolDiagnostics(t)
+	dataPool.Transactions().AddData([]byte("b1"), createPoolTx([]byte("bob"), []byte("bob"), 1, 100), 42, "0")
+
+	diagnostics, err := n.GetTransactionsPoolSenderDiagnostics(hex.EncodeToString([]byte("alice")))
+	require.Equal(t, ErrSenderNotFoundInTransactionsPool, err)
+	require.Nil(t, diagnostics)
+}
+
+func TestNode_GetTransactionsPoolSenderDiagnostics_ShouldFillAccountNonce(t *testing.T) {
+	t.Parallel()
+
+	n, dataPool := createNodeForTransactionsPoolDiagnostics(t)
+	n.accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			account.Nonce = 3
+			return account, nil
+		},
+	}
+
+	alice := []byte("alice")
+	dataPool.Transactions().AddData([]byte("a5"), createPoolTx(alice, alice, 5, 100), 42, "0")
+	dataPool.Transactions().AddData([]byte("a6"), createPoolTx(alice, alice, 6, 100), 42, "0")
+
+	diagnostics, err := n.GetTransactionsPoolSenderDiagnostics(hex.EncodeToString(alice))
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString(alice), diagnostics.Sender)
+	require.Equal(t, uint64(2), diagnostics.NumTxs)
+	require.True(t, diagnostics.IsAccountNonceKnown)
+	require.Equal(t, uint64(3), diagnostics.AccountNonce)
+	require.Equal(t, uint64(5), diagnostics.LowestTxNonce)
+	require.Equal(t, uint64(2), diagnostics.NonceGap)
+}
+
+func TestNode_GetTransactionsPoolSendersDiagnostics_Pagination(t *testing.T) {
+	t.Parallel()
+
+	n, dataPool := createNodeForTransactionsPoolDiagnostics(t)
+	n.accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return nil, state.ErrAccNotFound
+		},
+	}
+
+	dataPool.Transactions().AddData([]byte("a1"), createPoolTx([]byte("alice"), []byte("bob"), 1, 100), 42, "0")
+	dataPool.Transactions().AddData([]byte("c1"), createPoolTx([]byte("carol"), []byte("bob"), 1, 100), 42, "0")
+	dataPool.Transactions().AddData([]byte("e1"), createPoolTx([]byte("erin"), []byte("bob"), 1, 100), 42, "0")
+
+	diagnostics, err := n.GetTransactionsPoolSendersDiagnostics(1, 2)
+	require.Nil(t, err)
+	require.Equal(t, "0", diagnostics.CacheID)
+	require.Equal(t, uint32(3), diagnostics.NumSenders)
+	require.Equal(t, uint32(1), diagnostics.Page)
+	require.Equal(t, uint32(2), diagnostics.PageSize)
+	require.Len(t, diagnostics.Senders, 1)
+	require.Equal(t, uint64(1), diagnostics.Senders[0].NonceGap)
+}
+
+func TestNode_GetTransactionsPoolSendersDiagnostics_ObserverShouldOrderByAccountNonceGap(t *testing.T) {
+	t.Parallel()
+
+	accountsNonces := map[string]uint64{
+		"alice": 4,
+		"carol": 1,
+		"erin":  5,
+	}
+	n, dataPool := createNodeForTransactionsPoolDiagnostics(t)
+	n.accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			account.Nonce = accountsNonces[string(address)]
+			return account, nil
+		},
+	}
+
+	// no selection pass is ever performed, so the cache does not know the account nonces
+	dataPool.Transactions().AddData([]byte("a5"), createPoolTx([]byte("alice"), []byte("bob"), 5, 100), 42, "0")
+	dataPool.Transactions().AddData([]byte("c9"), createPoolTx([]byte("carol"), []byte("bob"), 9, 100), 42, "0")
+	dataPool.Transactions().AddData([]byte("e5"), createPoolTx([]byte("erin"), []byte("bob"), 5, 100), 42, "0")
+
+	diagnostics, err := n.GetTransactionsPoolSendersDiagnostics(0, 2)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), diagnostics.NumSenders)
+	require.Len(t, diagnostics.Senders, 2)
+	require.Equal(t, hex.EncodeToString([]byte("carol")), diagnostics.Senders[0].Sender)
+	require.Equal(t, uint64(8), diagnostics.Senders[0].NonceGap)
+	require.Equal(t, hex.EncodeToString([]byte("alice")), diagnostics.Senders[1].Sender)
+	require.Equal(t, uint64(1), diagnostics.Senders[1].NonceGap)
+
+	diagnostics, err = n.GetTransactionsPoolSendersDiagnostics(1, 2)
+	require.Nil(t, err)
+	require.Len(t, diagnostics.Senders, 1)
+	require.Equal(t, hex.EncodeToString([]byte("erin")), diagnostics.Senders[0].Sender)
+	require.Equal(t, uint64(0), diagnostics.Senders[0].NonceGap)
+}
+
+// createNodeForTransactionsPoolDiagnostics returns a node that lives in shard 0, as the mocked pool does
+func createNodeForTransactionsPoolDiagnostics(t *testing.T) (*Node, *testscommon.PoolsHolderMock) {
+	dataPool := testscommon.NewPoolsHolderMock()
+	n, err := NewNode(
+		WithDataPool(dataPool),
+		WithAddressPubkeyConverter(&mock.PubkeyConverterMock{}),
+		WithShardCoordinator(&mock.ShardCoordinatorMock{NumOfShards: 1}),
+	)
+	require.Nil(t, err)
+
+	return n, dataPool
+}
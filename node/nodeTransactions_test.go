@@ -10,12 +10,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
@@ -432,6 +434,63 @@ func TestNode_PutHistoryFieldsInTransaction(t *testing.T) {
 	require.Equal(t, "0c", tx.NotarizedAtDestinationInMetaHash)
 }
 
+func TestNode_PutFinalityFieldsInTransaction(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 42, true)
+	lastFinalMetaNonce := uint64(4255)
+	n.blockTracker = &mock.BlockTrackerStub{
+		GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+			require.Equal(t, core.MetachainShardId, shardID)
+			return &block.MetaBlock{Nonce: lastFinalMetaNonce}, []byte("meta"), nil
+		},
+	}
+
+	// not yet notarized at destination
+	tx := &transaction.ApiTransactionResult{NotarizedAtSourceInMetaNonce: 4250}
+	n.putFinalityFieldsInTransaction(tx)
+	require.Zero(t, tx.HyperblockNonce)
+	require.Zero(t, tx.Confirmations)
+	require.False(t, tx.IsFinal)
+
+	tx = &transaction.ApiTransactionResult{
+		NotarizedAtSourceInMetaNonce:      4250,
+		NotarizedAtDestinationInMetaNonce: 4253,
+		NotarizedAtDestinationInMetaHash:  "0c",
+	}
+	n.putFinalityFieldsInTransaction(tx)
+	require.Equal(t, 4253, int(tx.HyperblockNonce))
+	require.Equal(t, "0c", tx.HyperblockHash)
+	require.Equal(t, 3, int(tx.Confirmations))
+	require.True(t, tx.IsFinal)
+
+	// the hyperblock is not yet known as final by the block tracker
+	lastFinalMetaNonce = 4252
+	tx = &transaction.ApiTransactionResult{NotarizedAtDestinationInMetaNonce: 4253}
+	n.putFinalityFieldsInTransaction(tx)
+	require.Equal(t, 4253, int(tx.HyperblockNonce))
+	require.Zero(t, tx.Confirmations)
+	require.False(t, tx.IsFinal)
+}
+
+func TestNode_PutFinalityFieldsInTransactionOnMetachain(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 42, true)
+	n.shardCoordinator = &mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}
+	n.blockTracker = &mock.BlockTrackerStub{
+		GetLastSelfNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+			require.Equal(t, core.MetachainShardId, shardID)
+			return &block.MetaBlock{Nonce: 100}, []byte("meta"), nil
+		},
+	}
+
+	tx := &transaction.ApiTransactionResult{NotarizedAtDestinationInMetaNonce: 100}
+	n.putFinalityFieldsInTransaction(tx)
+	require.Equal(t, 1, int(tx.Confirmations))
+	require.True(t, tx.IsFinal)
+}
+
 func createNode(t *testing.T, epoch uint32, withDbLookupExt bool) (*Node, *genericMocks.ChainStorerMock, *testscommon.PoolsHolderMock, *testscommon.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := testscommon.NewPoolsHolderMock()
@@ -546,3 +605,49 @@ func TestPrepareUnsignedTx(t *testing.T) {
 	}
 	assert.Equal(t, scrResult2, expectedScr2)
 }
+
+func TestPrepareNormalTx_RelayedTxV2ShouldRenderInnerTransaction(t *testing.T) {
+	t.Parallel()
+	addrSize := 32
+	userTxData := []byte("doSomething@01")
+	tx := &transaction.Transaction{
+		Nonce:    5,
+		Value:    big.NewInt(0),
+		SndAddr:  bytes.Repeat([]byte{0}, addrSize),
+		RcvAddr:  bytes.Repeat([]byte{5}, addrSize),
+		GasPrice: 1000000000,
+		GasLimit: 600000,
+		Data: []byte(core.RelayedTransactionV2 +
+			"@" + hex.EncodeToString(bytes.Repeat([]byte{6}, addrSize)) +
+			"@" + hex.EncodeToString(big.NewInt(7).Bytes()) +
+			"@" + hex.EncodeToString(userTxData) +
+			"@" + hex.EncodeToString([]byte("signature"))),
+	}
+
+	n := Node{}
+	n.addressPubkeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(addrSize)
+	n.feeHandler = &mock.FeeHandlerStub{
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 100000
+		},
+	}
+
+	txResult, err := n.prepareNormalTx(tx)
+	require.Nil(t, err)
+	expectedInnerTx := &transaction.ApiInnerTransaction{
+		Nonce:     7,
+		Value:     "0",
+		Receiver:  "erd1qcrqvpsxqcrqvpsxqcrqvpsxqcrqvpsxqcrqvpsxqcrqvpsxqcrqwkh39e",
+		Sender:    "erd1q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zs2pg9q5zsrqsks3",
+		GasPrice:  1000000000,
+		GasLimit:  500000,
+		Data:      userTxData,
+		Signature: hex.EncodeToString([]byte("signature")),
+	}
+	assert.Equal(t, expectedInnerTx, txResult.InnerTransaction)
+
+	tx.Data = []byte(core.RelayedTransaction + "@" + hex.EncodeToString([]byte("{}")))
+	txResult, err = n.prepareNormalTx(tx)
+	require.Nil(t, err)
+	assert.Nil(t, txResult.InnerTransaction)
+}
@@ -0,0 +1,71 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+const (
+	defaultNumTopDataTries = 10
+	maxNumTopDataTries     = 100
+)
+
+// GetTrieStatistics walks the accounts trie at the root hash of the current block, together with all the accounts data
+// tries, and returns the node counts, the depth histograms, the sizes and the accounts having the largest data tries
+func (n *Node) GetTrieStatistics(numTopDataTries uint32) (*api.TrieStatistics, error) {
+	if check.IfNil(n.accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	statisticsProvider, ok := n.accounts.(state.TrieStatisticsProvider)
+	if !ok {
+		return nil, ErrTrieStatisticsNotSupported
+	}
+
+	currentHeader, err := n.getCurrentBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	trieStatistics, err := statisticsProvider.GetTrieStatistics(
+		context.Background(),
+		currentHeader.GetRootHash(),
+		computeNumTopDataTries(numTopDataTries),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTrieStatistics := &api.TrieStatistics{
+		RootHash:                hex.EncodeToString(trieStatistics.RootHash),
+		BlockNonce:              currentHeader.GetNonce(),
+		NumAccounts:             trieStatistics.NumAccounts,
+		NumAccountsWithDataTrie: trieStatistics.NumAccountsWithDataTrie,
+		MainTrie:                trieStatistics.MainTrie,
+		DataTries:               trieStatistics.DataTries,
+		TopDataTries:            make([]*api.DataTrieStatistics, 0, len(trieStatistics.TopDataTries)),
+	}
+	for _, dataTrie := range trieStatistics.TopDataTries {
+		apiTrieStatistics.TopDataTries = append(apiTrieStatistics.TopDataTries, &api.DataTrieStatistics{
+			Address:   n.addressPubkeyConverter.Encode(dataTrie.Address),
+			RootHash:  hex.EncodeToString(dataTrie.RootHash),
+			NumNodes:  dataTrie.NumNodes,
+			TotalSize: dataTrie.TotalSize,
+		})
+	}
+
+	return apiTrieStatistics, nil
+}
+
+func computeNumTopDataTries(numTopDataTries uint32) int {
+	if numTopDataTries == 0 {
+		return defaultNumTopDataTries
+	}
+
+	return int(core.MinUint32(numTopDataTries, maxNumTopDataTries))
+}
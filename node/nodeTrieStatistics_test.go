@@ -0,0 +1,83 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForTrieStatistics(accounts state.AccountsAdapter, currentHeader data.HeaderHandler) *node.Node {
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accounts),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+		}),
+	)
+
+	return n
+}
+
+func TestNode_GetTrieStatisticsNotSupportedAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForTrieStatistics(&mock.AccountsStub{}, &block.Header{})
+
+	trieStatistics, err := n.GetTrieStatistics(10)
+	assert.Nil(t, trieStatistics)
+	assert.Equal(t, node.ErrTrieStatisticsNotSupported, err)
+}
+
+func TestNode_GetTrieStatisticsNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := createAccountsDBWithTwoStates(t)
+	n := createNodeForTrieStatistics(adb, nil)
+
+	trieStatistics, err := n.GetTrieStatistics(10)
+	assert.Nil(t, trieStatistics)
+	assert.Equal(t, node.ErrNilBlockHeader, err)
+}
+
+func TestNode_GetTrieStatisticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerFake{}
+	hasher := &mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	adb, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	account, _ := adb.LoadAccount(accountsQueryAddress)
+	_ = account.(state.UserAccountHandler).DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = adb.SaveAccount(account)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	n := createNodeForTrieStatistics(adb, &block.Header{Nonce: 7, RootHash: rootHash})
+
+	trieStatistics, err := n.GetTrieStatistics(0)
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(rootHash), trieStatistics.RootHash)
+	assert.Equal(t, uint64(7), trieStatistics.BlockNonce)
+	assert.Equal(t, uint64(1), trieStatistics.NumAccounts)
+	assert.Equal(t, uint64(1), trieStatistics.NumAccountsWithDataTrie)
+	assert.Equal(t, uint64(1), trieStatistics.MainTrie.NumLeafNodes)
+	assert.Equal(t, uint64(1), trieStatistics.DataTries.NumLeafNodes)
+	require.Equal(t, 1, len(trieStatistics.TopDataTries))
+	assert.Equal(t, createMockPubkeyConverter().Encode(accountsQueryAddress), trieStatistics.TopDataTries[0].Address)
+	assert.Equal(t, uint64(1), trieStatistics.TopDataTries[0].NumNodes)
+}
@@ -213,7 +213,7 @@ func (gc *gasComputation) ComputeGasConsumedByTx(
 		return txHandler.GetGasLimit(), txHandler.GetGasLimit(), nil
 	}
 
-	if txTypeSndShard == process.RelayedTx {
+	if txTypeSndShard == process.RelayedTx || txTypeSndShard == process.RelayedTxV2 {
 		return txHandler.GetGasLimit(), txHandler.GetGasLimit(), nil
 	}
 
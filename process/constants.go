@@ -30,6 +30,8 @@ const (
 	BuiltInFunctionCall
 	// RelayedTx defines ID of a transaction of type relayed
 	RelayedTx
+	// RelayedTxV2 defines the ID of a transaction of type relayed V2
+	RelayedTxV2
 	// RewardTx defines ID of a reward transaction
 	RewardTx
 	// InvalidTransaction defines unknown transaction type
@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"bytes"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -14,10 +15,12 @@ import (
 
 var _ process.TxTypeHandler = (*txTypeHandler)(nil)
 
+const argsPerMultiESDTTransfer = 3
+
 type txTypeHandler struct {
 	pubkeyConv       core.PubkeyConverter
 	shardCoordinator sharding.Coordinator
-	builtInFuncNames map[string]struct{}
+	builtInFunctions process.BuiltInFunctionContainer
 	argumentParser   process.CallArgumentsParser
 }
 
@@ -25,7 +28,7 @@ type txTypeHandler struct {
 type ArgNewTxTypeHandler struct {
 	PubkeyConverter  core.PubkeyConverter
 	ShardCoordinator sharding.Coordinator
-	BuiltInFuncNames map[string]struct{}
+	BuiltInFunctions process.BuiltInFunctionContainer
 	ArgumentParser   process.CallArgumentsParser
 }
 
@@ -42,7 +45,7 @@ func NewTxTypeHandler(
 	if check.IfNil(args.ArgumentParser) {
 		return nil, process.ErrNilArgumentParser
 	}
-	if args.BuiltInFuncNames == nil {
+	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
 
@@ -50,7 +53,7 @@ func NewTxTypeHandler(
 		pubkeyConv:       args.PubkeyConverter,
 		shardCoordinator: args.ShardCoordinator,
 		argumentParser:   args.ArgumentParser,
-		builtInFuncNames: args.BuiltInFuncNames,
+		builtInFunctions: args.BuiltInFunctions,
 	}
 
 	return tc, nil
@@ -97,6 +100,10 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) (pr
 		return process.RelayedTx, process.RelayedTx
 	}
 
+	if tth.isRelayedTransactionV2(funcName) {
+		return process.RelayedTxV2, process.RelayedTxV2
+	}
+
 	isDestInSelfShard := tth.isDestAddressInSelfShard(tx.GetRcvAddr())
 	if isDestInSelfShard && core.IsSmartContractAddress(tx.GetRcvAddr()) {
 		return process.SCInvoking, process.SCInvoking
@@ -119,17 +126,53 @@ func isAsynchronousCallBack(tx data.TransactionHandler) bool {
 }
 
 func (tth *txTypeHandler) isSCCallAfterBuiltIn(function string, args [][]byte, tx data.TransactionHandler) bool {
-	if !core.IsSmartContractAddress(tx.GetRcvAddr()) {
+	switch function {
+	case core.BuiltInFunctionESDTTransfer:
+		return len(args) > 2 && core.IsSmartContractAddress(tx.GetRcvAddr())
+	case core.BuiltInFunctionESDTNFTTransfer:
+		return tth.isSCCallAfterNFTTransfer(args, tx)
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		return tth.isSCCallAfterMultiTransfer(args, tx)
+	default:
 		return false
 	}
-	if len(args) <= 2 {
+}
+
+func (tth *txTypeHandler) isSCCallAfterNFTTransfer(args [][]byte, tx data.TransactionHandler) bool {
+	if len(args) <= 4 {
 		return false
 	}
-	if function != core.BuiltInFunctionESDTTransfer {
+
+	// the self-sent NFT transfer has the destination in the arguments, otherwise the destination is the receiver
+	dstAddress := tx.GetRcvAddr()
+	if bytes.Equal(tx.GetSndAddr(), tx.GetRcvAddr()) {
+		dstAddress = args[3]
+	}
+
+	return core.IsSmartContractAddress(dstAddress)
+}
+
+func (tth *txTypeHandler) isSCCallAfterMultiTransfer(args [][]byte, tx data.TransactionHandler) bool {
+	// the self-sent multi transfer has the destination as first argument, otherwise the destination is the receiver
+	dstAddress := tx.GetRcvAddr()
+	if bytes.Equal(tx.GetSndAddr(), tx.GetRcvAddr()) {
+		if len(args) == 0 {
+			return false
+		}
+		dstAddress = args[0]
+		args = args[1:]
+	}
+	if len(args) == 0 {
 		return false
 	}
 
-	return true
+	numTransfers := big.NewInt(0).SetBytes(args[0]).Uint64()
+	if numTransfers > uint64(len(args)-1)/argsPerMultiESDTTransfer {
+		return false
+	}
+	hasSCCall := uint64(len(args)-1) > numTransfers*argsPerMultiESDTTransfer
+
+	return hasSCCall && core.IsSmartContractAddress(dstAddress)
 }
 
 func (tth *txTypeHandler) getFunctionFromArguments(txData []byte) (string, [][]byte) {
@@ -146,18 +189,26 @@ func (tth *txTypeHandler) getFunctionFromArguments(txData []byte) (string, [][]b
 }
 
 func (tth *txTypeHandler) isBuiltInFunctionCall(functionName string) bool {
-	if len(tth.builtInFuncNames) == 0 {
+	if len(functionName) == 0 {
+		return false
+	}
+
+	builtInFunc, err := tth.builtInFunctions.Get(functionName)
+	if err != nil {
 		return false
 	}
 
-	_, ok := tth.builtInFuncNames[functionName]
-	return ok
+	return builtInFunc.IsActive()
 }
 
 func (tth *txTypeHandler) isRelayedTransaction(functionName string) bool {
 	return functionName == core.RelayedTransaction
 }
 
+func (tth *txTypeHandler) isRelayedTransactionV2(functionName string) bool {
+	return functionName == core.RelayedTransactionV2
+}
+
 func (tth *txTypeHandler) isDestAddressEmpty(tx data.TransactionHandler) bool {
 	isEmptyAddress := bytes.Equal(tx.GetRcvAddr(), make([]byte, tth.pubkeyConv.Len()))
 	return isEmptyAddress
@@ -1,6 +1,8 @@
 package coordinator
 
 import (
+	"bytes"
+	"encoding/hex"
 	"math/big"
 	"testing"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,7 +21,7 @@ func createMockArguments() ArgNewTxTypeHandler {
 	return ArgNewTxTypeHandler{
 		PubkeyConverter:  createMockPubkeyConverter(),
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(3),
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 }
@@ -64,7 +67,7 @@ func TestNewTxTypeHandler_NilBuiltInFuncs(t *testing.T) {
 	t.Parallel()
 
 	arg := createMockArguments()
-	arg.BuiltInFuncNames = nil
+	arg.BuiltInFunctions = nil
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.Nil(t, tth)
@@ -243,7 +246,7 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 		},
 	}
 	builtIn := "builtIn"
-	arg.BuiltInFuncNames[builtIn] = struct{}{}
+	_ = arg.BuiltInFunctions.Add(builtIn, &mock.BuiltInFunctionStub{})
 	tth, err := NewTxTypeHandler(arg)
 
 	assert.NotNil(t, tth)
@@ -254,6 +257,101 @@ func TestTxTypeHandler_ComputeTransactionTypeBuiltInFunc(t *testing.T) {
 	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
 }
 
+func TestTxTypeHandler_ComputeTransactionTypeNotActiveBuiltInFunc(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte("builtIn")
+	tx.Value = big.NewInt(45)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	builtIn := "builtIn"
+	_ = arg.BuiltInFunctions.Add(builtIn, &mock.BuiltInFunctionStub{
+		IsActiveCalled: func() bool {
+			return false
+		},
+	})
+	tth, _ := NewTxTypeHandler(arg)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.MoveBalance, txTypeIn)
+	assert.Equal(t, process.MoveBalance, txTypeCross)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeNFTTransferWithSCCall(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	scAddress := bytes.Repeat([]byte{0}, 32)
+	scAddress[31] = 1
+
+	tx := &transaction.Transaction{}
+	tx.SndAddr = sender
+	tx.RcvAddr = sender
+	tx.Value = big.NewInt(0)
+	tx.Data = []byte(core.BuiltInFunctionESDTNFTTransfer + "@" + hex.EncodeToString([]byte("token")) + "@01@01@" + hex.EncodeToString(scAddress))
+
+	arg := createMockArguments()
+	_ = arg.BuiltInFunctions.Add(core.BuiltInFunctionESDTNFTTransfer, &mock.BuiltInFunctionStub{})
+	tth, _ := NewTxTypeHandler(arg)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeIn)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
+
+	tx.Data = append(tx.Data, []byte("@"+hex.EncodeToString([]byte("function")))...)
+	txTypeIn, txTypeCross = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeIn)
+	assert.Equal(t, process.SCInvoking, txTypeCross)
+
+	scAddress[0] = 1
+	tx.Data = []byte(core.BuiltInFunctionESDTNFTTransfer + "@" + hex.EncodeToString([]byte("token")) + "@01@01@" + hex.EncodeToString(scAddress) + "@" + hex.EncodeToString([]byte("function")))
+	_, txTypeCross = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
+}
+
+func TestTxTypeHandler_ComputeTransactionTypeMultiTransferWithSCCall(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	scAddress := bytes.Repeat([]byte{0}, 32)
+	scAddress[31] = 1
+	transfers := "@02@" + hex.EncodeToString([]byte("token1")) + "@00@05@" + hex.EncodeToString([]byte("token2")) + "@01@01"
+
+	tx := &transaction.Transaction{}
+	tx.SndAddr = sender
+	tx.RcvAddr = sender
+	tx.Value = big.NewInt(0)
+	tx.Data = []byte(core.BuiltInFunctionMultiESDTNFTTransfer + "@" + hex.EncodeToString(scAddress) + transfers)
+
+	arg := createMockArguments()
+	_ = arg.BuiltInFunctions.Add(core.BuiltInFunctionMultiESDTNFTTransfer, &mock.BuiltInFunctionStub{})
+	tth, _ := NewTxTypeHandler(arg)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeIn)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeCross)
+
+	tx.Data = append(tx.Data, []byte("@"+hex.EncodeToString([]byte("function")))...)
+	txTypeIn, txTypeCross = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.BuiltInFunctionCall, txTypeIn)
+	assert.Equal(t, process.SCInvoking, txTypeCross)
+
+	tx.SndAddr = bytes.Repeat([]byte{2}, 32)
+	tx.RcvAddr = scAddress
+	tx.Data = []byte(core.BuiltInFunctionMultiESDTNFTTransfer + transfers + "@" + hex.EncodeToString([]byte("function")))
+	_, txTypeCross = tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.SCInvoking, txTypeCross)
+}
+
 func TestTxTypeHandler_ComputeTransactionTypeRelayedFunc(t *testing.T) {
 	t.Parallel()
 
@@ -280,6 +378,32 @@ func TestTxTypeHandler_ComputeTransactionTypeRelayedFunc(t *testing.T) {
 	assert.Equal(t, process.RelayedTx, txTypeCross)
 }
 
+func TestTxTypeHandler_ComputeTransactionTypeRelayedV2Func(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Data = []byte(core.RelayedTransactionV2)
+	tx.Value = big.NewInt(0)
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.RelayedTxV2, txTypeIn)
+	assert.Equal(t, process.RelayedTxV2, txTypeCross)
+}
+
 func TestTxTypeHandler_ComputeTransactionTypeForSCRCallBack(t *testing.T) {
 	t.Parallel()
 
@@ -863,6 +863,9 @@ var ErrUpgradeNotAllowed = errors.New("upgrade is allowed only for owner")
 // ErrBuiltInFunctionsAreDisabled signals that built in functions are disabled
 var ErrBuiltInFunctionsAreDisabled = errors.New("built in functions are disabled")
 
+// ErrBuiltInFunctionIsNotActive signals that the called built in function is not yet active
+var ErrBuiltInFunctionIsNotActive = errors.New("built in function is not active")
+
 // ErrRelayedTxDisabled signals that relayed tx are disabled
 var ErrRelayedTxDisabled = errors.New("relayed tx is disabled")
 
@@ -875,6 +878,12 @@ var ErrRelayedTxGasLimitMissmatch = errors.New("relayed tx gas limit higher then
 // ErrRelayedGasPriceMissmatch signals that relayed gas price is not equal with user tx
 var ErrRelayedGasPriceMissmatch = errors.New("relayed gas price missmatch")
 
+// ErrRelayedTxV2Disabled signals that relayed tx v2 are disabled
+var ErrRelayedTxV2Disabled = errors.New("relayed tx v2 is disabled")
+
+// ErrRelayedTxV2ZeroVal signals that the v2 version of relayed tx should be created with 0 as value
+var ErrRelayedTxV2ZeroVal = errors.New("relayed tx v2 value should be 0")
+
 // ErrNilUserAccount signals that nil user account was provided
 var ErrNilUserAccount = errors.New("nil user account")
 
@@ -946,3 +955,27 @@ var ErrMaxAccumulatedFeesExceeded = errors.New("max accumulated fees has been ex
 
 // ErrMaxDeveloperFeesExceeded signals that max developer fees has been exceeded
 var ErrMaxDeveloperFeesExceeded = errors.New("max developer fees has been exceeded")
+
+// ErrHistoricalStateNotSupported signals that the accounts adapter cannot open views over older states
+var ErrHistoricalStateNotSupported = errors.New("historical state queries not supported by the accounts adapter")
+
+// ErrActionNotAllowed signals that action is not allowed
+var ErrActionNotAllowed = errors.New("action is not allowed")
+
+// ErrInvalidNFTQuantity signals that invalid NFT quantity was provided
+var ErrInvalidNFTQuantity = errors.New("invalid NFT quantity")
+
+// ErrInvalidRoyalties signals that royalties value is higher than the maximum allowed
+var ErrInvalidRoyalties = errors.New("invalid royalties value")
+
+// ErrNFTTokenDoesNotExist signals that the NFT token does not exist for the given nonce
+var ErrNFTTokenDoesNotExist = errors.New("NFT token does not exist")
+
+// ErrWrongNFTOnDestination signals that the NFT received on destination differs from the one already saved there
+var ErrWrongNFTOnDestination = errors.New("wrong NFT on destination")
+
+// ErrNFTDoesNotHaveMetadata signals that the NFT data does not have metadata
+var ErrNFTDoesNotHaveMetadata = errors.New("NFT does not have metadata")
+
+// ErrNilRolesHandler signals that nil roles handler has been provided
+var ErrNilRolesHandler = errors.New("nil roles handler")
@@ -23,35 +23,41 @@ import (
 var _ process.VirtualMachinesContainerFactory = (*vmContainerFactory)(nil)
 
 type vmContainerFactory struct {
-	chanceComputer         sharding.ChanceComputer
-	validatorAccountsDB    state.AccountsAdapter
-	blockChainHookImpl     *hooks.BlockChainHookImpl
-	cryptoHook             vmcommon.CryptoHook
-	systemContracts        vm.SystemSCContainer
-	economics              process.EconomicsDataHandler
-	messageSigVerifier     vm.MessageSignVerifier
-	nodesConfigProvider    vm.NodesConfigProvider
-	gasSchedule            core.GasScheduleNotifier
-	hasher                 hashing.Hasher
-	marshalizer            marshal.Marshalizer
-	systemSCConfig         *config.SystemSmartContractsConfig
-	epochNotifier          process.EpochNotifier
-	addressPubKeyConverter core.PubkeyConverter
+	chanceComputer                sharding.ChanceComputer
+	validatorAccountsDB           state.AccountsAdapter
+	blockChainHookImpl            *hooks.BlockChainHookImpl
+	cryptoHook                    vmcommon.CryptoHook
+	systemContracts               vm.SystemSCContainer
+	economics                     process.EconomicsDataHandler
+	messageSigVerifier            vm.MessageSignVerifier
+	nodesConfigProvider           vm.NodesConfigProvider
+	gasSchedule                   core.GasScheduleNotifier
+	hasher                        hashing.Hasher
+	marshalizer                   marshal.Marshalizer
+	systemSCConfig                *config.SystemSmartContractsConfig
+	epochNotifier                 process.EpochNotifier
+	addressPubKeyConverter        core.PubkeyConverter
+	esdtNFTEnableEpoch            uint32
+	esdtSpecialRolesEnableEpoch   uint32
+	esdtSupplyTrackingEnableEpoch uint32
 }
 
 // ArgsNewVMContainerFactory defines the arguments needed to create a new VM container factory
 type ArgsNewVMContainerFactory struct {
-	ArgBlockChainHook   hooks.ArgBlockChainHook
-	Economics           process.EconomicsDataHandler
-	MessageSignVerifier vm.MessageSignVerifier
-	GasSchedule         core.GasScheduleNotifier
-	NodesConfigProvider vm.NodesConfigProvider
-	Hasher              hashing.Hasher
-	Marshalizer         marshal.Marshalizer
-	SystemSCConfig      *config.SystemSmartContractsConfig
-	ValidatorAccountsDB state.AccountsAdapter
-	ChanceComputer      sharding.ChanceComputer
-	EpochNotifier       process.EpochNotifier
+	ArgBlockChainHook             hooks.ArgBlockChainHook
+	Economics                     process.EconomicsDataHandler
+	MessageSignVerifier           vm.MessageSignVerifier
+	GasSchedule                   core.GasScheduleNotifier
+	NodesConfigProvider           vm.NodesConfigProvider
+	Hasher                        hashing.Hasher
+	Marshalizer                   marshal.Marshalizer
+	SystemSCConfig                *config.SystemSmartContractsConfig
+	ValidatorAccountsDB           state.AccountsAdapter
+	ChanceComputer                sharding.ChanceComputer
+	EpochNotifier                 process.EpochNotifier
+	ESDTNFTEnableEpoch            uint32
+	ESDTSpecialRolesEnableEpoch   uint32
+	ESDTSupplyTrackingEnableEpoch uint32
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -94,19 +100,22 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 	cryptoHook := hooks.NewVMCryptoHook()
 
 	return &vmContainerFactory{
-		blockChainHookImpl:     blockChainHookImpl,
-		cryptoHook:             cryptoHook,
-		economics:              args.Economics,
-		messageSigVerifier:     args.MessageSignVerifier,
-		gasSchedule:            args.GasSchedule,
-		nodesConfigProvider:    args.NodesConfigProvider,
-		hasher:                 args.Hasher,
-		marshalizer:            args.Marshalizer,
-		systemSCConfig:         args.SystemSCConfig,
-		validatorAccountsDB:    args.ValidatorAccountsDB,
-		chanceComputer:         args.ChanceComputer,
-		epochNotifier:          args.EpochNotifier,
-		addressPubKeyConverter: args.ArgBlockChainHook.PubkeyConv,
+		blockChainHookImpl:            blockChainHookImpl,
+		cryptoHook:                    cryptoHook,
+		economics:                     args.Economics,
+		messageSigVerifier:            args.MessageSignVerifier,
+		gasSchedule:                   args.GasSchedule,
+		nodesConfigProvider:           args.NodesConfigProvider,
+		hasher:                        args.Hasher,
+		marshalizer:                   args.Marshalizer,
+		systemSCConfig:                args.SystemSCConfig,
+		validatorAccountsDB:           args.ValidatorAccountsDB,
+		chanceComputer:                args.ChanceComputer,
+		epochNotifier:                 args.EpochNotifier,
+		addressPubKeyConverter:        args.ArgBlockChainHook.PubkeyConv,
+		esdtNFTEnableEpoch:            args.ESDTNFTEnableEpoch,
+		esdtSpecialRolesEnableEpoch:   args.ESDTSpecialRolesEnableEpoch,
+		esdtSupplyTrackingEnableEpoch: args.ESDTSupplyTrackingEnableEpoch,
 	}, nil
 }
 
@@ -161,16 +170,19 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 	}
 
 	argsNewSystemScFactory := systemVMFactory.ArgsNewSystemSCFactory{
-		SystemEI:               systemEI,
-		SigVerifier:            vmf.messageSigVerifier,
-		GasSchedule:            vmf.gasSchedule,
-		NodesConfigProvider:    vmf.nodesConfigProvider,
-		Hasher:                 vmf.hasher,
-		Marshalizer:            vmf.marshalizer,
-		SystemSCConfig:         vmf.systemSCConfig,
-		Economics:              vmf.economics,
-		EpochNotifier:          vmf.epochNotifier,
-		AddressPubKeyConverter: vmf.addressPubKeyConverter,
+		SystemEI:                      systemEI,
+		SigVerifier:                   vmf.messageSigVerifier,
+		GasSchedule:                   vmf.gasSchedule,
+		NodesConfigProvider:           vmf.nodesConfigProvider,
+		Hasher:                        vmf.hasher,
+		Marshalizer:                   vmf.marshalizer,
+		SystemSCConfig:                vmf.systemSCConfig,
+		Economics:                     vmf.economics,
+		EpochNotifier:                 vmf.epochNotifier,
+		AddressPubKeyConverter:        vmf.addressPubKeyConverter,
+		ESDTNFTEnableEpoch:            vmf.esdtNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   vmf.esdtSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: vmf.esdtSupplyTrackingEnableEpoch,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
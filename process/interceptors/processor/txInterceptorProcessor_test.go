@@ -8,10 +8,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -167,6 +170,86 @@ func TestTxInterceptorProcessor_SaveShouldWork(t *testing.T) {
 	assert.True(t, addedWasCalled)
 }
 
+func TestTxInterceptorProcessor_SaveShouldReplaceTransactionWithSameNonceAndHigherGasPrice(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageUnit.CacheConfig{
+			Capacity:                          100,
+			SizePerSender:                     10,
+			SizeInBytes:                       409600,
+			SizeInBytesPerSender:              40960,
+			Shards:                            1,
+			ReplacementGasPriceBumpPercentage: 10,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      1000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 1,
+		SelfShardID:    0,
+	})
+	arg := createMockTxArgument()
+	arg.ShardedDataCache = txPool
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	initialTx := createInterceptedTxWithGasPrice([]byte("initial"), 7, 1000000000)
+	err := txip.Save(initialTx, "", "")
+	assert.Nil(t, err)
+
+	bumpedTx := createInterceptedTxWithGasPrice([]byte("bumped"), 7, 1100000000)
+	err = txip.Save(bumpedTx, "", "")
+	assert.Nil(t, err)
+
+	_, found := txPool.SearchFirstData([]byte("initial"))
+	assert.False(t, found)
+	tx, found := txPool.SearchFirstData([]byte("bumped"))
+	assert.True(t, found)
+	assert.Equal(t, bumpedTx.Transaction(), tx)
+	assert.Equal(t, int64(1), txPool.GetCounts().GetTotal())
+}
+
+func createInterceptedTxWithGasPrice(hash []byte, nonce uint64, gasPrice uint64) *struct {
+	mock.InterceptedDataStub
+	mock.InterceptedTxHandlerStub
+} {
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  []byte("alice"),
+		GasPrice: gasPrice,
+		GasLimit: 50000,
+	}
+
+	return &struct {
+		mock.InterceptedDataStub
+		mock.InterceptedTxHandlerStub
+	}{
+		InterceptedDataStub: mock.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return hash
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 0
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 0
+			},
+			NonceCalled: func() uint64 {
+				return nonce
+			},
+			SenderAddressCalled: func() []byte {
+				return tx.SndAddr
+			},
+			TransactionCalled: func() data.TransactionHandler {
+				return tx
+			},
+		},
+	}
+}
+
 //------- IsInterfaceNil
 
 func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
@@ -451,6 +451,10 @@ type BlockChainHookHandler interface {
 	GetBuiltInFunctions() BuiltInFunctionContainer
 	NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error)
 	DeleteCompiledCode(codeHash []byte)
+	GetHeaderByNonce(nonce uint64) (data.HeaderHandler, error)
+	GetHeaderByHash(hash []byte) (data.HeaderHandler, error)
+	UseStateAtRootHash(rootHash []byte) error
+	UseCurrentState()
 	IsInterfaceNil() bool
 }
 
@@ -672,12 +676,16 @@ type NetworkConnectionWatcher interface {
 }
 
 // SCQuery represents a prepared query for executing a function of the smart contract
+// When a block nonce or a block hash is provided, the query is executed against the state at that block
 type SCQuery struct {
-	ScAddress  []byte
-	FuncName   string
-	CallerAddr []byte
-	CallValue  *big.Int
-	Arguments  [][]byte
+	ScAddress     []byte
+	FuncName      string
+	CallerAddr    []byte
+	CallValue     *big.Int
+	Arguments     [][]byte
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -874,6 +882,7 @@ type MiniBlockProvider interface {
 type BuiltinFunction interface {
 	ProcessBuiltinFunction(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
 	SetNewGasConfig(gasCost *GasCost)
+	IsActive() bool
 	IsInterfaceNil() bool
 }
 
@@ -1013,6 +1022,13 @@ type ESDTPauseHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ESDTRoleHandler provides IsAllowedToExecute function for an ESDT token
+type ESDTRoleHandler interface {
+	CheckAllowedToExecute(account state.UserAccountHandler, tokenID []byte, action []byte) error
+	GetTokenType(account state.UserAccountHandler, tokenID []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
 // PayableHandler provides IsPayable function which returns if an account is payable or not
 type PayableHandler interface {
 	IsPayable(address []byte) (bool, error)
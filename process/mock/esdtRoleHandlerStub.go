@@ -0,0 +1,30 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/state"
+
+// ESDTRoleHandlerStub -
+type ESDTRoleHandlerStub struct {
+	CheckAllowedToExecuteCalled func(account state.UserAccountHandler, tokenID []byte, action []byte) error
+	GetTokenTypeCalled          func(account state.UserAccountHandler, tokenID []byte) ([]byte, error)
+}
+
+// CheckAllowedToExecute -
+func (e *ESDTRoleHandlerStub) CheckAllowedToExecute(account state.UserAccountHandler, tokenID []byte, action []byte) error {
+	if e.CheckAllowedToExecuteCalled != nil {
+		return e.CheckAllowedToExecuteCalled(account, tokenID, action)
+	}
+	return nil
+}
+
+// GetTokenType -
+func (e *ESDTRoleHandlerStub) GetTokenType(account state.UserAccountHandler, tokenID []byte) ([]byte, error) {
+	if e.GetTokenTypeCalled != nil {
+		return e.GetTokenTypeCalled(account, tokenID)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (e *ESDTRoleHandlerStub) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,29 @@
+package builtInFunctions
+
+// baseAlwaysActive is embedded by the built-in functions which are active since the built-in functions were enabled
+type baseAlwaysActive struct {
+}
+
+// IsActive returns true as the function is always active
+func (b baseAlwaysActive) IsActive() bool {
+	return true
+}
+
+// baseActiveHandler is embedded by the built-in functions which are activated at a later epoch, the activation
+// being decided by the provided handler
+type baseActiveHandler struct {
+	activeHandler func() bool
+}
+
+// IsActive returns true if the function is active. A function without an active handler is considered active.
+func (b *baseActiveHandler) IsActive() bool {
+	if b.activeHandler == nil {
+		return true
+	}
+
+	return b.activeHandler()
+}
+
+func (b *baseActiveHandler) setActiveHandler(handler func() bool) {
+	b.activeHandler = handler
+}
@@ -2,6 +2,7 @@ package builtInFunctions
 
 import (
 	"bytes"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -15,10 +16,13 @@ import (
 var _ process.BuiltinFunction = (*esdtFreezeWipe)(nil)
 
 type esdtFreezeWipe struct {
+	baseAlwaysActive
 	marshalizer marshal.Marshalizer
 	keyPrefix   []byte
 	wipe        bool
 	freeze      bool
+
+	supplyTrackingHandler func() bool
 }
 
 // NewESDTFreezeWipeFunc returns the esdt freeze/un-freeze/wipe built-in function component
@@ -69,11 +73,15 @@ func (e *esdtFreezeWipe) ProcessBuiltinFunction(
 	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
 	log.Trace(vmInput.Function, "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "token", esdtTokenKey)
 
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
 	if e.wipe {
-		err := e.wipeIfApplicable(acntDst, esdtTokenKey)
+		wipedValue, err := e.wipeIfApplicable(acntDst, esdtTokenKey)
 		if err != nil {
 			return nil, err
 		}
+		if wipedValue != nil && wipedValue.Cmp(zero) > 0 && e.isSupplyTrackingEnabled() {
+			addSupplyChangeToVMOutput(core.BuiltInFunctionESDTWipe, vmInput.Arguments[0], wipedValue, vmOutput)
+		}
 	} else {
 		err := e.toggleFreeze(acntDst, esdtTokenKey)
 		if err != nil {
@@ -81,22 +89,26 @@ func (e *esdtFreezeWipe) ProcessBuiltinFunction(
 		}
 	}
 
-	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
 	return vmOutput, nil
 }
 
-func (e *esdtFreezeWipe) wipeIfApplicable(acntDst state.UserAccountHandler, tokenKey []byte) error {
+func (e *esdtFreezeWipe) wipeIfApplicable(acntDst state.UserAccountHandler, tokenKey []byte) (*big.Int, error) {
 	tokenData, err := getESDTDataFromKey(acntDst, tokenKey, e.marshalizer)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	esdtUserMetadata := ESDTUserMetadataFromBytes(tokenData.Properties)
 	if !esdtUserMetadata.Frozen {
-		return process.ErrCannotWipeAccountNotFrozen
+		return nil, process.ErrCannotWipeAccountNotFrozen
+	}
+
+	err = acntDst.DataTrieTracker().SaveKeyValue(tokenKey, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return acntDst.DataTrieTracker().SaveKeyValue(tokenKey, nil)
+	return tokenData.Value, nil
 }
 
 func (e *esdtFreezeWipe) toggleFreeze(acntDst state.UserAccountHandler, tokenKey []byte) error {
@@ -117,6 +129,20 @@ func (e *esdtFreezeWipe) toggleFreeze(acntDst state.UserAccountHandler, tokenKey
 	return nil
 }
 
+func (e *esdtFreezeWipe) setSupplyTrackingHandler(handler func() bool) {
+	e.supplyTrackingHandler = handler
+}
+
+// isSupplyTrackingEnabled returns true if the wiped value should be reported to the ESDT system SC. A function without
+// a supply tracking handler always reports it.
+func (e *esdtFreezeWipe) isSupplyTrackingEnabled() bool {
+	if e.supplyTrackingHandler == nil {
+		return true
+	}
+
+	return e.supplyTrackingHandler()
+}
+
 // IsInterfaceNil returns true if underlying object in nil
 func (e *esdtFreezeWipe) IsInterfaceNil() bool {
 	return e == nil
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -11,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestESDTFreezeWipe_ProcessBuiltInFunctionErrors(t *testing.T) {
@@ -132,3 +134,64 @@ func TestESDTFreezeWipe_ProcessBuiltInFunction(t *testing.T) {
 	marshaledData, _ = acnt.DataTrieTracker().RetrieveValue(esdtKey)
 	assert.Equal(t, 0, len(marshaledData))
 }
+
+func TestESDTFreezeWipe_ProcessBuiltInFunctionWipeShouldNotifyESDTSC(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID},
+		},
+		RecipientAddr: []byte("dst"),
+	}
+
+	wipe, _ := NewESDTFreezeWipeFunc(marshalizer, false, true)
+	acnt, _ := state.NewUserAccount(input.RecipientAddr)
+	metaData := ESDTUserMetadata{Frozen: true}
+	esdtToken := &esdt.ESDigitalToken{
+		Value:      big.NewInt(100),
+		Properties: metaData.ToBytes(),
+	}
+	esdtTokenBytes, _ := marshalizer.Marshal(esdtToken)
+	_ = acnt.DataTrieTracker().SaveKeyValue(append(wipe.keyPrefix, tokenID...), esdtTokenBytes)
+
+	vmOutput, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	checkSupplyChangeOutput(t, vmOutput, core.BuiltInFunctionESDTWipe, tokenID, 100)
+}
+
+func TestESDTFreezeWipe_ProcessBuiltInFunctionWipeBeforeSupplyTrackingShouldNotNotifyESDTSC(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID},
+		},
+		RecipientAddr: []byte("dst"),
+	}
+
+	wipe, _ := NewESDTFreezeWipeFunc(marshalizer, false, true)
+	wipe.setSupplyTrackingHandler(func() bool {
+		return false
+	})
+	acnt, _ := state.NewUserAccount(input.RecipientAddr)
+	metaData := ESDTUserMetadata{Frozen: true}
+	esdtToken := &esdt.ESDigitalToken{
+		Value:      big.NewInt(100),
+		Properties: metaData.ToBytes(),
+	}
+	esdtTokenBytes, _ := marshalizer.Marshal(esdtToken)
+	_ = acnt.DataTrieTracker().SaveKeyValue(append(wipe.keyPrefix, tokenID...), esdtTokenBytes)
+
+	vmOutput, err := wipe.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+}
@@ -0,0 +1,98 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtLocalBurn)(nil)
+
+type esdtLocalBurn struct {
+	baseActiveHandler
+	keyPrefix    []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	rolesHandler process.ESDTRoleHandler
+	funcGasCost  uint64
+	mutExecution sync.RWMutex
+}
+
+// NewESDTLocalBurnFunc returns the esdt local burn built-in function component
+func NewESDTLocalBurnFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+) (*esdtLocalBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+
+	e := &esdtLocalBurn{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		rolesHandler: rolesHandler,
+		funcGasCost:  funcGasCost,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtLocalBurn) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTLocalBurn
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT local burn function call
+// Arguments: tokenID and the value to burn
+func (e *esdtLocalBurn) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput, e.rolesHandler, core.ESDTRoleLocalBurn, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	log.Trace("esdtLocalBurn", "sender", vmInput.CallerAddr, "value", value, "token", esdtTokenKey)
+
+	err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	addSupplyChangeToVMOutput(core.BuiltInFunctionESDTLocalBurn, tokenID, value, vmOutput)
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLocalBurn) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,73 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTLocalBurnFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	localBurn, err := NewESDTLocalBurnFunc(0, nil, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(localBurn))
+
+	localBurn, err = NewESDTLocalBurnFunc(0, &mock.MarshalizerMock{}, nil, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.True(t, check.IfNil(localBurn))
+
+	localBurn, err = NewESDTLocalBurnFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+	assert.True(t, check.IfNil(localBurn))
+}
+
+func TestESDTLocalBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	rolesHandler := &mock.ESDTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ state.UserAccountHandler, _ []byte, action []byte) error {
+			assert.Equal(t, []byte(core.ESDTRoleLocalBurn), action)
+			return process.ErrActionNotAllowed
+		},
+	}
+	localBurn, _ := NewESDTLocalBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesHandler)
+	acnt, _ := state.NewUserAccount(caller)
+	addFungibleBalance(t, acnt, tokenID, 10)
+
+	input := createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalBurn, tokenID, 5)
+	_, err := localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	rolesHandler.CheckAllowedToExecuteCalled = nil
+	input = createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalBurn, tokenID, 11)
+	_, err = localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+	assert.Equal(t, big.NewInt(10), getFungibleBalance(t, acnt, tokenID))
+}
+
+func TestESDTLocalBurn_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	localBurn, _ := NewESDTLocalBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	acnt, _ := state.NewUserAccount(caller)
+	addFungibleBalance(t, acnt, tokenID, 10)
+
+	input := createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalBurn, tokenID, 4)
+	vmOutput, err := localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-10, vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(6), getFungibleBalance(t, acnt, tokenID))
+	checkSupplyChangeOutput(t, vmOutput, core.BuiltInFunctionESDTLocalBurn, tokenID, 4)
+}
@@ -0,0 +1,131 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.BuiltinFunction = (*esdtLocalMint)(nil)
+
+type esdtLocalMint struct {
+	baseActiveHandler
+	keyPrefix    []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	rolesHandler process.ESDTRoleHandler
+	funcGasCost  uint64
+	mutExecution sync.RWMutex
+}
+
+// NewESDTLocalMintFunc returns the esdt local mint built-in function component
+func NewESDTLocalMintFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+) (*esdtLocalMint, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+
+	e := &esdtLocalMint{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		rolesHandler: rolesHandler,
+		funcGasCost:  funcGasCost,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtLocalMint) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTLocalMint
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT local mint function call
+// Arguments: tokenID and the value to mint
+func (e *esdtLocalMint) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput, e.rolesHandler, core.ESDTRoleLocalMint, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	log.Trace("esdtLocalMint", "sender", vmInput.CallerAddr, "value", value, "token", esdtTokenKey)
+
+	err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	addSupplyChangeToVMOutput(core.BuiltInFunctionESDTLocalMint, tokenID, value, vmOutput)
+
+	return vmOutput, nil
+}
+
+// checkInputArgumentsForLocalAction checks the common input of the local mint and burn built-in functions, which are
+// executed by the caller on its own account, given that it holds the required role for the token
+func checkInputArgumentsForLocalAction(
+	acntSnd state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	rolesHandler process.ESDTRoleHandler,
+	role string,
+	funcGasCost uint64,
+) error {
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput)
+	if err != nil {
+		return err
+	}
+	if len(vmInput.Arguments) != 2 {
+		return process.ErrInvalidArguments
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return process.ErrNegativeValue
+	}
+
+	err = rolesHandler.CheckAllowedToExecute(acntSnd, vmInput.Arguments[0], []byte(role))
+	if err != nil {
+		return err
+	}
+	if vmInput.GasProvided < funcGasCost {
+		return process.ErrNotEnoughGas
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLocalMint) IsInterfaceNil() bool {
+	return e == nil
+}
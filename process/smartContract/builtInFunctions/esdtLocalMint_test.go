@@ -0,0 +1,126 @@
+package builtInFunctions
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLocalMintBurnInput(caller []byte, function string, tokenID []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments:   [][]byte{tokenID, big.NewInt(value).Bytes()},
+		},
+		RecipientAddr: caller,
+		Function:      function,
+	}
+}
+
+func checkSupplyChangeOutput(t *testing.T, vmOutput *vmcommon.VMOutput, function string, tokenID []byte, value int64) {
+	outAcc, ok := vmOutput.OutputAccounts[string(vm.ESDTSCAddress)]
+	require.True(t, ok)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+
+	expectedData := function + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(big.NewInt(value).Bytes())
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+	assert.Equal(t, uint64(0), outAcc.OutputTransfers[0].GasLimit)
+	assert.Equal(t, vmcommon.ESDTSupplyChangeCall, outAcc.OutputTransfers[0].CallType)
+}
+
+func TestNewESDTLocalMintFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	localMint, err := NewESDTLocalMintFunc(0, nil, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(localMint))
+
+	localMint, err = NewESDTLocalMintFunc(0, &mock.MarshalizerMock{}, nil, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.True(t, check.IfNil(localMint))
+
+	localMint, err = NewESDTLocalMintFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+	assert.True(t, check.IfNil(localMint))
+}
+
+func TestESDTLocalMint_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	rolesHandler := &mock.ESDTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ state.UserAccountHandler, _ []byte, action []byte) error {
+			assert.Equal(t, []byte(core.ESDTRoleLocalMint), action)
+			return process.ErrActionNotAllowed
+		},
+	}
+	pauseHandler := &mock.PauseHandlerStub{}
+	localMint, _ := NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, pauseHandler, rolesHandler)
+	acnt, _ := state.NewUserAccount(caller)
+
+	_, err := localMint.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalMint, tokenID, 5)
+	input.RecipientAddr = []byte("other")
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalMint, tokenID, 5)
+	_, err = localMint.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	input.Arguments = input.Arguments[:1]
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalMint, tokenID, 0)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input = createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalMint, tokenID, 5)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	rolesHandler.CheckAllowedToExecuteCalled = nil
+	input.GasProvided = 1
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	pauseHandler.IsPausedCalled = func(_ []byte) bool {
+		return true
+	}
+	input.GasProvided = 1000
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+}
+
+func TestESDTLocalMint_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	localMint, _ := NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	acnt, _ := state.NewUserAccount(caller)
+	addFungibleBalance(t, acnt, tokenID, 10)
+
+	input := createLocalMintBurnInput(caller, core.BuiltInFunctionESDTLocalMint, tokenID, 5)
+	vmOutput, err := localMint.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-10, vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(15), getFungibleBalance(t, acnt, tokenID))
+	checkSupplyChangeOutput(t, vmOutput, core.BuiltInFunctionESDTLocalMint, tokenID, 5)
+}
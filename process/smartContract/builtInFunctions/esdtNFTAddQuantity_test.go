@@ -0,0 +1,106 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTNonceQuantityInput(caller []byte, function string, tokenID []byte, nonce uint64, quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments:   [][]byte{tokenID, big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(quantity).Bytes()},
+		},
+		RecipientAddr: caller,
+		Function:      function,
+	}
+}
+
+func createAccountWithNFT(t *testing.T, address []byte, tokenID []byte, quantity int64) state.UserAccountHandler {
+	nftCreate, _ := NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	acnt, _ := state.NewUserAccount(address)
+	_, err := nftCreate.ProcessBuiltinFunction(acnt, nil, createNFTCreateInput(address, tokenID, quantity))
+	require.Nil(t, err)
+
+	return acnt
+}
+
+func TestNewESDTNFTAddQuantityFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftAdd, err := NewESDTNFTAddQuantityFunc(0, nil, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(nftAdd))
+
+	nftAdd, err = NewESDTNFTAddQuantityFunc(0, &mock.MarshalizerMock{}, nil, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.True(t, check.IfNil(nftAdd))
+
+	nftAdd, err = NewESDTNFTAddQuantityFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+	assert.True(t, check.IfNil(nftAdd))
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	rolesHandler := &mock.ESDTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ state.UserAccountHandler, _ []byte, action []byte) error {
+			assert.Equal(t, []byte(core.ESDTRoleNFTAddQuantity), action)
+			return process.ErrActionNotAllowed
+		},
+	}
+	nftAdd, _ := NewESDTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesHandler)
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	input := createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTAddQuantity, tokenID, 1, 5)
+	_, err := nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	rolesHandler.CheckAllowedToExecuteCalled = nil
+	input.Arguments = input.Arguments[:2]
+	_, err = nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTAddQuantity, tokenID, 1, 0)
+	_, err = nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input = createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTAddQuantity, tokenID, 2, 5)
+	_, err = nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	input = createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTAddQuantity, tokenID, 0, 5)
+	_, err = nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
+
+func TestESDTNFTAddQuantity_ProcessBuiltInFunctionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	nftAdd, _ := NewESDTNFTAddQuantityFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	input := createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTAddQuantity, tokenID, 1, 5)
+	vmOutput, err := nftAdd.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-10, vmOutput.GasRemaining)
+
+	sft := getNFTFromAccount(t, acnt, tokenID, 1)
+	assert.Equal(t, big.NewInt(7), sft.Value)
+}
@@ -0,0 +1,88 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTNFTBurnFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftBurn, err := NewESDTNFTBurnFunc(0, nil, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(nftBurn))
+
+	nftBurn, err = NewESDTNFTBurnFunc(0, &mock.MarshalizerMock{}, nil, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.True(t, check.IfNil(nftBurn))
+
+	nftBurn, err = NewESDTNFTBurnFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+	assert.True(t, check.IfNil(nftBurn))
+}
+
+func TestESDTNFTBurn_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	rolesHandler := &mock.ESDTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ state.UserAccountHandler, _ []byte, action []byte) error {
+			assert.Equal(t, []byte(core.ESDTRoleNFTBurn), action)
+			return process.ErrActionNotAllowed
+		},
+	}
+	pauseHandler := &mock.PauseHandlerStub{}
+	nftBurn, _ := NewESDTNFTBurnFunc(10, &mock.MarshalizerMock{}, pauseHandler, rolesHandler)
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	input := createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTBurn, tokenID, 1, 1)
+	_, err := nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	rolesHandler.CheckAllowedToExecuteCalled = nil
+	input.GasProvided = 1
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	pauseHandler.IsPausedCalled = func(token []byte) bool {
+		return true
+	}
+	input.GasProvided = 1000
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrESDTTokenIsPaused, err)
+
+	pauseHandler.IsPausedCalled = nil
+	input = createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTBurn, tokenID, 1, 3)
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestESDTNFTBurn_ProcessBuiltInFunctionShouldBurnAndDeleteWhenEmpty(t *testing.T) {
+	t.Parallel()
+
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	nftBurn, _ := NewESDTNFTBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	input := createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTBurn, tokenID, 1, 1)
+	vmOutput, err := nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-10, vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(1), getNFTFromAccount(t, acnt, tokenID, 1).Value)
+
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
@@ -0,0 +1,306 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const maxRoyalties = 10000
+
+var _ process.BuiltinFunction = (*esdtNFTCreate)(nil)
+
+type esdtNFTCreate struct {
+	baseActiveHandler
+	keyPrefix    []byte
+	noncePrefix  []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	rolesHandler process.ESDTRoleHandler
+	funcGasCost  uint64
+	gasConfig    process.BaseOperationCost
+	mutExecution sync.RWMutex
+}
+
+// NewESDTNFTCreateFunc returns the esdt NFT create built-in function component
+func NewESDTNFTCreateFunc(
+	funcGasCost uint64,
+	gasConfig process.BaseOperationCost,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	rolesHandler process.ESDTRoleHandler,
+) (*esdtNFTCreate, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(rolesHandler) {
+		return nil, process.ErrNilRolesHandler
+	}
+
+	e := &esdtNFTCreate{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		noncePrefix:  []byte(core.ElrondProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		rolesHandler: rolesHandler,
+		funcGasCost:  funcGasCost,
+		gasConfig:    gasConfig,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTCreate) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTCreate
+	e.gasConfig = gasCost.BaseOperationCost
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT create function call
+// Arguments: tokenID, quantity, name, royalties, hash, attributes and one or more URIs
+func (e *esdtNFTCreate) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < 7 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = e.rolesHandler.CheckAllowedToExecute(acntSnd, tokenID, []byte(core.ESDTRoleNFTCreate))
+	if err != nil {
+		return nil, err
+	}
+
+	totalLength := uint64(0)
+	for _, arg := range vmInput.Arguments {
+		totalLength += uint64(len(arg))
+	}
+	gasToUse := totalLength*e.gasConfig.StorePerByte + e.funcGasCost
+	if vmInput.GasProvided < gasToUse {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	royalties := big.NewInt(0).SetBytes(vmInput.Arguments[3])
+	if !royalties.IsUint64() || royalties.Uint64() > maxRoyalties {
+		return nil, process.ErrInvalidRoyalties
+	}
+
+	tokenType, err := e.rolesHandler.GetTokenType(acntSnd, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	esdtType := core.NonFungible
+	if bytes.Equal(tokenType, []byte(core.SemiFungibleESDT)) {
+		esdtType = core.SemiFungible
+	}
+	if esdtType == core.NonFungible && quantity.Cmp(big.NewInt(1)) != 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	err = checkFrozeAndPauseForNFT(vmInput.CallerAddr, acntSnd, esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceKey := append(e.noncePrefix, tokenID...)
+	nonce, err := getLatestNonce(acntSnd, nonceKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce++
+
+	esdtData := &esdt.ESDigitalToken{
+		Type:  uint32(esdtType),
+		Value: quantity,
+		TokenMetaData: &esdt.MetaData{
+			Nonce:      nonce,
+			Name:       vmInput.Arguments[2],
+			Creator:    vmInput.CallerAddr,
+			Royalties:  uint32(royalties.Uint64()),
+			Hash:       vmInput.Arguments[4],
+			Attributes: vmInput.Arguments[5],
+			URIs:       vmInput.Arguments[6:],
+		},
+	}
+
+	log.Trace("esdtNFTCreate", "sender", vmInput.CallerAddr, "token", esdtTokenKey, "nonce", nonce, "quantity", quantity)
+
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveLatestNonce(acntSnd, nonceKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		ReturnData:   [][]byte{big.NewInt(0).SetUint64(nonce).Bytes()},
+	}
+
+	return vmOutput, nil
+}
+
+func checkESDTNFTCreateBurnAddInput(
+	account state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrInvalidRcvAddr
+	}
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+
+	return nil
+}
+
+func getLatestNonce(acnt state.UserAccountHandler, nonceKey []byte) (uint64, error) {
+	nonceData, err := acnt.DataTrieTracker().RetrieveValue(nonceKey)
+	if err != nil || len(nonceData) == 0 {
+		return 0, nil
+	}
+
+	return big.NewInt(0).SetBytes(nonceData).Uint64(), nil
+}
+
+func saveLatestNonce(acnt state.UserAccountHandler, nonceKey []byte, nonce uint64) error {
+	return acnt.DataTrieTracker().SaveKeyValue(nonceKey, big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+func computeESDTNFTTokenKey(esdtTokenKey []byte, nonce uint64) []byte {
+	return append(esdtTokenKey, big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+func getESDTNFTTokenOnSender(
+	accnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDigitalToken, error) {
+	if nonce == 0 {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	esdtData, isNew, err := getESDTNFTTokenOnDestination(accnt, esdtTokenKey, nonce, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	return esdtData, nil
+}
+
+func getESDTNFTTokenOnDestination(
+	accnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdt.ESDigitalToken, bool, error) {
+	esdtNFTTokenKey := computeESDTNFTTokenKey(esdtTokenKey, nonce)
+	esdtData := &esdt.ESDigitalToken{Value: big.NewInt(0), Type: uint32(core.Fungible)}
+	marshaledData, err := accnt.DataTrieTracker().RetrieveValue(esdtNFTTokenKey)
+	if err != nil || len(marshaledData) == 0 {
+		return esdtData, true, nil
+	}
+
+	err = marshalizer.Unmarshal(esdtData, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return esdtData, false, nil
+}
+
+func saveESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	esdtData *esdt.ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+) error {
+	if esdtData.TokenMetaData == nil {
+		return process.ErrNFTDoesNotHaveMetadata
+	}
+
+	esdtNFTTokenKey := computeESDTNFTTokenKey(esdtTokenKey, esdtData.TokenMetaData.Nonce)
+	if esdtData.Value.Cmp(zero) <= 0 {
+		return acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, nil)
+	}
+
+	marshaledData, err := marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	return acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, marshaledData)
+}
+
+func checkFrozeAndPauseForNFT(
+	senderAddr []byte,
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) error {
+	if bytes.Equal(senderAddr, vm.ESDTSCAddress) {
+		return nil
+	}
+
+	esdtData, err := getESDTDataFromKey(acnt, esdtTokenKey, marshalizer)
+	if err != nil {
+		return err
+	}
+
+	esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
+	if esdtUserMetaData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+	if pauseHandler.IsPaused(esdtTokenKey) {
+		return process.ErrESDTTokenIsPaused
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTCreate) IsInterfaceNil() bool {
+	return e == nil
+}
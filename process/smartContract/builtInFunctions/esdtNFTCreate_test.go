@@ -0,0 +1,186 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTCreateInput(caller []byte, tokenID []byte, quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				tokenID,
+				big.NewInt(quantity).Bytes(),
+				[]byte("name"),
+				big.NewInt(100).Bytes(),
+				[]byte("hash"),
+				[]byte("attributes"),
+				[]byte("uri"),
+			},
+		},
+		RecipientAddr: caller,
+		Function:      core.BuiltInFunctionESDTNFTCreate,
+	}
+}
+
+func getNFTFromAccount(
+	t *testing.T,
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	nonce uint64,
+) *esdt.ESDigitalToken {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	esdtData, isNew, err := getESDTNFTTokenOnDestination(acnt, esdtTokenKey, nonce, &mock.MarshalizerMock{})
+	require.Nil(t, err)
+	require.False(t, isNew)
+
+	return esdtData
+}
+
+func TestNewESDTNFTCreateFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreate, err := NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, nil, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(nftCreate))
+
+	nftCreate, err = NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, nil, &mock.ESDTRoleHandlerStub{})
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.True(t, check.IfNil(nftCreate))
+
+	nftCreate, err = NewESDTNFTCreateFunc(0, process.BaseOperationCost{}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	assert.Equal(t, process.ErrNilRolesHandler, err)
+	assert.True(t, check.IfNil(nftCreate))
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	rolesHandler := &mock.ESDTRoleHandlerStub{
+		CheckAllowedToExecuteCalled: func(_ state.UserAccountHandler, _ []byte, _ []byte) error {
+			return process.ErrActionNotAllowed
+		},
+	}
+	nftCreate, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesHandler)
+	acnt, _ := state.NewUserAccount([]byte("caller"))
+
+	_, err := nftCreate.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createNFTCreateInput([]byte("caller"), []byte("token"), 1)
+	input.RecipientAddr = []byte("other")
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input.RecipientAddr = input.CallerAddr
+	_, err = nftCreate.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	rolesHandler.CheckAllowedToExecuteCalled = nil
+	input.GasProvided = 1
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input.GasProvided = 1000
+	input.Arguments[3] = big.NewInt(maxRoyalties + 1).Bytes()
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRoyalties, err)
+
+	royaltiesOverflow, _ := big.NewInt(0).SetString("10000000000000064", 16)
+	input.Arguments[3] = royaltiesOverflow.Bytes()
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidRoyalties, err)
+
+	input.Arguments[3] = big.NewInt(100).Bytes()
+	input.Arguments[1] = big.NewInt(2).Bytes()
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input.Arguments[1] = big.NewInt(0).Bytes()
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	input.Arguments = input.Arguments[:6]
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionShouldCreateWithIncreasingNonces(t *testing.T) {
+	t.Parallel()
+
+	tokenType := []byte(core.NonFungibleESDT)
+	rolesHandler := &mock.ESDTRoleHandlerStub{
+		GetTokenTypeCalled: func(_ state.UserAccountHandler, _ []byte) ([]byte, error) {
+			return tokenType, nil
+		},
+	}
+	nftCreate, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{StorePerByte: 1}, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, rolesHandler)
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount(caller)
+
+	input := createNFTCreateInput(caller, tokenID, 1)
+	vmOutput, err := nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(1).Bytes()}, vmOutput.ReturnData)
+
+	totalLength := uint64(0)
+	for _, arg := range input.Arguments {
+		totalLength += uint64(len(arg))
+	}
+	assert.Equal(t, input.GasProvided-totalLength-10, vmOutput.GasRemaining)
+
+	input = createNFTCreateInput(caller, tokenID, 5)
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidNFTQuantity, err)
+
+	tokenType = []byte(core.SemiFungibleESDT)
+	vmOutput, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{big.NewInt(2).Bytes()}, vmOutput.ReturnData)
+
+	nft := getNFTFromAccount(t, acnt, tokenID, 1)
+	assert.Equal(t, uint32(core.NonFungible), nft.Type)
+	assert.Equal(t, big.NewInt(1), nft.Value)
+	assert.Equal(t, caller, nft.TokenMetaData.Creator)
+	assert.Equal(t, uint32(100), nft.TokenMetaData.Royalties)
+	assert.Equal(t, [][]byte{[]byte("uri")}, nft.TokenMetaData.URIs)
+
+	sft := getNFTFromAccount(t, acnt, tokenID, 2)
+	assert.Equal(t, uint32(core.SemiFungible), sft.Type)
+	assert.Equal(t, big.NewInt(5), sft.Value)
+	assert.Equal(t, uint64(2), sft.TokenMetaData.Nonce)
+}
+
+func TestESDTNFTCreate_ProcessBuiltInFunctionFrozenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreate, _ := NewESDTNFTCreateFunc(10, process.BaseOperationCost{}, marshalizer, &mock.PauseHandlerStub{}, &mock.ESDTRoleHandlerStub{})
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount(caller)
+
+	esdtFrozen := ESDTUserMetadata{Frozen: true}
+	marshaledData, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(0), Properties: esdtFrozen.ToBytes()})
+	_ = acnt.DataTrieTracker().SaveKeyValue(append(nftCreate.keyPrefix, tokenID...), marshaledData)
+
+	_, err := nftCreate.ProcessBuiltinFunction(acnt, nil, createNFTCreateInput(caller, tokenID, 1))
+	assert.Equal(t, process.ErrESDTIsFrozenForAccount, err)
+}
@@ -0,0 +1,443 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const argsPerTransfer = 3
+
+var _ process.BuiltinFunction = (*esdtNFTMultiTransfer)(nil)
+
+type esdtTransferEntry struct {
+	tokenID      []byte
+	esdtTokenKey []byte
+	nonce        uint64
+	value        *big.Int
+	esdtData     *esdt.ESDigitalToken
+}
+
+type esdtNFTMultiTransfer struct {
+	baseActiveHandler
+	keyPrefix        []byte
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	funcGasCost      uint64
+	mutExecution     sync.RWMutex
+}
+
+// NewESDTNFTMultiTransferFunc returns the esdt multi transfer built-in function component
+func NewESDTNFTMultiTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+) (*esdtNFTMultiTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	e := &esdtNFTMultiTransfer{
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+		funcGasCost:      funcGasCost,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTMultiTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.MultiESDTNFTTransfer
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT multi transfer function call
+// Arguments on the sender shard: destination address, number of transfers, the (tokenID, nonce, quantity) tuple for each
+// transfer and, optionally, the function and the arguments of the smart contract call to be executed after the transfer
+// Arguments on the destination shard: number of transfers, the (tokenID, nonce, quantity) tuple for each transfer, where
+// the quantity is replaced by the marshaled token data for non-fungible tokens, and the optional smart contract call
+func (e *esdtNFTMultiTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+
+	if check.IfNil(acntSnd) {
+		return e.processMultiTransferOnDestination(acntDst, vmInput)
+	}
+
+	// the multi transfer is a self-sent transaction, the destination being given in the arguments
+	if len(vmInput.Arguments) < 2+argsPerTransfer {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+
+	dstAddress := vmInput.Arguments[0]
+	if len(dstAddress) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidArguments
+	}
+	if bytes.Equal(dstAddress, vmInput.CallerAddr) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+
+	numTransfers, err := getNumOfTransfers(vmInput.Arguments[1], vmInput.Arguments[2:])
+	if err != nil {
+		return nil, err
+	}
+	gasToUse := numTransfers * e.funcGasCost
+	if vmInput.GasProvided < gasToUse {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	endOfTransfers := 2 + numTransfers*argsPerTransfer
+	transfers, err := e.removeTransfersFromSender(vmInput.CallerAddr, acntSnd, vmInput.Arguments[2:endOfTransfers])
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdtNFTMultiTransfer", "sender", vmInput.CallerAddr, "receiver", dstAddress, "numTransfers", numTransfers)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - gasToUse,
+	}
+	isSCCallAfter := core.IsSmartContractAddress(dstAddress) && uint64(len(vmInput.Arguments)) > endOfTransfers
+
+	if e.shardCoordinator.SameShard(vmInput.CallerAddr, dstAddress) {
+		err = e.addTransfersToDestination(vmInput.CallerAddr, dstAddress, transfers)
+		if err != nil {
+			e.revertTransfersOnSender(vmInput.CallerAddr, acntSnd, transfers)
+			return nil, err
+		}
+
+		if isSCCallAfter {
+			addOutPutTransferToVMOutput(
+				string(vmInput.Arguments[endOfTransfers]),
+				vmInput.Arguments[endOfTransfers+1:],
+				dstAddress,
+				vmInput.GasLocked,
+				vmOutput)
+		}
+
+		return vmOutput, nil
+	}
+
+	multiTransferArgs, err := e.createCrossShardArguments(vmInput.Arguments[1], transfers)
+	if err != nil {
+		e.revertTransfersOnSender(vmInput.CallerAddr, acntSnd, transfers)
+		return nil, err
+	}
+	multiTransferArgs = append(multiTransferArgs, vmInput.Arguments[endOfTransfers:]...)
+
+	gasRemaining := uint64(0)
+	gasLocked := vmInput.GasLocked
+	if !isSCCallAfter {
+		// the gas is forwarded to the destination only if there is a smart contract call to be executed
+		gasRemaining = vmOutput.GasRemaining
+		gasLocked = 0
+		vmOutput.GasRemaining = 0
+	}
+
+	addOutPutTransferToVMOutput(
+		core.BuiltInFunctionMultiESDTNFTTransfer,
+		multiTransferArgs,
+		dstAddress,
+		gasLocked,
+		vmOutput)
+	vmOutput.GasRemaining = gasRemaining
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTMultiTransfer) processMultiTransferOnDestination(
+	acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+	if len(vmInput.Arguments) < 1+argsPerTransfer {
+		return nil, process.ErrInvalidArguments
+	}
+
+	numTransfers, err := getNumOfTransfers(vmInput.Arguments[0], vmInput.Arguments[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	endOfTransfers := 1 + numTransfers*argsPerTransfer
+	for i := uint64(1); i < endOfTransfers; i += argsPerTransfer {
+		transfer, errParse := e.parseReceivedTransfer(vmInput.Arguments[i : i+argsPerTransfer])
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		err = e.addTransferToAccount(vmInput.CallerAddr, acntDst, transfer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided,
+	}
+	if core.IsSmartContractAddress(vmInput.RecipientAddr) && uint64(len(vmInput.Arguments)) > endOfTransfers {
+		addOutPutTransferToVMOutput(
+			string(vmInput.Arguments[endOfTransfers]),
+			vmInput.Arguments[endOfTransfers+1:],
+			vmInput.RecipientAddr,
+			vmInput.GasLocked,
+			vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+func getNumOfTransfers(numTransfersBytes []byte, transfersArgs [][]byte) (uint64, error) {
+	numTransfers := big.NewInt(0).SetBytes(numTransfersBytes).Uint64()
+	if numTransfers == 0 {
+		return 0, process.ErrInvalidArguments
+	}
+	if numTransfers > uint64(len(transfersArgs))/argsPerTransfer {
+		return 0, process.ErrInvalidArguments
+	}
+
+	return numTransfers, nil
+}
+
+func (e *esdtNFTMultiTransfer) removeTransfersFromSender(
+	sndAddress []byte,
+	acntSnd state.UserAccountHandler,
+	transfersArgs [][]byte,
+) ([]*esdtTransferEntry, error) {
+	transfers := make([]*esdtTransferEntry, 0, len(transfersArgs)/argsPerTransfer)
+	for i := 0; i < len(transfersArgs); i += argsPerTransfer {
+		transfer, err := e.removeTransferFromSender(sndAddress, acntSnd, transfersArgs[i:i+argsPerTransfer])
+		if err != nil {
+			// all the transfers are reverted as the multi transfer is atomic
+			e.revertTransfersOnSender(sndAddress, acntSnd, transfers)
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
+
+func (e *esdtNFTMultiTransfer) removeTransferFromSender(
+	sndAddress []byte,
+	acntSnd state.UserAccountHandler,
+	transferArgs [][]byte,
+) (*esdtTransferEntry, error) {
+	transfer := &esdtTransferEntry{
+		tokenID:      transferArgs[0],
+		esdtTokenKey: e.computeESDTTokenKey(transferArgs[0]),
+		nonce:        big.NewInt(0).SetBytes(transferArgs[1]).Uint64(),
+		value:        big.NewInt(0).SetBytes(transferArgs[2]),
+	}
+	if transfer.value.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	if transfer.nonce == 0 {
+		err := addToESDTBalance(sndAddress, acntSnd, transfer.esdtTokenKey, big.NewInt(0).Neg(transfer.value), e.marshalizer, e.pauseHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		return transfer, nil
+	}
+
+	err := checkFrozeAndPauseForNFT(sndAddress, acntSnd, transfer.esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData, err := getESDTNFTTokenOnSender(acntSnd, transfer.esdtTokenKey, transfer.nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.Value.Cmp(transfer.value) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	esdtData.Value.Sub(esdtData.Value, transfer.value)
+	err = saveESDTNFTToken(acntSnd, transfer.esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value = big.NewInt(0).Set(transfer.value)
+	transfer.esdtData = esdtData
+
+	return transfer, nil
+}
+
+func (e *esdtNFTMultiTransfer) revertTransfersOnSender(
+	sndAddress []byte,
+	acntSnd state.UserAccountHandler,
+	transfers []*esdtTransferEntry,
+) {
+	for _, transfer := range transfers {
+		err := e.addTransferToAccount(sndAddress, acntSnd, transfer)
+		log.LogIfError(err, "function", "esdtNFTMultiTransfer.revertTransfersOnSender", "token", transfer.tokenID)
+	}
+}
+
+func (e *esdtNFTMultiTransfer) addTransfersToDestination(
+	sndAddress []byte,
+	dstAddress []byte,
+	transfers []*esdtTransferEntry,
+) error {
+	account, err := e.accounts.LoadAccount(dstAddress)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	for _, transfer := range transfers {
+		err = e.addTransferToAccount(sndAddress, userAccount, transfer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.accounts.SaveAccount(userAccount)
+}
+
+func (e *esdtNFTMultiTransfer) addTransferToAccount(
+	sndAddress []byte,
+	userAccount state.UserAccountHandler,
+	transfer *esdtTransferEntry,
+) error {
+	if transfer.nonce == 0 {
+		return addToESDTBalance(sndAddress, userAccount, transfer.esdtTokenKey, transfer.value, e.marshalizer, e.pauseHandler)
+	}
+
+	// the token data is copied as adding the token to an account changes its value
+	esdtData := &esdt.ESDigitalToken{
+		Type:          transfer.esdtData.Type,
+		Value:         big.NewInt(0).Set(transfer.value),
+		Properties:    transfer.esdtData.Properties,
+		TokenMetaData: transfer.esdtData.TokenMetaData,
+	}
+
+	return addNFTToAccount(sndAddress, userAccount, transfer.esdtTokenKey, esdtData, e.marshalizer, e.pauseHandler)
+}
+
+func (e *esdtNFTMultiTransfer) createCrossShardArguments(
+	numTransfersBytes []byte,
+	transfers []*esdtTransferEntry,
+) ([][]byte, error) {
+	multiTransferArgs := make([][]byte, 0, 1+len(transfers)*argsPerTransfer)
+	multiTransferArgs = append(multiTransferArgs, numTransfersBytes)
+	for _, transfer := range transfers {
+		nonceBytes := big.NewInt(0).SetUint64(transfer.nonce).Bytes()
+		if transfer.nonce == 0 {
+			multiTransferArgs = append(multiTransferArgs, transfer.tokenID, nonceBytes, transfer.value.Bytes())
+			continue
+		}
+
+		marshaledNFTTransfer, err := e.marshalizer.Marshal(transfer.esdtData)
+		if err != nil {
+			return nil, err
+		}
+
+		multiTransferArgs = append(multiTransferArgs, transfer.tokenID, nonceBytes, marshaledNFTTransfer)
+	}
+
+	return multiTransferArgs, nil
+}
+
+func (e *esdtNFTMultiTransfer) parseReceivedTransfer(transferArgs [][]byte) (*esdtTransferEntry, error) {
+	transfer := &esdtTransferEntry{
+		tokenID:      transferArgs[0],
+		esdtTokenKey: e.computeESDTTokenKey(transferArgs[0]),
+		nonce:        big.NewInt(0).SetBytes(transferArgs[1]).Uint64(),
+	}
+
+	if transfer.nonce == 0 {
+		transfer.value = big.NewInt(0).SetBytes(transferArgs[2])
+		if transfer.value.Cmp(zero) <= 0 {
+			return nil, process.ErrNegativeValue
+		}
+
+		return transfer, nil
+	}
+
+	esdtData := &esdt.ESDigitalToken{}
+	err := e.marshalizer.Unmarshal(esdtData, transferArgs[2])
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.TokenMetaData == nil {
+		return nil, process.ErrNFTDoesNotHaveMetadata
+	}
+	if esdtData.Value == nil || esdtData.Value.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+	if esdtData.TokenMetaData.Nonce != transfer.nonce {
+		return nil, process.ErrInvalidArguments
+	}
+
+	transfer.value = esdtData.Value
+	transfer.esdtData = esdtData
+
+	return transfer, nil
+}
+
+// computeESDTTokenKey returns a newly allocated key, as the keys of all the transfers are kept until the end of the execution
+func (e *esdtNFTMultiTransfer) computeESDTTokenKey(tokenID []byte) []byte {
+	esdtTokenKey := make([]byte, 0, len(e.keyPrefix)+len(tokenID))
+	esdtTokenKey = append(esdtTokenKey, e.keyPrefix...)
+
+	return append(esdtTokenKey, tokenID...)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTMultiTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,251 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMultiTransferInput(caller []byte, dst []byte, transfers ...[]byte) *vmcommon.ContractCallInput {
+	numTransfers := big.NewInt(int64(len(transfers) / argsPerTransfer)).Bytes()
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments:   append([][]byte{dst, numTransfers}, transfers...),
+		},
+		RecipientAddr: caller,
+		Function:      core.BuiltInFunctionMultiESDTNFTTransfer,
+	}
+}
+
+func addFungibleBalance(t *testing.T, acnt state.UserAccountHandler, tokenID []byte, value int64) {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	err := saveESDTData(acnt, &esdt.ESDigitalToken{Value: big.NewInt(value)}, esdtTokenKey, &mock.MarshalizerMock{})
+	require.Nil(t, err)
+}
+
+func getFungibleBalance(t *testing.T, acnt state.UserAccountHandler, tokenID []byte) *big.Int {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	esdtData, err := getESDTDataFromKey(acnt, esdtTokenKey, &mock.MarshalizerMock{})
+	require.Nil(t, err)
+
+	return esdtData.Value
+}
+
+func TestNewESDTNFTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, err := NewESDTNFTMultiTransferFunc(0, nil, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(multiTransfer))
+
+	multiTransfer, err = NewESDTNFTMultiTransferFunc(0, &mock.MarshalizerMock{}, nil, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.True(t, check.IfNil(multiTransfer))
+
+	multiTransfer, err = NewESDTNFTMultiTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+	assert.True(t, check.IfNil(multiTransfer))
+
+	multiTransfer, err = NewESDTNFTMultiTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, nil)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.True(t, check.IfNil(multiTransfer))
+}
+
+func TestESDTNFTMultiTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	dst := bytes.Repeat([]byte{2}, 32)
+	tokenID := []byte("token")
+	multiTransfer, _ := NewESDTNFTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	_, err := multiTransfer.ProcessBuiltinFunction(acnt, acnt, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createMultiTransferInput(caller, dst, tokenID, []byte{1}, []byte{1})
+	input.Arguments = input.Arguments[:4]
+	_, err = multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createMultiTransferInput(caller, dst, tokenID, []byte{1}, []byte{1})
+	input.RecipientAddr = dst
+	_, err = multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createMultiTransferInput(caller, caller, tokenID, []byte{1}, []byte{1})
+	_, err = multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createMultiTransferInput(caller, dst, tokenID, []byte{1}, []byte{1})
+	input.Arguments[1] = big.NewInt(2).Bytes()
+	_, err = multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createMultiTransferInput(caller, dst, tokenID, []byte{1}, []byte{1})
+	input.GasProvided = 1
+	_, err = multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input = createMultiTransferInput(caller, dst, tokenID, []byte{}, []byte{})
+	_, err = multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input = createMultiTransferInput(caller, dst, tokenID, []byte{1}, []byte{3})
+	_, err = multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestESDTNFTMultiTransfer_ProcessBuiltInFunctionFailedTransferShouldRevertSender(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	dst := bytes.Repeat([]byte{2}, 32)
+	nftID := []byte("nft")
+	fungibleID := []byte("fungible")
+	multiTransfer, _ := NewESDTNFTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	acnt := createAccountWithNFT(t, caller, nftID, 2)
+	addFungibleBalance(t, acnt, fungibleID, 10)
+
+	input := createMultiTransferInput(
+		caller,
+		dst,
+		fungibleID, []byte{}, []byte{5},
+		nftID, []byte{1}, []byte{1},
+		nftID, []byte{1}, []byte{2},
+	)
+	_, err := multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	assert.Equal(t, big.NewInt(10), getFungibleBalance(t, acnt, fungibleID))
+	assert.Equal(t, big.NewInt(2), getNFTFromAccount(t, acnt, nftID, 1).Value)
+}
+
+func TestESDTNFTMultiTransfer_ProcessBuiltInFunctionSameShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	dst := bytes.Repeat([]byte{2}, 32)
+	nftID := []byte("nft")
+	fungibleID := []byte("fungible")
+	dstAcnt, _ := state.NewUserAccount(dst)
+	savedAccount := false
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return dstAcnt, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			savedAccount = true
+			return nil
+		},
+	}
+	multiTransfer, _ := NewESDTNFTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, accounts, mock.NewMultiShardsCoordinatorMock(2))
+	acnt := createAccountWithNFT(t, caller, nftID, 2)
+	addFungibleBalance(t, acnt, fungibleID, 10)
+
+	input := createMultiTransferInput(
+		caller,
+		dst,
+		fungibleID, []byte{}, []byte{4},
+		nftID, []byte{1}, []byte{1},
+	)
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	require.Nil(t, err)
+	assert.True(t, savedAccount)
+	assert.Equal(t, input.GasProvided-20, vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+
+	assert.Equal(t, big.NewInt(6), getFungibleBalance(t, acnt, fungibleID))
+	assert.Equal(t, big.NewInt(1), getNFTFromAccount(t, acnt, nftID, 1).Value)
+	assert.Equal(t, big.NewInt(4), getFungibleBalance(t, dstAcnt, fungibleID))
+	received := getNFTFromAccount(t, dstAcnt, nftID, 1)
+	assert.Equal(t, big.NewInt(1), received.Value)
+	assert.Equal(t, caller, received.TokenMetaData.Creator)
+}
+
+func TestESDTNFTMultiTransfer_ProcessBuiltInFunctionCrossShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	dst := bytes.Repeat([]byte{2}, 32)
+	nftID := []byte("nft")
+	fungibleID := []byte("fungible")
+	marshalizer := &mock.MarshalizerMock{}
+	shardCoordinator := &mock.ShardCoordinatorStub{
+		SameShardCalled: func(_, _ []byte) bool {
+			return false
+		},
+	}
+	multiTransfer, _ := NewESDTNFTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, shardCoordinator)
+	acnt := createAccountWithNFT(t, caller, nftID, 2)
+	addFungibleBalance(t, acnt, fungibleID, 10)
+
+	input := createMultiTransferInput(
+		caller,
+		dst,
+		fungibleID, []byte{}, []byte{4},
+		nftID, []byte{1}, []byte{2},
+	)
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-20, vmOutput.GasRemaining)
+
+	outAcc, ok := vmOutput.OutputAccounts[string(dst)]
+	require.True(t, ok)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	assert.Equal(t, uint64(0), outAcc.OutputTransfers[0].GasLimit)
+
+	assert.Equal(t, big.NewInt(6), getFungibleBalance(t, acnt, fungibleID))
+	_, err = getESDTNFTTokenOnSender(acnt, multiTransfer.computeESDTTokenKey(nftID), 1, marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	tokens := strings.Split(string(outAcc.OutputTransfers[0].Data), "@")
+	require.Equal(t, 8, len(tokens))
+	function := tokens[0]
+	assert.Equal(t, core.BuiltInFunctionMultiESDTNFTTransfer, function)
+	args := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		arg, errDecode := hex.DecodeString(token)
+		require.Nil(t, errDecode)
+		args = append(args, arg)
+	}
+
+	dstAcnt, _ := state.NewUserAccount(dst)
+	destinationInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 0,
+			Arguments:   args,
+		},
+		RecipientAddr: dst,
+		Function:      function,
+	}
+	_, err = multiTransfer.ProcessBuiltinFunction(nil, dstAcnt, destinationInput)
+	require.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(4), getFungibleBalance(t, dstAcnt, fungibleID))
+	received := getNFTFromAccount(t, dstAcnt, nftID, 1)
+	assert.Equal(t, big.NewInt(2), received.Value)
+
+	wrongData, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(5), TokenMetaData: received.TokenMetaData})
+	destinationInput.Arguments[5] = []byte{2}
+	destinationInput.Arguments[6] = wrongData
+	_, err = multiTransfer.ProcessBuiltinFunction(nil, dstAcnt, destinationInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
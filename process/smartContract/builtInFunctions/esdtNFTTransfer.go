@@ -0,0 +1,296 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTTransfer)(nil)
+
+type esdtNFTTransfer struct {
+	baseActiveHandler
+	keyPrefix        []byte
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	funcGasCost      uint64
+	mutExecution     sync.RWMutex
+}
+
+// NewESDTNFTTransferFunc returns the esdt NFT transfer built-in function component
+func NewESDTNFTTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+) (*esdtNFTTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	e := &esdtNFTTransfer{
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+		funcGasCost:      funcGasCost,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtNFTTransfer) SetNewGasConfig(gasCost *process.GasCost) {
+	e.mutExecution.Lock()
+	e.funcGasCost = gasCost.BuiltInCost.ESDTNFTTransfer
+	e.mutExecution.Unlock()
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT transfer function call
+// Arguments on the sender shard: tokenID, nonce, quantity, destination address and, optionally, the function and the
+// arguments of the smart contract call to be executed after the transfer
+// Arguments on the destination shard: tokenID, nonce, quantity, the marshaled token data and the optional smart contract call
+func (e *esdtNFTTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	e.mutExecution.RLock()
+	defer e.mutExecution.RUnlock()
+
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 4 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	if check.IfNil(acntSnd) {
+		return e.processNFTTransferOnDestination(acntDst, vmInput)
+	}
+
+	// the NFT transfer is a self-sent transaction, the destination being given in the arguments
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	dstAddress := vmInput.Arguments[3]
+	if len(dstAddress) != len(vmInput.CallerAddr) {
+		return nil, process.ErrInvalidArguments
+	}
+	if bytes.Equal(dstAddress, vmInput.CallerAddr) {
+		return nil, process.ErrInvalidRcvAddr
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	tokenID := vmInput.Arguments[0]
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	err := checkFrozeAndPauseForNFT(vmInput.CallerAddr, acntSnd, esdtTokenKey, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTTokenOnSender(acntSnd, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.Value.Cmp(quantity) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	log.Trace("esdtNFTTransfer", "sender", vmInput.CallerAddr, "receiver", dstAddress, "token", esdtTokenKey, "nonce", nonce, "quantity", quantity)
+
+	esdtData.Value.Sub(esdtData.Value, quantity)
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value.Set(quantity)
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided - e.funcGasCost,
+	}
+	isSCCallAfter := core.IsSmartContractAddress(dstAddress) && len(vmInput.Arguments) > 4
+
+	if e.shardCoordinator.SameShard(vmInput.CallerAddr, dstAddress) {
+		err = e.addNFTToDestination(vmInput.CallerAddr, dstAddress, esdtTokenKey, esdtData)
+		if err != nil {
+			return nil, err
+		}
+
+		if isSCCallAfter {
+			addOutPutTransferToVMOutput(
+				string(vmInput.Arguments[4]),
+				vmInput.Arguments[5:],
+				dstAddress,
+				vmInput.GasLocked,
+				vmOutput)
+		}
+
+		return vmOutput, nil
+	}
+
+	marshaledNFTTransfer, err := e.marshalizer.Marshal(esdtData)
+	if err != nil {
+		return nil, err
+	}
+
+	nftTransferArgs := [][]byte{tokenID, vmInput.Arguments[1], vmInput.Arguments[2], marshaledNFTTransfer}
+	nftTransferArgs = append(nftTransferArgs, vmInput.Arguments[4:]...)
+
+	gasRemaining := uint64(0)
+	gasLocked := vmInput.GasLocked
+	if !isSCCallAfter {
+		// the gas is forwarded to the destination only if there is a smart contract call to be executed
+		gasRemaining = vmOutput.GasRemaining
+		gasLocked = 0
+		vmOutput.GasRemaining = 0
+	}
+
+	addOutPutTransferToVMOutput(
+		core.BuiltInFunctionESDTNFTTransfer,
+		nftTransferArgs,
+		dstAddress,
+		gasLocked,
+		vmOutput)
+	vmOutput.GasRemaining = gasRemaining
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnDestination(
+	acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtData := &esdt.ESDigitalToken{}
+	err := e.marshalizer.Unmarshal(esdtData, vmInput.Arguments[3])
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.TokenMetaData == nil {
+		return nil, process.ErrNFTDoesNotHaveMetadata
+	}
+	if esdtData.Value == nil {
+		return nil, process.ErrInvalidNFTQuantity
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if nonce != esdtData.TokenMetaData.Nonce || esdtData.Value.Cmp(quantity) != 0 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	err = addNFTToAccount(vmInput.CallerAddr, acntDst, esdtTokenKey, esdtData, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: vmInput.GasProvided,
+	}
+	if core.IsSmartContractAddress(vmInput.RecipientAddr) && len(vmInput.Arguments) > 4 {
+		addOutPutTransferToVMOutput(
+			string(vmInput.Arguments[4]),
+			vmInput.Arguments[5:],
+			vmInput.RecipientAddr,
+			vmInput.GasLocked,
+			vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) addNFTToDestination(
+	sndAddress []byte,
+	dstAddress []byte,
+	esdtTokenKey []byte,
+	esdtData *esdt.ESDigitalToken,
+) error {
+	account, err := e.accounts.LoadAccount(dstAddress)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = addNFTToAccount(sndAddress, userAccount, esdtTokenKey, esdtData, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return err
+	}
+
+	return e.accounts.SaveAccount(userAccount)
+}
+
+func addNFTToAccount(
+	sndAddress []byte,
+	userAccount state.UserAccountHandler,
+	esdtTokenKey []byte,
+	esdtData *esdt.ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) error {
+	err := checkFrozeAndPauseForNFT(sndAddress, userAccount, esdtTokenKey, marshalizer, pauseHandler)
+	if err != nil {
+		return err
+	}
+
+	currentESDTData, isNew, err := getESDTNFTTokenOnDestination(userAccount, esdtTokenKey, esdtData.TokenMetaData.Nonce, marshalizer)
+	if err != nil {
+		return err
+	}
+	if !isNew && !currentESDTData.TokenMetaData.Equal(esdtData.TokenMetaData) {
+		return process.ErrWrongNFTOnDestination
+	}
+
+	esdtData.Value.Add(esdtData.Value, currentESDTData.Value)
+
+	return saveESDTNFTToken(userAccount, esdtTokenKey, esdtData, marshalizer)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,180 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTTransferInput(caller []byte, tokenID []byte, nonce uint64, quantity int64, dst []byte) *vmcommon.ContractCallInput {
+	input := createNFTNonceQuantityInput(caller, core.BuiltInFunctionESDTNFTTransfer, tokenID, nonce, quantity)
+	input.Arguments = append(input.Arguments, dst)
+
+	return input
+}
+
+func TestNewESDTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftTransfer, err := NewESDTNFTTransferFunc(0, nil, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(nftTransfer))
+
+	nftTransfer, err = NewESDTNFTTransferFunc(0, &mock.MarshalizerMock{}, nil, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.True(t, check.IfNil(nftTransfer))
+
+	nftTransfer, err = NewESDTNFTTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, mock.NewMultiShardsCoordinatorMock(2))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+	assert.True(t, check.IfNil(nftTransfer))
+
+	nftTransfer, err = NewESDTNFTTransferFunc(0, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, nil)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.True(t, check.IfNil(nftTransfer))
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	dst := bytes.Repeat([]byte{2}, 32)
+	tokenID := []byte("token")
+	nftTransfer, _ := NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, mock.NewMultiShardsCoordinatorMock(2))
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	_, err := nftTransfer.ProcessBuiltinFunction(acnt, acnt, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createNFTTransferInput(caller, tokenID, 1, 1, dst)
+	input.Arguments = input.Arguments[:3]
+	_, err = nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createNFTTransferInput(caller, tokenID, 1, 1, dst)
+	input.RecipientAddr = dst
+	_, err = nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createNFTTransferInput(caller, tokenID, 1, 1, caller)
+	_, err = nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	input = createNFTTransferInput(caller, tokenID, 1, 1, []byte("short"))
+	_, err = nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createNFTTransferInput(caller, tokenID, 1, 3, dst)
+	_, err = nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	input = createNFTTransferInput(caller, tokenID, 2, 1, dst)
+	_, err = nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionSameShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	dst := bytes.Repeat([]byte{2}, 32)
+	tokenID := []byte("token")
+	dstAcnt, _ := state.NewUserAccount(dst)
+	savedAccount := false
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return dstAcnt, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			savedAccount = true
+			return nil
+		},
+	}
+	nftTransfer, _ := NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, accounts, mock.NewMultiShardsCoordinatorMock(2))
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	input := createNFTTransferInput(caller, tokenID, 1, 1, dst)
+	vmOutput, err := nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	require.Nil(t, err)
+	assert.True(t, savedAccount)
+	assert.Equal(t, input.GasProvided-10, vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+
+	assert.Equal(t, big.NewInt(1), getNFTFromAccount(t, acnt, tokenID, 1).Value)
+	received := getNFTFromAccount(t, dstAcnt, tokenID, 1)
+	assert.Equal(t, big.NewInt(1), received.Value)
+	assert.Equal(t, caller, received.TokenMetaData.Creator)
+}
+
+func TestESDTNFTTransfer_ProcessBuiltInFunctionCrossShardShouldWork(t *testing.T) {
+	t.Parallel()
+
+	caller := bytes.Repeat([]byte{1}, 32)
+	dst := bytes.Repeat([]byte{2}, 32)
+	tokenID := []byte("token")
+	marshalizer := &mock.MarshalizerMock{}
+	shardCoordinator := &mock.ShardCoordinatorStub{
+		SameShardCalled: func(_, _ []byte) bool {
+			return false
+		},
+	}
+	nftTransfer, _ := NewESDTNFTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, shardCoordinator)
+	acnt := createAccountWithNFT(t, caller, tokenID, 2)
+
+	input := createNFTTransferInput(caller, tokenID, 1, 2, dst)
+	vmOutput, err := nftTransfer.ProcessBuiltinFunction(acnt, acnt, input)
+	require.Nil(t, err)
+	assert.Equal(t, input.GasProvided-10, vmOutput.GasRemaining)
+
+	outAcc, ok := vmOutput.OutputAccounts[string(dst)]
+	require.True(t, ok)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	assert.Equal(t, uint64(0), outAcc.OutputTransfers[0].GasLimit)
+
+	_, err = getESDTNFTTokenOnSender(acnt, append(nftTransfer.keyPrefix, tokenID...), 1, marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	tokens := strings.Split(string(outAcc.OutputTransfers[0].Data), "@")
+	require.Equal(t, 5, len(tokens))
+	function := tokens[0]
+	assert.Equal(t, core.BuiltInFunctionESDTNFTTransfer, function)
+	args := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		arg, errDecode := hex.DecodeString(token)
+		require.Nil(t, errDecode)
+		args = append(args, arg)
+	}
+
+	dstAcnt, _ := state.NewUserAccount(dst)
+	destinationInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 0,
+			Arguments:   args,
+		},
+		RecipientAddr: dst,
+		Function:      function,
+	}
+	_, err = nftTransfer.ProcessBuiltinFunction(nil, dstAcnt, destinationInput)
+	require.Nil(t, err)
+
+	received := getNFTFromAccount(t, dstAcnt, tokenID, 1)
+	assert.Equal(t, big.NewInt(2), received.Value)
+
+	wrongData, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(5), TokenMetaData: received.TokenMetaData})
+	destinationInput.Arguments[3] = wrongData
+	_, err = nftTransfer.ProcessBuiltinFunction(nil, dstAcnt, destinationInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
@@ -14,6 +14,7 @@ import (
 var _ process.BuiltinFunction = (*esdtPause)(nil)
 
 type esdtPause struct {
+	baseAlwaysActive
 	keyPrefix []byte
 	pause     bool
 	accounts  state.AccountsAdapter
@@ -0,0 +1,213 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+var _ process.BuiltinFunction = (*esdtRoles)(nil)
+var _ process.ESDTRoleHandler = (*esdtRoles)(nil)
+
+type esdtRoles struct {
+	baseActiveHandler
+	set         bool
+	keyPrefix   []byte
+	marshalizer marshal.Marshalizer
+}
+
+// NewESDTRolesFunc returns the esdt set or unset role built-in function component
+func NewESDTRolesFunc(
+	marshalizer marshal.Marshalizer,
+	set bool,
+) (*esdtRoles, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtRoles{
+		set:         set,
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier),
+		marshalizer: marshalizer,
+	}
+
+	return e, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtRoles) SetNewGasConfig(_ *process.GasCost) {
+}
+
+// ProcessBuiltinFunction resolves ESDT set and unset role function calls
+func (e *esdtRoles) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	log.Trace(vmInput.Function, "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "token", esdtTokenRoleKey)
+
+	roles, _, err := getESDTRolesForAcnt(e.marshalizer, acntDst, esdtTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.set {
+		e.addRolesToAccount(roles, vmInput.Arguments[1:])
+	} else {
+		e.deleteRolesFromAccount(roles, vmInput.Arguments[1:])
+	}
+
+	err = saveRolesToAccount(acntDst, esdtTokenRoleKey, roles, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	return vmOutput, nil
+}
+
+func (e *esdtRoles) addRolesToAccount(roles *esdt.ESDTRoles, newRoles [][]byte) {
+	for _, role := range newRoles {
+		// the ESDT system SC sends the type of the non and semi fungible tokens together with the create role
+		if isNonOrSemiFungibleTokenType(role) {
+			roles.TokenType = role
+			continue
+		}
+
+		_, exists := doesRoleExist(roles, role)
+		if exists {
+			continue
+		}
+
+		roles.Roles = append(roles.Roles, role)
+	}
+}
+
+func (e *esdtRoles) deleteRolesFromAccount(roles *esdt.ESDTRoles, deleteRoles [][]byte) {
+	for _, role := range deleteRoles {
+		index, exists := doesRoleExist(roles, role)
+		if !exists {
+			continue
+		}
+
+		copy(roles.Roles[index:], roles.Roles[index+1:])
+		roles.Roles[len(roles.Roles)-1] = nil
+		roles.Roles = roles.Roles[:len(roles.Roles)-1]
+	}
+}
+
+// CheckAllowedToExecute returns error if the account is not allowed to execute the given action on the token
+func (e *esdtRoles) CheckAllowedToExecute(account state.UserAccountHandler, tokenID []byte, action []byte) error {
+	if check.IfNil(account) {
+		return process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := append(e.keyPrefix, tokenID...)
+	roles, isNew, err := getESDTRolesForAcnt(e.marshalizer, account, esdtTokenRoleKey)
+	if err != nil {
+		return err
+	}
+	if isNew {
+		return process.ErrActionNotAllowed
+	}
+
+	_, exists := doesRoleExist(roles, action)
+	if !exists {
+		return process.ErrActionNotAllowed
+	}
+
+	return nil
+}
+
+// GetTokenType returns the token type saved together with the roles of the account for the given token
+func (e *esdtRoles) GetTokenType(account state.UserAccountHandler, tokenID []byte) ([]byte, error) {
+	if check.IfNil(account) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := append(e.keyPrefix, tokenID...)
+	roles, _, err := getESDTRolesForAcnt(e.marshalizer, account, esdtTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles.TokenType, nil
+}
+
+func isNonOrSemiFungibleTokenType(value []byte) bool {
+	return bytes.Equal(value, []byte(core.NonFungibleESDT)) || bytes.Equal(value, []byte(core.SemiFungibleESDT))
+}
+
+func doesRoleExist(roles *esdt.ESDTRoles, role []byte) (int, bool) {
+	for i, currentRole := range roles.Roles {
+		if bytes.Equal(currentRole, role) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func getESDTRolesForAcnt(
+	marshalizer marshal.Marshalizer,
+	account state.UserAccountHandler,
+	key []byte,
+) (*esdt.ESDTRoles, bool, error) {
+	roles := &esdt.ESDTRoles{
+		Roles: make([][]byte, 0),
+	}
+
+	marshaledData, err := account.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(marshaledData) == 0 {
+		return roles, true, nil
+	}
+
+	err = marshalizer.Unmarshal(roles, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return roles, false, nil
+}
+
+func saveRolesToAccount(
+	account state.UserAccountHandler,
+	key []byte,
+	roles *esdt.ESDTRoles,
+	marshalizer marshal.Marshalizer,
+) error {
+	marshaledData, err := marshalizer.Marshal(roles)
+	if err != nil {
+		return err
+	}
+
+	return account.DataTrieTracker().SaveKeyValue(key, marshaledData)
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtRoles) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,173 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, err := NewESDTRolesFunc(nil, true)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.True(t, check.IfNil(rolesFunc))
+}
+
+func TestESDTRoles_ProcessBuiltInFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true)
+	_, err := rolesFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(core.ESDTRoleNFTCreate)}
+	input.CallerAddr = []byte("caller")
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestESDTRoles_ProcessBuiltInFunctionShouldAddRolesOnce(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	rolesFunc, _ := NewESDTRolesFunc(marshalizer, true)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+
+	tokenID := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)},
+		},
+	}
+	vmOutput, err := rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	input.Arguments = [][]byte{tokenID, []byte(core.ESDTRoleNFTBurn)}
+	_, err = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	roles := &esdt.ESDTRoles{}
+	marshaledData, _ := acnt.DataTrieTracker().RetrieveValue(append(rolesFunc.keyPrefix, tokenID...))
+	_ = marshalizer.Unmarshal(roles, marshaledData)
+	assert.Equal(t, [][]byte{[]byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)}, roles.Roles)
+}
+
+func TestESDTRoles_CheckAllowedToExecute(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	tokenID := []byte("token")
+
+	err := rolesFunc.CheckAllowedToExecute(nil, tokenID, []byte(core.ESDTRoleNFTCreate))
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	err = rolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTCreate))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate)},
+		},
+	}
+	_, _ = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+
+	err = rolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTCreate))
+	assert.Nil(t, err)
+
+	err = rolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleNFTBurn))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+}
+
+func TestESDTRoles_ProcessBuiltInFunctionUnSetShouldDeleteRoles(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	setRolesFunc, _ := NewESDTRolesFunc(marshalizer, true)
+	unSetRolesFunc, _ := NewESDTRolesFunc(marshalizer, false)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+
+	tokenID := []byte("token")
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)},
+		},
+	}
+	_, err := setRolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	input.Arguments = [][]byte{tokenID, []byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleNFTCreate)}
+	vmOutput, err := unSetRolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	err = unSetRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleLocalMint))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+	err = unSetRolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.ESDTRoleLocalBurn))
+	assert.Nil(t, err)
+}
+
+func TestESDTRoles_GetTokenTypeShouldReturnTheTypeSentWithTheRoles(t *testing.T) {
+	t.Parallel()
+
+	rolesFunc, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	tokenID := []byte("token")
+
+	_, err := rolesFunc.GetTokenType(nil, tokenID)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	tokenType, err := rolesFunc.GetTokenType(acnt, tokenID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tokenType))
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate), []byte(core.SemiFungibleESDT)},
+		},
+	}
+	_, _ = rolesFunc.ProcessBuiltinFunction(nil, acnt, input)
+
+	tokenType, err = rolesFunc.GetTokenType(acnt, tokenID)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(core.SemiFungibleESDT), tokenType)
+
+	err = rolesFunc.CheckAllowedToExecute(acnt, tokenID, []byte(core.SemiFungibleESDT))
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+}
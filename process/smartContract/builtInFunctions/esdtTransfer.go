@@ -21,6 +21,7 @@ var _ process.BuiltinFunction = (*esdtTransfer)(nil)
 var zero = big.NewInt(0)
 
 type esdtTransfer struct {
+	baseAlwaysActive
 	funcGasCost    uint64
 	marshalizer    marshal.Marshalizer
 	keyPrefix      []byte
@@ -191,6 +192,24 @@ func addOutPutTransferToVMOutput(
 	vmOutput.GasRemaining = 0
 }
 
+// addSupplyChangeToVMOutput notifies the ESDT system SC about a change of the token supply which happened on this
+// shard, so that the minted, burnt and wiped values kept on the metachain stay accurate
+func addSupplyChangeToVMOutput(function string, tokenID []byte, value *big.Int, vmOutput *vmcommon.VMOutput) {
+	supplyChangeData := function + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(value.Bytes())
+	outTransfer := vmcommon.OutputTransfer{
+		Value:    big.NewInt(0),
+		Data:     []byte(supplyChangeData),
+		CallType: vmcommon.ESDTSupplyChangeCall,
+	}
+	if vmOutput.OutputAccounts == nil {
+		vmOutput.OutputAccounts = make(map[string]*vmcommon.OutputAccount)
+	}
+	vmOutput.OutputAccounts[string(vm.ESDTSCAddress)] = &vmcommon.OutputAccount{
+		Address:         vm.ESDTSCAddress,
+		OutputTransfers: []vmcommon.OutputTransfer{outTransfer},
+	}
+}
+
 func addToESDTBalance(
 	senderAddr []byte,
 	userAcnt state.UserAccountHandler,
@@ -3,10 +3,12 @@ package builtInFunctions
 import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -14,20 +16,35 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasSchedule          core.GasScheduleNotifier
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
-	Accounts             state.AccountsAdapter
+	GasSchedule                   core.GasScheduleNotifier
+	MapDNSAddresses               map[string]struct{}
+	EnableUserNameChange          bool
+	Marshalizer                   marshal.Marshalizer
+	Accounts                      state.AccountsAdapter
+	ShardCoordinator              sharding.Coordinator
+	EpochNotifier                 process.EpochNotifier
+	ESDTNFTEnableEpoch            uint32
+	ESDTSpecialRolesEnableEpoch   uint32
+	ESDTSupplyTrackingEnableEpoch uint32
+	ESDTMultiTransferEnableEpoch  uint32
 }
 
 type builtInFuncFactory struct {
-	mapDNSAddresses      map[string]struct{}
-	enableUserNameChange bool
-	marshalizer          marshal.Marshalizer
-	accounts             state.AccountsAdapter
-	builtInFunctions     process.BuiltInFunctionContainer
-	gasConfig            *process.GasCost
+	mapDNSAddresses               map[string]struct{}
+	enableUserNameChange          bool
+	marshalizer                   marshal.Marshalizer
+	accounts                      state.AccountsAdapter
+	shardCoordinator              sharding.Coordinator
+	builtInFunctions              process.BuiltInFunctionContainer
+	gasConfig                     *process.GasCost
+	esdtNFTEnableEpoch            uint32
+	flagESDTNFT                   atomic.Flag
+	esdtSpecialRolesEnableEpoch   uint32
+	flagESDTSpecialRoles          atomic.Flag
+	esdtSupplyTrackingEnableEpoch uint32
+	flagESDTSupplyTracking        atomic.Flag
+	esdtMultiTransferEnableEpoch  uint32
+	flagESDTMultiTransfer         atomic.Flag
 }
 
 // NewBuiltInFunctionsFactory creates a factory which will instantiate the built in functions contracts
@@ -44,12 +61,23 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	if args.MapDNSAddresses == nil {
 		return nil, process.ErrNilDnsAddresses
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	b := &builtInFuncFactory{
-		mapDNSAddresses:      args.MapDNSAddresses,
-		enableUserNameChange: args.EnableUserNameChange,
-		marshalizer:          args.Marshalizer,
-		accounts:             args.Accounts,
+		mapDNSAddresses:               args.MapDNSAddresses,
+		enableUserNameChange:          args.EnableUserNameChange,
+		marshalizer:                   args.Marshalizer,
+		accounts:                      args.Accounts,
+		shardCoordinator:              args.ShardCoordinator,
+		esdtNFTEnableEpoch:            args.ESDTNFTEnableEpoch,
+		esdtSpecialRolesEnableEpoch:   args.ESDTSpecialRolesEnableEpoch,
+		esdtSupplyTrackingEnableEpoch: args.ESDTSupplyTrackingEnableEpoch,
+		esdtMultiTransferEnableEpoch:  args.ESDTMultiTransferEnableEpoch,
 	}
 
 	var err error
@@ -60,6 +88,7 @@ func NewBuiltInFunctionsFactory(args ArgsCreateBuiltInFunctionContainer) (*built
 	b.builtInFunctions = NewBuiltInFunctionContainer()
 
 	args.GasSchedule.RegisterNotifyHandler(b)
+	args.EpochNotifier.RegisterNotifyHandler(b)
 
 	return b, nil
 }
@@ -162,11 +191,12 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
-	newFunc, err = NewESDTFreezeWipeFunc(b.marshalizer, false, true)
+	wipeFunc, err := NewESDTFreezeWipeFunc(b.marshalizer, false, true)
 	if err != nil {
 		return nil, err
 	}
-	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTWipe, newFunc)
+	wipeFunc.setSupplyTrackingHandler(b.flagESDTSupplyTracking.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTWipe, wipeFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +210,96 @@ func (b *builtInFuncFactory) CreateBuiltInFunctionContainer() (process.BuiltInFu
 		return nil, err
 	}
 
+	setRoleFunc, err := NewESDTRolesFunc(b.marshalizer, true)
+	if err != nil {
+		return nil, err
+	}
+	setRoleFunc.setActiveHandler(b.isSetRoleActive)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTSetRole, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	unSetRoleFunc, err := NewESDTRolesFunc(b.marshalizer, false)
+	if err != nil {
+		return nil, err
+	}
+	unSetRoleFunc.setActiveHandler(b.isSetRoleActive)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTUnSetRole, unSetRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	localMintFunc, err := NewESDTLocalMintFunc(b.gasConfig.BuiltInCost.ESDTLocalMint, b.marshalizer, pauseFunc, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+	localMintFunc.setActiveHandler(b.flagESDTSpecialRoles.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTLocalMint, localMintFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	localBurnFunc, err := NewESDTLocalBurnFunc(b.gasConfig.BuiltInCost.ESDTLocalBurn, b.marshalizer, pauseFunc, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+	localBurnFunc.setActiveHandler(b.flagESDTSpecialRoles.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTLocalBurn, localBurnFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	nftCreateFunc, err := NewESDTNFTCreateFunc(b.gasConfig.BuiltInCost.ESDTNFTCreate, b.gasConfig.BaseOperationCost, b.marshalizer, pauseFunc, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+	nftCreateFunc.setActiveHandler(b.flagESDTNFT.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTCreate, nftCreateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	nftAddQuantityFunc, err := NewESDTNFTAddQuantityFunc(b.gasConfig.BuiltInCost.ESDTNFTAddQuantity, b.marshalizer, pauseFunc, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+	nftAddQuantityFunc.setActiveHandler(b.flagESDTNFT.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTAddQuantity, nftAddQuantityFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	nftBurnFunc, err := NewESDTNFTBurnFunc(b.gasConfig.BuiltInCost.ESDTNFTBurn, b.marshalizer, pauseFunc, setRoleFunc)
+	if err != nil {
+		return nil, err
+	}
+	nftBurnFunc.setActiveHandler(b.flagESDTNFT.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTBurn, nftBurnFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	nftTransferFunc, err := NewESDTNFTTransferFunc(b.gasConfig.BuiltInCost.ESDTNFTTransfer, b.marshalizer, pauseFunc, b.accounts, b.shardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+	nftTransferFunc.setActiveHandler(b.flagESDTNFT.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionESDTNFTTransfer, nftTransferFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	multiTransferFunc, err := NewESDTNFTMultiTransferFunc(b.gasConfig.BuiltInCost.MultiESDTNFTTransfer, b.marshalizer, pauseFunc, b.accounts, b.shardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+	multiTransferFunc.setActiveHandler(b.flagESDTMultiTransfer.IsSet)
+	err = b.builtInFunctions.Add(core.BuiltInFunctionMultiESDTNFTTransfer, multiTransferFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	return b.builtInFunctions, nil
 }
 
@@ -231,6 +351,26 @@ func SetPayableHandler(container process.BuiltInFunctionContainer, payableHandle
 	return esdtTransferFunc.setPayableHandler(payableHandler)
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (b *builtInFuncFactory) EpochConfirmed(epoch uint32) {
+	b.flagESDTNFT.Toggle(epoch >= b.esdtNFTEnableEpoch)
+	log.Debug("built in functions: ESDT NFT", "enabled", b.flagESDTNFT.IsSet())
+
+	b.flagESDTSpecialRoles.Toggle(epoch >= b.esdtSpecialRolesEnableEpoch)
+	log.Debug("built in functions: ESDT special roles", "enabled", b.flagESDTSpecialRoles.IsSet())
+
+	b.flagESDTSupplyTracking.Toggle(epoch >= b.esdtSupplyTrackingEnableEpoch)
+	log.Debug("built in functions: ESDT supply tracking", "enabled", b.flagESDTSupplyTracking.IsSet())
+
+	b.flagESDTMultiTransfer.Toggle(epoch >= b.esdtMultiTransferEnableEpoch)
+	log.Debug("built in functions: ESDT multi transfer", "enabled", b.flagESDTMultiTransfer.IsSet())
+}
+
+// the roles are written to the accounts both for the special roles and for the roles given at NFT issuing
+func (b *builtInFuncFactory) isSetRoleActive() bool {
+	return b.flagESDTSpecialRoles.IsSet() || b.flagESDTNFT.IsSet()
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (b *builtInFuncFactory) IsInterfaceNil() bool {
 	return b == nil
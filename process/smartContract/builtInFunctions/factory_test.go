@@ -15,11 +15,17 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 
 	gasScheduleNotifier := mock.NewGasScheduleNotifierMock(gasMap)
 	args := ArgsCreateBuiltInFunctionContainer{
-		GasSchedule:          gasScheduleNotifier,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          &mock.MarshalizerMock{},
-		Accounts:             &mock.AccountsStub{},
+		GasSchedule:                   gasScheduleNotifier,
+		MapDNSAddresses:               make(map[string]struct{}),
+		EnableUserNameChange:          false,
+		Marshalizer:                   &mock.MarshalizerMock{},
+		Accounts:                      &mock.AccountsStub{},
+		ShardCoordinator:              mock.NewMultiShardsCoordinatorMock(1),
+		EpochNotifier:                 &mock.EpochNotifierStub{},
+		ESDTNFTEnableEpoch:            0,
+		ESDTSpecialRolesEnableEpoch:   0,
+		ESDTSupplyTrackingEnableEpoch: 0,
+		ESDTMultiTransferEnableEpoch:  0,
 	}
 
 	return args
@@ -52,6 +58,13 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
 	gasMap["ESDTBurn"] = value
+	gasMap["ESDTNFTCreate"] = value
+	gasMap["ESDTNFTAddQuantity"] = value
+	gasMap["ESDTNFTBurn"] = value
+	gasMap["ESDTNFTTransfer"] = value
+	gasMap["MultiESDTNFTTransfer"] = value
+	gasMap["ESDTLocalMint"] = value
+	gasMap["ESDTLocalBurn"] = value
 
 	return gasMap
 }
@@ -71,10 +84,123 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, factory)
 
+	args = createMockArguments()
+	args.ShardCoordinator = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.Nil(t, factory)
+
+	args = createMockArguments()
+	args.EpochNotifier = nil
+	factory, err = NewBuiltInFunctionsFactory(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, factory)
+
 	args = createMockArguments()
 	factory, err = NewBuiltInFunctionsFactory(args)
 	assert.Nil(t, err)
 	container, err := factory.CreateBuiltInFunctionContainer()
 	assert.Nil(t, err)
-	assert.Equal(t, len(container.Keys()), 11)
+	assert.Equal(t, len(container.Keys()), 20)
+}
+
+func TestCreateBuiltInFunctionContainer_ESDTNFTFunctionsShouldBeActiveOnlyAfterEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.ESDTNFTEnableEpoch = 2
+	args.ESDTSpecialRolesEnableEpoch = 2
+	factory, _ := NewBuiltInFunctionsFactory(args)
+	container, _ := factory.CreateBuiltInFunctionContainer()
+
+	nftFunctions := []string{
+		core.BuiltInFunctionESDTNFTCreate,
+		core.BuiltInFunctionESDTNFTAddQuantity,
+		core.BuiltInFunctionESDTNFTBurn,
+		core.BuiltInFunctionESDTNFTTransfer,
+	}
+
+	factory.EpochConfirmed(1)
+	for _, name := range nftFunctions {
+		builtInFunc, err := container.Get(name)
+		assert.Nil(t, err)
+		assert.False(t, builtInFunc.IsActive(), name)
+	}
+	esdtTransferFunc, _ := container.Get(core.BuiltInFunctionESDTTransfer)
+	assert.True(t, esdtTransferFunc.IsActive())
+
+	factory.EpochConfirmed(2)
+	for _, name := range nftFunctions {
+		builtInFunc, _ := container.Get(name)
+		assert.True(t, builtInFunc.IsActive(), name)
+	}
+}
+
+func TestCreateBuiltInFunctionContainer_SpecialRolesFunctionsShouldBeActiveOnlyAfterEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.ESDTNFTEnableEpoch = 5
+	args.ESDTSpecialRolesEnableEpoch = 3
+	factory, _ := NewBuiltInFunctionsFactory(args)
+	container, _ := factory.CreateBuiltInFunctionContainer()
+
+	specialRolesFunctions := []string{
+		core.BuiltInFunctionESDTSetRole,
+		core.BuiltInFunctionESDTUnSetRole,
+		core.BuiltInFunctionESDTLocalMint,
+		core.BuiltInFunctionESDTLocalBurn,
+	}
+
+	factory.EpochConfirmed(2)
+	for _, name := range specialRolesFunctions {
+		builtInFunc, err := container.Get(name)
+		assert.Nil(t, err)
+		assert.False(t, builtInFunc.IsActive(), name)
+	}
+
+	factory.EpochConfirmed(3)
+	for _, name := range specialRolesFunctions {
+		builtInFunc, _ := container.Get(name)
+		assert.True(t, builtInFunc.IsActive(), name)
+	}
+	nftCreateFunc, _ := container.Get(core.BuiltInFunctionESDTNFTCreate)
+	assert.False(t, nftCreateFunc.IsActive())
+}
+
+func TestCreateBuiltInFunctionContainer_SetRoleShouldBeActiveWithTheNFTFunctions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.ESDTNFTEnableEpoch = 2
+	args.ESDTSpecialRolesEnableEpoch = 5
+	factory, _ := NewBuiltInFunctionsFactory(args)
+	container, _ := factory.CreateBuiltInFunctionContainer()
+
+	factory.EpochConfirmed(2)
+	setRoleFunc, _ := container.Get(core.BuiltInFunctionESDTSetRole)
+	assert.True(t, setRoleFunc.IsActive())
+	localMintFunc, _ := container.Get(core.BuiltInFunctionESDTLocalMint)
+	assert.False(t, localMintFunc.IsActive())
+}
+
+func TestCreateBuiltInFunctionContainer_MultiTransferShouldBeActiveOnlyAfterEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.ESDTMultiTransferEnableEpoch = 3
+	factory, _ := NewBuiltInFunctionsFactory(args)
+	container, _ := factory.CreateBuiltInFunctionContainer()
+
+	multiTransferFunc, err := container.Get(core.BuiltInFunctionMultiESDTNFTTransfer)
+	assert.Nil(t, err)
+
+	factory.EpochConfirmed(2)
+	assert.False(t, multiTransferFunc.IsActive())
+
+	nftTransferFunc, _ := container.Get(core.BuiltInFunctionESDTNFTTransfer)
+	assert.True(t, nftTransferFunc.IsActive())
+
+	factory.EpochConfirmed(3)
+	assert.True(t, multiTransferFunc.IsActive())
 }
@@ -16,6 +16,7 @@ import (
 var _ process.BuiltinFunction = (*saveKeyValueStorage)(nil)
 
 type saveKeyValueStorage struct {
+	baseAlwaysActive
 	gasConfig    process.BaseOperationCost
 	funcGasCost  uint64
 	mutExecution sync.RWMutex
@@ -64,6 +64,9 @@ type BlockChainHookImpl struct {
 	mutCurrentHdr sync.RWMutex
 	currentHdr    data.HeaderHandler
 
+	mutAccountsView sync.RWMutex
+	accountsView    state.AccountsAdapter
+
 	compiledScPool     storage.Cacher
 	compiledScStorage  storage.Storer
 	configSCStorage    config.StorageConfig
@@ -140,7 +143,7 @@ func checkForNil(args ArgBlockChainHook) error {
 
 // GetCode returns the code for the given account
 func (bh *BlockChainHookImpl) GetCode(account vmcommon.UserAccountHandler) []byte {
-	return bh.accounts.GetCode(account.GetCodeHash())
+	return bh.getAccounts().GetCode(account.GetCodeHash())
 }
 
 // GetUserAccount returns the balance of a shard account
@@ -152,7 +155,7 @@ func (bh *BlockChainHookImpl) GetUserAccount(address []byte) (vmcommon.UserAccou
 		return nil, nil
 	}
 
-	acc, err := bh.accounts.GetExistingAccount(address)
+	acc, err := bh.getAccounts().GetExistingAccount(address)
 	if err != nil {
 		return nil, err
 	}
@@ -351,8 +354,12 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 	if err != nil {
 		return nil, err
 	}
+	if !function.IsActive() {
+		return nil, process.ErrBuiltInFunctionIsNotActive
+	}
 
-	sndAccount, dstAccount, err := bh.getUserAccounts(input)
+	accounts := bh.getAccounts()
+	sndAccount, dstAccount, err := bh.getUserAccounts(accounts, input)
 	if err != nil {
 		return nil, err
 	}
@@ -363,14 +370,14 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 	}
 
 	if !check.IfNil(sndAccount) {
-		err = bh.accounts.SaveAccount(sndAccount)
+		err = accounts.SaveAccount(sndAccount)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if !check.IfNil(dstAccount) {
-		err = bh.accounts.SaveAccount(dstAccount)
+		err = accounts.SaveAccount(dstAccount)
 		if err != nil {
 			return nil, err
 		}
@@ -415,12 +422,13 @@ func (bh *BlockChainHookImpl) IsPayable(address []byte) (bool, error) {
 }
 
 func (bh *BlockChainHookImpl) getUserAccounts(
+	accounts state.AccountsAdapter,
 	input *vmcommon.ContractCallInput,
 ) (state.UserAccountHandler, state.UserAccountHandler, error) {
 	var sndAccount state.UserAccountHandler
 	sndShardId := bh.shardCoordinator.ComputeId(input.CallerAddr)
 	if sndShardId == bh.shardCoordinator.SelfId() {
-		acc, err := bh.accounts.GetExistingAccount(input.CallerAddr)
+		acc, err := accounts.GetExistingAccount(input.CallerAddr)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -435,7 +443,7 @@ func (bh *BlockChainHookImpl) getUserAccounts(
 	var dstAccount state.UserAccountHandler
 	dstShardId := bh.shardCoordinator.ComputeId(input.RecipientAddr)
 	if dstShardId == bh.shardCoordinator.SelfId() {
-		acc, err := bh.accounts.LoadAccount(input.RecipientAddr)
+		acc, err := accounts.LoadAccount(input.RecipientAddr)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -502,6 +510,79 @@ func (bh *BlockChainHookImpl) SetCurrentHeader(hdr data.HeaderHandler) {
 	bh.mutCurrentHdr.Unlock()
 }
 
+// GetHeaderByNonce returns the self shard header with the provided nonce, read from storage
+func (bh *BlockChainHookImpl) GetHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	header, _, err := process.GetHeaderFromStorageWithNonce(
+		nonce,
+		bh.shardCoordinator.SelfId(),
+		bh.storageService,
+		bh.uint64Converter,
+		bh.marshalizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// GetHeaderByHash returns the self shard header with the provided hash, read from storage
+func (bh *BlockChainHookImpl) GetHeaderByHash(hash []byte) (data.HeaderHandler, error) {
+	if bh.shardCoordinator.SelfId() == core.MetachainShardId {
+		metaHeader, err := process.GetMetaHeaderFromStorage(hash, bh.marshalizer, bh.storageService)
+		if err != nil {
+			return nil, err
+		}
+
+		return metaHeader, nil
+	}
+
+	shardHeader, err := process.GetShardHeaderFromStorage(hash, bh.marshalizer, bh.storageService)
+	if err != nil {
+		return nil, err
+	}
+
+	return shardHeader, nil
+}
+
+// UseStateAtRootHash makes the hook read the accounts from a read-only view over the state at the provided root hash,
+// until UseCurrentState is called. The accounts adapter is not altered
+func (bh *BlockChainHookImpl) UseStateAtRootHash(rootHash []byte) error {
+	viewCreator, ok := bh.accounts.(state.AccountsViewCreator)
+	if !ok {
+		return process.ErrHistoricalStateNotSupported
+	}
+
+	accountsView, err := viewCreator.CreateViewAtRootHash(rootHash)
+	if err != nil {
+		return err
+	}
+
+	bh.mutAccountsView.Lock()
+	bh.accountsView = accountsView
+	bh.mutAccountsView.Unlock()
+
+	return nil
+}
+
+// UseCurrentState makes the hook read the accounts from the accounts adapter again
+func (bh *BlockChainHookImpl) UseCurrentState() {
+	bh.mutAccountsView.Lock()
+	bh.accountsView = nil
+	bh.mutAccountsView.Unlock()
+}
+
+func (bh *BlockChainHookImpl) getAccounts() state.AccountsAdapter {
+	bh.mutAccountsView.RLock()
+	defer bh.mutAccountsView.RUnlock()
+
+	if check.IfNil(bh.accountsView) {
+		return bh.accounts
+	}
+
+	return bh.accountsView
+}
+
 // SaveCompiledCode saves the compiled code to cache and storage
 func (bh *BlockChainHookImpl) SaveCompiledCode(codeHash []byte, code []byte) {
 	bh.compiledScPool.Put(codeHash, code, len(code))
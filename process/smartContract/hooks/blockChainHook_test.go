@@ -460,3 +460,117 @@ func TestBlockChainHookImpl_IsPayablePayable(t *testing.T) {
 	assert.True(t, isPayable)
 	assert.Nil(t, err)
 }
+
+type accountsViewCreatorStub struct {
+	*mock.AccountsStub
+	CreateViewAtRootHashCalled func(rootHash []byte) (state.AccountsAdapter, error)
+}
+
+func (a *accountsViewCreatorStub) CreateViewAtRootHash(rootHash []byte) (state.AccountsAdapter, error) {
+	return a.CreateViewAtRootHashCalled(rootHash)
+}
+
+func TestBlockChainHookImpl_UseStateAtRootHashNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockVMAccountsArguments()
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	err := bh.UseStateAtRootHash([]byte("root hash"))
+	assert.Equal(t, process.ErrHistoricalStateNotSupported, err)
+}
+
+func TestBlockChainHookImpl_UseStateAtRootHashMissingStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("missing trie node")
+	args := createMockVMAccountsArguments()
+	args.Accounts = &accountsViewCreatorStub{
+		AccountsStub: &mock.AccountsStub{},
+		CreateViewAtRootHashCalled: func(_ []byte) (state.AccountsAdapter, error) {
+			return nil, expectedErr
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	err := bh.UseStateAtRootHash([]byte("root hash"))
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestBlockChainHookImpl_UseStateAtRootHashShouldReadFromTheViewUntilUseCurrentState(t *testing.T) {
+	t.Parallel()
+
+	currentAccount := state.NewEmptyUserAccount()
+	historicalAccount := state.NewEmptyUserAccount()
+	rootHash := []byte("root hash")
+
+	args := createMockVMAccountsArguments()
+	args.Accounts = &accountsViewCreatorStub{
+		AccountsStub: &mock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+				return currentAccount, nil
+			},
+		},
+		CreateViewAtRootHashCalled: func(providedRootHash []byte) (state.AccountsAdapter, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			return &mock.AccountsStub{
+				GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+					return historicalAccount, nil
+				},
+			}, nil
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+	address := make([]byte, 32)
+
+	err := bh.UseStateAtRootHash(rootHash)
+	assert.Nil(t, err)
+
+	account, err := bh.GetUserAccount(address)
+	assert.Nil(t, err)
+	assert.True(t, account == historicalAccount)
+
+	bh.UseCurrentState()
+
+	account, err = bh.GetUserAccount(address)
+	assert.Nil(t, err)
+	assert.True(t, account == currentAccount)
+}
+
+func TestBlockChainHookImpl_GetHeaderByNonceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{Nonce: 7, RootHash: []byte("root hash")}
+	hdrHash := []byte("header hash")
+	args := createMockVMAccountsArguments()
+	marshaledHdr, _ := args.Marshalizer.Marshal(hdr)
+	args.StorageService = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			if unitType == dataRetriever.ShardHdrNonceHashDataUnit {
+				return &mock.StorerStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						return hdrHash, nil
+					},
+				}
+			}
+
+			return &mock.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					if !bytes.Equal(key, hdrHash) {
+						return nil, errors.New("not found")
+					}
+					return marshaledHdr, nil
+				},
+			}
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	header, err := bh.GetHeaderByNonce(7)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr.RootHash, header.GetRootHash())
+
+	header, err = bh.GetHeaderByHash(hdrHash)
+	assert.Nil(t, err)
+	assert.Equal(t, hdr.Nonce, header.GetNonce())
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -35,6 +36,9 @@ const executeDurationAlarmThreshold = time.Duration(100) * time.Millisecond
 // TODO: Move to vm-common.
 const upgradeFunctionName = "upgradeContract"
 
+// argsPerMultiESDTTransfer is the number of arguments of each transfer of a multi ESDT transfer: token, nonce and quantity
+const argsPerMultiESDTTransfer = 3
+
 var zero = big.NewInt(0)
 
 type scProcessor struct {
@@ -51,12 +55,14 @@ type scProcessor struct {
 	builtinEnableEpoch             uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	repairCallBackEnableEpoch      uint32
+	esdtSupplyTrackingEnableEpoch  uint32
 	stakingV2EnableEpoch           uint32
 	flagStakingV2                  atomic.Flag
 	flagDeploy                     atomic.Flag
 	flagBuiltin                    atomic.Flag
 	flagPenalizedTooMuchGas        atomic.Flag
 	flagRepairCallBackData         atomic.Flag
+	flagESDTSupplyTracking         atomic.Flag
 	isGenesisProcessing            bool
 
 	badTxForwarder process.IntermediateTransactionHandler
@@ -97,6 +103,7 @@ type ArgsNewSmartContractProcessor struct {
 	BuiltinEnableEpoch             uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	RepairCallbackEnableEpoch      uint32
+	ESDTSupplyTrackingEnableEpoch  uint32
 	StakingV2EnableEpoch           uint32
 	EpochNotifier                  process.EpochNotifier
 	IsGenesisProcessing            bool
@@ -184,6 +191,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		deployEnableEpoch:              args.DeployEnableEpoch,
 		builtinEnableEpoch:             args.BuiltinEnableEpoch,
 		repairCallBackEnableEpoch:      args.RepairCallbackEnableEpoch,
+		esdtSupplyTrackingEnableEpoch:  args.ESDTSupplyTrackingEnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		isGenesisProcessing:            args.IsGenesisProcessing,
 		stakingV2EnableEpoch:           args.StakingV2EnableEpoch,
@@ -682,7 +690,8 @@ func (sc *scProcessor) deleteSCRsWithValueZeroGoingToMeta(scrs []data.Transactio
 	cleanSCRs := make([]data.TransactionHandler, 0, len(scrs))
 	for _, scr := range scrs {
 		shardID := sc.shardCoordinator.ComputeId(scr.GetRcvAddr())
-		if shardID == core.MetachainShardId && scr.GetGasLimit() == 0 && scr.GetValue().Cmp(zero) == 0 {
+		isSupplyChangeForESDTSC := sc.flagESDTSupplyTracking.IsSet() && bytes.Equal(scr.GetRcvAddr(), vm.ESDTSCAddress)
+		if shardID == core.MetachainShardId && scr.GetGasLimit() == 0 && scr.GetValue().Cmp(zero) == 0 && !isSupplyChangeForESDTSC {
 			continue
 		}
 		cleanSCRs = append(cleanSCRs, scr)
@@ -910,6 +919,10 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 		vmOutput.ReturnMessage = err.Error()
 		return vmOutput, nil
 	}
+	if !builtIn.IsActive() {
+		vmOutput.ReturnMessage = process.ErrBuiltInFunctionIsNotActive.Error()
+		return vmOutput, nil
+	}
 
 	vmOutput, err = builtIn.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
 	if err != nil {
@@ -936,7 +949,12 @@ func (sc *scProcessor) treatExecutionAfterBuiltInFunc(
 	acntDst state.UserAccountHandler,
 	snapshot int,
 ) (bool, *vmcommon.VMOutput, *vmcommon.ContractCallInput, error) {
-	isSCCall, newVMInput, err := sc.isSCExecutionAfterBuiltInFunc(tx, vmInput, vmOutput, acntDst)
+	scDstAddress, scDstAccount, err := sc.getSCDestinationAfterBuiltInFunc(vmInput, acntDst)
+	if err != nil {
+		return false, vmOutput, vmInput, err
+	}
+
+	isSCCall, newVMInput, err := sc.isSCExecutionAfterBuiltInFunc(tx, vmInput, vmOutput, scDstAddress, scDstAccount)
 	if !isSCCall {
 		return false, vmOutput, vmInput, nil
 	}
@@ -948,13 +966,13 @@ func (sc *scProcessor) treatExecutionAfterBuiltInFunc(
 		return true, userErrorVmOutput, newVMInput, sc.ProcessIfError(acntSnd, vmInput.CurrentTxHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
-	err = sc.checkUpgradePermission(acntDst, newVMInput)
+	err = sc.checkUpgradePermission(scDstAccount, newVMInput)
 	if err != nil {
 		log.Debug("checkUpgradePermission", "error", err.Error())
 		return true, userErrorVmOutput, newVMInput, sc.ProcessIfError(acntSnd, vmInput.CurrentTxHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
-	newVMOutput, err := sc.executeSmartContractCall(newVMInput, tx, newVMInput.CurrentTxHash, snapshot, acntSnd, acntDst)
+	newVMOutput, err := sc.executeSmartContractCall(newVMInput, tx, newVMInput.CurrentTxHash, snapshot, acntSnd, scDstAccount)
 	if err != nil {
 		return true, userErrorVmOutput, newVMInput, err
 	}
@@ -969,6 +987,7 @@ func (sc *scProcessor) isSCExecutionAfterBuiltInFunc(
 	tx data.TransactionHandler,
 	vmInput *vmcommon.ContractCallInput,
 	vmOutput *vmcommon.VMOutput,
+	scDstAddress []byte,
 	acntDst state.UserAccountHandler,
 ) (bool, *vmcommon.ContractCallInput, error) {
 	if vmOutput.ReturnCode != vmcommon.Ok {
@@ -977,7 +996,7 @@ func (sc *scProcessor) isSCExecutionAfterBuiltInFunc(
 	if check.IfNil(acntDst) {
 		return false, nil, nil
 	}
-	if !core.IsSmartContractAddress(vmInput.RecipientAddr) {
+	if !core.IsSmartContractAddress(scDstAddress) {
 		return false, nil, nil
 	}
 
@@ -987,7 +1006,7 @@ func (sc *scProcessor) isSCExecutionAfterBuiltInFunc(
 		return true, newVMInput, nil
 	}
 
-	outAcc, ok := vmOutput.OutputAccounts[string(vmInput.RecipientAddr)]
+	outAcc, ok := vmOutput.OutputAccounts[string(scDstAddress)]
 	if !ok {
 		return false, nil, nil
 	}
@@ -1013,16 +1032,45 @@ func (sc *scProcessor) isSCExecutionAfterBuiltInFunc(
 			OriginalTxHash: vmInput.OriginalTxHash,
 			CurrentTxHash:  vmInput.CurrentTxHash,
 		},
-		RecipientAddr:     vmInput.RecipientAddr,
+		RecipientAddr:     scDstAddress,
 		Function:          function,
 		AllowInitFunction: false,
 	}
 
-	fillWithESDTValue(vmInput, newVMInput)
+	sc.fillWithESDTValue(vmInput, newVMInput)
 
 	return true, newVMInput, nil
 }
 
+// getSCDestinationAfterBuiltInFunc returns the address and the account of the smart contract which might be called
+// after the built-in function. For the self-sent NFT and multi transfers, the destination is given in the arguments.
+func (sc *scProcessor) getSCDestinationAfterBuiltInFunc(
+	vmInput *vmcommon.ContractCallInput,
+	acntDst state.UserAccountHandler,
+) ([]byte, state.UserAccountHandler, error) {
+	isSelfSent := bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr)
+	var scDstAddress []byte
+	switch {
+	case isSelfSent && vmInput.Function == core.BuiltInFunctionESDTNFTTransfer && len(vmInput.Arguments) >= 4:
+		scDstAddress = vmInput.Arguments[3]
+	case isSelfSent && vmInput.Function == core.BuiltInFunctionMultiESDTNFTTransfer && len(vmInput.Arguments) >= 1:
+		scDstAddress = vmInput.Arguments[0]
+	default:
+		return vmInput.RecipientAddr, acntDst, nil
+	}
+
+	if !core.IsSmartContractAddress(scDstAddress) {
+		return scDstAddress, nil, nil
+	}
+
+	scDstAccount, err := sc.getAccountFromAddress(scDstAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return scDstAddress, scDstAccount, nil
+}
+
 func (sc *scProcessor) createVMInputWithAsyncCallBack(vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) *vmcommon.ContractCallInput {
 	arguments := [][]byte{
 		big.NewInt(int64(vmOutput.ReturnCode)).Bytes(),
@@ -1058,17 +1106,77 @@ func (sc *scProcessor) createVMInputWithAsyncCallBack(vmInput *vmcommon.Contract
 		AllowInitFunction: false,
 	}
 
-	fillWithESDTValue(vmInput, newVMInput)
+	sc.fillWithESDTValue(vmInput, newVMInput)
 	return newVMInput
 }
 
-func fillWithESDTValue(fullVMInput *vmcommon.ContractCallInput, newVMInput *vmcommon.ContractCallInput) {
-	if fullVMInput.Function != core.BuiltInFunctionESDTTransfer {
+func (sc *scProcessor) fillWithESDTValue(fullVMInput *vmcommon.ContractCallInput, newVMInput *vmcommon.ContractCallInput) {
+	switch fullVMInput.Function {
+	case core.BuiltInFunctionESDTTransfer:
+		newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
+		newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[1])
+	case core.BuiltInFunctionESDTNFTTransfer:
+		newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
+		newVMInput.ESDTTokenNonce = big.NewInt(0).SetBytes(fullVMInput.Arguments[1]).Uint64()
+		newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[2])
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		sc.fillWithMultiESDTTransfers(fullVMInput, newVMInput)
+	}
+}
+
+func (sc *scProcessor) fillWithMultiESDTTransfers(fullVMInput *vmcommon.ContractCallInput, newVMInput *vmcommon.ContractCallInput) {
+	// the self-sent multi transfer has the destination as first argument, while on the destination shard
+	// the non-fungible tokens are received as marshaled token data instead of quantity
+	isSelfSent := bytes.Equal(fullVMInput.CallerAddr, fullVMInput.RecipientAddr)
+	transfersArgs := fullVMInput.Arguments
+	if isSelfSent {
+		transfersArgs = transfersArgs[1:]
+	}
+
+	numTransfers, ok := getNumOfMultiESDTTransfers(transfersArgs)
+	if !ok {
 		return
 	}
 
-	newVMInput.ESDTTokenName = fullVMInput.Arguments[0]
-	newVMInput.ESDTValue = big.NewInt(0).SetBytes(fullVMInput.Arguments[1])
+	esdtTransfers := make([]*vmcommon.ESDTTransfer, 0, numTransfers)
+	for i := uint64(1); i < 1+numTransfers*argsPerMultiESDTTransfer; i += argsPerMultiESDTTransfer {
+		esdtTransfer := &vmcommon.ESDTTransfer{
+			ESDTTokenName:  transfersArgs[i],
+			ESDTTokenNonce: big.NewInt(0).SetBytes(transfersArgs[i+1]).Uint64(),
+			ESDTValue:      big.NewInt(0).SetBytes(transfersArgs[i+2]),
+		}
+		if esdtTransfer.ESDTTokenNonce > 0 && !isSelfSent {
+			esdtData := &esdt.ESDigitalToken{}
+			err := sc.marshalizer.Unmarshal(esdtData, transfersArgs[i+2])
+			if err != nil {
+				log.Debug("fillWithMultiESDTTransfers", "error", err.Error())
+				return
+			}
+			esdtTransfer.ESDTValue = esdtData.Value
+		}
+
+		esdtTransfers = append(esdtTransfers, esdtTransfer)
+	}
+
+	newVMInput.ESDTTransfers = esdtTransfers
+	newVMInput.ESDTTokenName = esdtTransfers[0].ESDTTokenName
+	newVMInput.ESDTTokenNonce = esdtTransfers[0].ESDTTokenNonce
+	newVMInput.ESDTValue = esdtTransfers[0].ESDTValue
+}
+
+// getNumOfMultiESDTTransfers returns the number of transfers of a multi ESDT transfer, given the arguments starting
+// with the number of transfers
+func getNumOfMultiESDTTransfers(transfersArgs [][]byte) (uint64, bool) {
+	if len(transfersArgs) == 0 {
+		return 0, false
+	}
+
+	numTransfers := big.NewInt(0).SetBytes(transfersArgs[0]).Uint64()
+	if numTransfers == 0 || numTransfers > uint64(len(transfersArgs)-1)/argsPerMultiESDTTransfer {
+		return 0, false
+	}
+
+	return numTransfers, true
 }
 
 func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) (string, bool) {
@@ -1087,17 +1195,35 @@ func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) (str
 		return "", false
 	}
 
-	if function != core.BuiltInFunctionESDTTransfer {
+	numTransferArgs := 0
+	switch function {
+	case core.BuiltInFunctionESDTTransfer:
+		numTransferArgs = 2
+	case core.BuiltInFunctionESDTNFTTransfer:
+		if !sc.isActiveBuiltInFunction(function) {
+			return "", false
+		}
+		numTransferArgs = 4
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		if !sc.isActiveBuiltInFunction(function) {
+			return "", false
+		}
+		numTransfers, ok := getNumOfMultiESDTTransfers(args)
+		if !ok {
+			return "", false
+		}
+		numTransferArgs = 1 + int(numTransfers)*argsPerMultiESDTTransfer
+	default:
 		return "", false
 	}
-	if len(args) < 2 {
+	if len(args) < numTransferArgs {
 		return "", false
 	}
 
-	returnData := ""
-	returnData += function + "@"
-	returnData += hex.EncodeToString(args[0]) + "@"
-	returnData += hex.EncodeToString(args[1])
+	returnData := function
+	for _, arg := range args[:numTransferArgs] {
+		returnData += "@" + hex.EncodeToString(arg)
+	}
 
 	return returnData, true
 }
@@ -1828,8 +1954,7 @@ func (sc *scProcessor) isTransferWithNoAdditionalData(data []byte) bool {
 		return false
 	}
 
-	_, err = sc.builtInFunctions.Get(function)
-	if err != nil {
+	if !sc.isActiveBuiltInFunction(function) {
 		return false
 	}
 
@@ -1840,6 +1965,15 @@ func (sc *scProcessor) isTransferWithNoAdditionalData(data []byte) bool {
 	return len(args) == 2
 }
 
+func (sc *scProcessor) isActiveBuiltInFunction(function string) bool {
+	builtIn, err := sc.builtInFunctions.Get(function)
+	if err != nil {
+		return false
+	}
+
+	return builtIn.IsActive()
+}
+
 // createSCRForSender(vmOutput, tx, txHash, acntSnd)
 // give back the user the unused gas money
 func (sc *scProcessor) createSCRForSenderAndRelayer(
@@ -2261,6 +2395,9 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32) {
 
 	sc.flagStakingV2.Toggle(epoch > sc.stakingV2EnableEpoch)
 	log.Debug("scProcessor: staking v2", "enabled", sc.flagStakingV2.IsSet())
+
+	sc.flagESDTSupplyTracking.Toggle(epoch >= sc.esdtSupplyTrackingEnableEpoch)
+	log.Debug("scProcessor: ESDT supply tracking", "enabled", sc.flagESDTSupplyTracking.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
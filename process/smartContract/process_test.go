@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -817,6 +818,52 @@ func TestScProcessor_ExecuteBuiltInFunction(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestScProcessor_ExecuteBuiltInFunctionNotActiveShouldFail(t *testing.T) {
+	t.Parallel()
+
+	vmContainer := &mock.VMContainerMock{}
+	argParser := NewArgumentParser()
+	arguments := createMockSmartContractProcessorArguments()
+	accountState := &mock.AccountsStub{
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+	arguments.AccountsDB = accountState
+	arguments.VmContainer = vmContainer
+	arguments.ArgsParser = argParser
+	arguments.BuiltinEnableEpoch = maxEpoch
+	funcName := "builtIn"
+	builtInCalled := false
+	_ = arguments.BuiltInFunctions.Add(funcName, &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(acntSnd, acntDst state.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			builtInCalled = true
+			return &vmcommon.VMOutput{}, nil
+		},
+		IsActiveCalled: func() bool {
+			return false
+		},
+	})
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST")
+	tx.Data = []byte(funcName + "@0500@0000")
+	tx.Value = big.NewInt(45)
+	acntSrc, _ := createAccounts(tx)
+
+	accountState.LoadAccountCalled = func(address []byte) (state.AccountHandler, error) {
+		return acntSrc, nil
+	}
+
+	sc.flagBuiltin.Set()
+	retCode, _ := sc.ExecuteBuiltInFunction(tx, acntSrc, nil)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.False(t, builtInCalled)
+}
+
 func TestScProcessor_DeploySmartContractWrongTx(t *testing.T) {
 	t.Parallel()
 
@@ -3451,6 +3498,29 @@ func TestGasLockedInSmartContractProcessor(t *testing.T) {
 	require.Equal(t, gasLocked, outTransfer.GasLocked)
 }
 
+func TestScProcessor_CreateSmartContractResultsShouldKeepTheSupplyChangeCallType(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	outAcc := &vmcommon.OutputAccount{
+		Address: vm.ESDTSCAddress,
+		OutputTransfers: []vmcommon.OutputTransfer{{
+			Value:    big.NewInt(0),
+			CallType: vmcommon.ESDTSupplyChangeCall,
+			Data:     []byte(core.BuiltInFunctionESDTLocalMint + "@746f6b656e@0a"),
+		}},
+	}
+	vmOutput := &vmcommon.VMOutput{
+		OutputAccounts: map[string]*vmcommon.OutputAccount{string(vm.ESDTSCAddress): outAcc},
+	}
+
+	_, results := sc.createSmartContractResults(vmOutput, vmcommon.AsynchronousCall, outAcc, &transaction.Transaction{}, []byte("hash"))
+	require.Equal(t, 1, len(results))
+	assert.Equal(t, vmcommon.ESDTSupplyChangeCall, results[0].(*smartContractResult.SmartContractResult).CallType)
+}
+
 func TestSmartContractProcessor_computeTotalConsumedFeeAndDevRwd(t *testing.T) {
 	t.Parallel()
 
@@ -3864,3 +3934,64 @@ func computeExpectedResults(
 	}
 	return expectedTotalFee, expectedDevFees
 }
+
+func TestScProcessor_DeleteSCRsWithValueZeroGoingToMetaShouldKeepSupplyChangesForESDTSC(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, vm.ESDTSCAddress) || bytes.Equal(address, vm.ValidatorSCAddress) {
+			return core.MetachainShardId
+		}
+		return 0
+	}
+	arguments.ShardCoordinator = shardCoordinator
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scrToESDTSC := &smartContractResult.SmartContractResult{
+		RcvAddr: vm.ESDTSCAddress,
+		Value:   big.NewInt(0),
+		Data:    []byte(core.BuiltInFunctionESDTWipe + "@746f6b656e@64"),
+	}
+	scrToValidatorSC := &smartContractResult.SmartContractResult{
+		RcvAddr: vm.ValidatorSCAddress,
+		Value:   big.NewInt(0),
+	}
+	scrToShard := &smartContractResult.SmartContractResult{
+		RcvAddr: []byte("address in shard"),
+		Value:   big.NewInt(0),
+	}
+
+	scrs := sc.deleteSCRsWithValueZeroGoingToMeta([]data.TransactionHandler{scrToESDTSC, scrToValidatorSC, scrToShard})
+	assert.Equal(t, []data.TransactionHandler{scrToESDTSC, scrToShard}, scrs)
+}
+
+func TestScProcessor_DeleteSCRsWithValueZeroGoingToMetaBeforeSupplyTrackingShouldDeleteSupplyChanges(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, vm.ESDTSCAddress) {
+			return core.MetachainShardId
+		}
+		return 0
+	}
+	arguments.ShardCoordinator = shardCoordinator
+	arguments.ESDTSupplyTrackingEnableEpoch = 1
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scrToESDTSC := &smartContractResult.SmartContractResult{
+		RcvAddr: vm.ESDTSCAddress,
+		Value:   big.NewInt(0),
+		Data:    []byte(core.BuiltInFunctionESDTWipe + "@746f6b656e@64"),
+	}
+
+	scrs := sc.deleteSCRsWithValueZeroGoingToMeta([]data.TransactionHandler{scrToESDTSC})
+	assert.Equal(t, 0, len(scrs))
+
+	sc.EpochConfirmed(1)
+	scrs = sc.deleteSCRsWithValueZeroGoingToMeta([]data.TransactionHandler{scrToESDTSC})
+	assert.Equal(t, []data.TransactionHandler{scrToESDTSC}, scrs)
+}
@@ -56,7 +56,8 @@ func NewSCQueryService(
 	}, nil
 }
 
-// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
+// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract. If the query provides
+// a block nonce or a block hash, the function runs against the state at that block
 func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	if query.ScAddress == nil {
 		return nil, process.ErrNilScAddress
@@ -75,7 +76,15 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	log.Debug("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	if isHistoricalQuery(query) {
+		err := service.useStateAtQueryBlock(query)
+		if err != nil {
+			return nil, err
+		}
+		defer service.blockChainHook.UseCurrentState()
+	} else {
+		service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	}
 
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
@@ -101,6 +110,37 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, nil
 }
 
+func isHistoricalQuery(query *process.SCQuery) bool {
+	return query.HasBlockNonce || len(query.BlockHash) > 0
+}
+
+// useStateAtQueryBlock sets the header requested by the query as the current header and makes the blockchain hook
+// read the state at its root hash. The block hash takes precedence over the block nonce
+func (service *SCQueryService) useStateAtQueryBlock(query *process.SCQuery) error {
+	var header data.HeaderHandler
+	var err error
+	if len(query.BlockHash) > 0 {
+		header, err = service.blockChainHook.GetHeaderByHash(query.BlockHash)
+	} else {
+		header, err = service.blockChainHook.GetHeaderByNonce(query.BlockNonce)
+	}
+	if err != nil {
+		return err
+	}
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	err = service.blockChainHook.UseStateAtRootHash(header.GetRootHash())
+	if err != nil {
+		return err
+	}
+
+	service.blockChainHook.SetCurrentHeader(header)
+
+	return nil
+}
+
 func prepareScQuery(query *process.SCQuery) *process.SCQuery {
 	if query.CallerAddr == nil {
 		query.CallerAddr = query.ScAddress
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -175,6 +177,175 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func createSCQueryServiceForHistoricalQueries(blockChainHook process.BlockChainHookHandler, runWasCalled *bool) *SCQueryService {
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			*runWasCalled = true
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+			}, nil
+		},
+	}
+
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+		blockChainHook,
+		&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 100}
+			},
+		},
+	)
+
+	return target
+}
+
+func TestExecuteQuery_WithBlockNonceShouldRunAgainstTheBlockState(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 37, RootHash: []byte("root hash")}
+	calls := make([]string, 0)
+	blockChainHook := &mock.BlockChainHookHandlerMock{
+		GetHeaderByNonceCalled: func(nonce uint64) (data.HeaderHandler, error) {
+			assert.Equal(t, uint64(37), nonce)
+			return header, nil
+		},
+		GetHeaderByHashCalled: func(hash []byte) (data.HeaderHandler, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		UseStateAtRootHashCalled: func(rootHash []byte) error {
+			assert.Equal(t, header.RootHash, rootHash)
+			calls = append(calls, "useStateAtRootHash")
+			return nil
+		},
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			assert.Equal(t, header, hdr)
+			calls = append(calls, "setCurrentHeader")
+		},
+		UseCurrentStateCalled: func() {
+			calls = append(calls, "useCurrentState")
+		},
+	}
+	runWasCalled := false
+	target := createSCQueryServiceForHistoricalQueries(blockChainHook, &runWasCalled)
+
+	query := process.SCQuery{
+		ScAddress:     []byte(DummyScAddress),
+		FuncName:      "function",
+		BlockNonce:    37,
+		HasBlockNonce: true,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+	assert.Nil(t, err)
+	assert.True(t, runWasCalled)
+	assert.Equal(t, []string{"useStateAtRootHash", "setCurrentHeader", "useCurrentState"}, calls)
+}
+
+func TestExecuteQuery_WithBlockHashShouldRunAgainstTheBlockState(t *testing.T) {
+	t.Parallel()
+
+	blockHash := []byte("block hash")
+	header := &block.Header{Nonce: 37, RootHash: []byte("root hash")}
+	useCurrentStateCalled := false
+	blockChainHook := &mock.BlockChainHookHandlerMock{
+		GetHeaderByNonceCalled: func(nonce uint64) (data.HeaderHandler, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+		GetHeaderByHashCalled: func(hash []byte) (data.HeaderHandler, error) {
+			assert.Equal(t, blockHash, hash)
+			return header, nil
+		},
+		UseStateAtRootHashCalled: func(rootHash []byte) error {
+			assert.Equal(t, header.RootHash, rootHash)
+			return nil
+		},
+		UseCurrentStateCalled: func() {
+			useCurrentStateCalled = true
+		},
+	}
+	runWasCalled := false
+	target := createSCQueryServiceForHistoricalQueries(blockChainHook, &runWasCalled)
+
+	query := process.SCQuery{
+		ScAddress: []byte(DummyScAddress),
+		FuncName:  "function",
+		BlockHash: blockHash,
+	}
+
+	_, err := target.ExecuteQuery(&query)
+	assert.Nil(t, err)
+	assert.True(t, runWasCalled)
+	assert.True(t, useCurrentStateCalled)
+}
+
+func TestExecuteQuery_WithMissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	blockChainHook := &mock.BlockChainHookHandlerMock{
+		GetHeaderByNonceCalled: func(nonce uint64) (data.HeaderHandler, error) {
+			return nil, expectedErr
+		},
+		UseStateAtRootHashCalled: func(rootHash []byte) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	runWasCalled := false
+	target := createSCQueryServiceForHistoricalQueries(blockChainHook, &runWasCalled)
+
+	query := process.SCQuery{
+		ScAddress:     []byte(DummyScAddress),
+		FuncName:      "function",
+		HasBlockNonce: true,
+	}
+
+	vmOutput, err := target.ExecuteQuery(&query)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, vmOutput)
+	assert.False(t, runWasCalled)
+}
+
+func TestExecuteQuery_WithPrunedBlockStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	setCurrentHeaderCalled := false
+	blockChainHook := &mock.BlockChainHookHandlerMock{
+		GetHeaderByNonceCalled: func(nonce uint64) (data.HeaderHandler, error) {
+			return &block.Header{RootHash: []byte("pruned root hash")}, nil
+		},
+		UseStateAtRootHashCalled: func(rootHash []byte) error {
+			return expectedErr
+		},
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			setCurrentHeaderCalled = true
+		},
+	}
+	runWasCalled := false
+	target := createSCQueryServiceForHistoricalQueries(blockChainHook, &runWasCalled)
+
+	query := process.SCQuery{
+		ScAddress:     []byte(DummyScAddress),
+		FuncName:      "function",
+		HasBlockNonce: true,
+	}
+
+	vmOutput, err := target.ExecuteQuery(&query)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, vmOutput)
+	assert.False(t, runWasCalled)
+	assert.False(t, setCurrentHeaderCalled)
+}
+
 func TestExecuteQuery_WhenNotOkCodeShouldNotErr(t *testing.T) {
 	t.Parallel()
 
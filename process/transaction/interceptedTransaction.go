@@ -165,6 +165,11 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 			return err
 		}
 
+		err = inTx.verifyIfRelayedTxV2(inTx.tx)
+		if err != nil {
+			return err
+		}
+
 		inTx.whiteListerVerifiedTxs.Add([][]byte{inTx.Hash()})
 	}
 
@@ -213,13 +218,60 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transactio
 	}
 
 	// recursive relayed transactions are not allowed
-	if core.RelayedTransaction == funcName {
+	if isRelayedTx(funcName) {
 		return process.ErrRecursiveRelayedTxIsNotAllowed
 	}
 
 	return nil
 }
 
+func (inTx *InterceptedTransaction) verifyIfRelayedTxV2(tx *transaction.Transaction) error {
+	funcName, userTxArgs, err := inTx.argsParser.ParseCallData(string(tx.Data))
+	if err != nil {
+		return nil
+	}
+	if core.RelayedTransactionV2 != funcName {
+		return nil
+	}
+
+	if tx.Value == nil || tx.Value.Cmp(big.NewInt(0)) != 0 {
+		return process.ErrRelayedTxV2ZeroVal
+	}
+
+	userTx, err := CreateRelayedTxV2UserTx(tx, userTxArgs)
+	if err != nil {
+		return err
+	}
+	if len(userTx.RcvAddr) != inTx.pubkeyConv.Len() {
+		return process.ErrInvalidRcvAddr
+	}
+
+	err = inTx.verifySig(userTx)
+	if err != nil {
+		return fmt.Errorf("inner transaction: %w", err)
+	}
+
+	if len(userTx.Data) == 0 {
+		return nil
+	}
+
+	funcName, _, err = inTx.argsParser.ParseCallData(string(userTx.Data))
+	if err != nil {
+		return nil
+	}
+
+	// recursive relayed transactions are not allowed
+	if isRelayedTx(funcName) {
+		return process.ErrRecursiveRelayedTxIsNotAllowed
+	}
+
+	return nil
+}
+
+func isRelayedTx(funcName string) bool {
+	return core.RelayedTransaction == funcName || core.RelayedTransactionV2 == funcName
+}
+
 func (inTx *InterceptedTransaction) processFields(txBuff []byte) error {
 	inTx.hash = inTx.hasher.Compute(string(txBuff))
 
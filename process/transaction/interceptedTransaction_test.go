@@ -1331,6 +1331,62 @@ func TestInterceptedTransaction_CheckValidityOfRelayedTx(t *testing.T) {
 	assert.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed, err)
 }
 
+func TestInterceptedTransaction_CheckValidityOfRelayedTxV2(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(0),
+		Data:      []byte(core.RelayedTransactionV2 + "@00@11"),
+		GasLimit:  3,
+		GasPrice:  4,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   chainID,
+		Version:   minTxVersion,
+	}
+	txi, _ := createInterceptedTxFromPlainTxWithArgParser(tx)
+	err := txi.CheckValidity()
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	createData := func(rcvAddr []byte, userData []byte, signature []byte) []byte {
+		return []byte(core.RelayedTransactionV2 +
+			"@" + hex.EncodeToString(rcvAddr) +
+			"@" + hex.EncodeToString(big.NewInt(1).Bytes()) +
+			"@" + hex.EncodeToString(userData) +
+			"@" + hex.EncodeToString(signature))
+	}
+
+	tx.Data = createData(senderAddress, []byte("hello"), sigOk)
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Nil(t, err)
+
+	tx.Value = big.NewInt(1)
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrRelayedTxV2ZeroVal, err)
+
+	tx.Value = big.NewInt(0)
+	tx.Data = createData([]byte("short"), []byte("hello"), sigOk)
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrInvalidRcvAddr, err)
+
+	tx.Data = createData(senderAddress, []byte("hello"), []byte("notOk"))
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.True(t, errors.Is(err, errSignerMockVerifySigFails))
+
+	tx.Data = createData(senderAddress, []byte(core.RelayedTransactionV2), sigOk)
+	txi, _ = createInterceptedTxFromPlainTxWithArgParser(tx)
+	err = txi.CheckValidity()
+	assert.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed, err)
+}
+
 //------- IsInterfaceNil
 func TestInterceptedTransaction_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
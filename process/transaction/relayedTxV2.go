@@ -0,0 +1,34 @@
+package transaction
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// NumArgsRelayedTxV2 is the number of arguments found in the data field of a relayed transaction v2
+const NumArgsRelayedTxV2 = 4
+
+// CreateRelayedTxV2UserTx rebuilds the user transaction carried by a relayed transaction v2. The arguments are, in
+// order, the receiver, the nonce, the data and the signature of the user transaction. The user is the receiver of the
+// relayed transaction and signs a transaction with 0 value and 0 gas limit, as all the gas is provided by the relayer
+// which also sets the gas price, the chain ID and the version
+func CreateRelayedTxV2UserTx(relayedTx *transaction.Transaction, args [][]byte) (*transaction.Transaction, error) {
+	if len(args) != NumArgsRelayedTxV2 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	return &transaction.Transaction{
+		Nonce:     big.NewInt(0).SetBytes(args[1]).Uint64(),
+		Value:     big.NewInt(0),
+		RcvAddr:   args[0],
+		SndAddr:   relayedTx.RcvAddr,
+		GasPrice:  relayedTx.GasPrice,
+		GasLimit:  0,
+		Data:      args[2],
+		ChainID:   relayedTx.ChainID,
+		Version:   relayedTx.Version,
+		Signature: args[3],
+	}, nil
+}
@@ -40,8 +40,10 @@ type txProcessor struct {
 	scrForwarder                   process.IntermediateTransactionHandler
 	signMarshalizer                marshal.Marshalizer
 	flagRelayedTx                  atomic.Flag
+	flagRelayedTxV2                atomic.Flag
 	flagMetaProtection             atomic.Flag
 	relayedTxEnableEpoch           uint32
+	relayedTxV2EnableEpoch         uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	metaProtectionEnableEpoch      uint32
 }
@@ -63,6 +65,7 @@ type ArgsNewTxProcessor struct {
 	ArgsParser                     process.ArgumentsParser
 	ScrForwarder                   process.IntermediateTransactionHandler
 	RelayedTxEnableEpoch           uint32
+	RelayedTxV2EnableEpoch         uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	MetaProtectionEnableEpoch      uint32
 	EpochNotifier                  process.EpochNotifier
@@ -136,6 +139,7 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		scrForwarder:                   args.ScrForwarder,
 		signMarshalizer:                args.SignMarshalizer,
 		relayedTxEnableEpoch:           args.RelayedTxEnableEpoch,
+		relayedTxV2EnableEpoch:         args.RelayedTxV2EnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		metaProtectionEnableEpoch:      args.MetaProtectionEnableEpoch,
 	}
@@ -205,6 +209,8 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) (vmco
 		return txProc.processBuiltInFunctionCall(tx, acntSnd, acntDst)
 	case process.RelayedTx:
 		return txProc.processRelayedTx(tx, acntSnd, acntDst)
+	case process.RelayedTxV2:
+		return txProc.processRelayedTxV2(tx, acntSnd, acntDst)
 	}
 
 	return vmcommon.UserError, txProc.executingFailedTransaction(tx, acntSnd, process.ErrWrongTransaction)
@@ -533,11 +539,45 @@ func (txProc *txProcessor) processRelayedTx(
 		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrRelayedGasPriceMissmatch)
 	}
 
-	totalFee, remainingFee, relayerFee, remainingGasLimit := txProc.computeRelayedTxFees(tx)
+	_, _, _, remainingGasLimit := txProc.computeRelayedTxFees(tx)
 	if userTx.GasLimit != remainingGasLimit {
 		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrRelayedTxGasLimitMissmatch)
 	}
 
+	return txProc.finishExecutionOfRelayedTx(relayerAcnt, acntDst, tx, userTx)
+}
+
+func (txProc *txProcessor) processRelayedTxV2(
+	tx *transaction.Transaction,
+	relayerAcnt, acntDst state.UserAccountHandler,
+) (vmcommon.ReturnCode, error) {
+	if !txProc.flagRelayedTxV2.IsSet() {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrRelayedTxV2Disabled)
+	}
+	if tx.GetValue().Cmp(big.NewInt(0)) != 0 {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, process.ErrRelayedTxV2ZeroVal)
+	}
+
+	_, args, err := txProc.argsParser.ParseCallData(string(tx.GetData()))
+	if err != nil {
+		return 0, err
+	}
+
+	userTx, err := CreateRelayedTxV2UserTx(tx, args)
+	if err != nil {
+		return vmcommon.UserError, txProc.executingFailedTransaction(tx, relayerAcnt, err)
+	}
+	_, _, _, userTx.GasLimit = txProc.computeRelayedTxFees(tx)
+
+	return txProc.finishExecutionOfRelayedTx(relayerAcnt, acntDst, tx, userTx)
+}
+
+func (txProc *txProcessor) finishExecutionOfRelayedTx(
+	relayerAcnt, acntDst state.UserAccountHandler,
+	tx *transaction.Transaction,
+	userTx *transaction.Transaction,
+) (vmcommon.ReturnCode, error) {
+	totalFee, remainingFee, relayerFee, _ := txProc.computeRelayedTxFees(tx)
 	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
 	if err != nil {
 		return 0, err
@@ -594,9 +634,24 @@ func (txProc *txProcessor) computeRelayedTxFees(tx *transaction.Transaction) (*b
 	return totalFee, remainingFee, relayerFee, tx.GasLimit - relayerGasLimit
 }
 
+// shouldIncreaseNonceOfFailedUserTx returns false for the user transaction of a relayed tx v2 which failed because of
+// its nonce: the user signs only the fields carried by the relayer, so a replayed or a future user transaction must not
+// consume the nonce of the user
+func (txProc *txProcessor) shouldIncreaseNonceOfFailedUserTx(originalTx *transaction.Transaction, userTxErr error) bool {
+	isNonceErr := errors.Is(userTxErr, process.ErrHigherNonceInTransaction) ||
+		errors.Is(userTxErr, process.ErrLowerNonceInTransaction)
+	if !isNonceErr {
+		return true
+	}
+
+	txType, _ := txProc.txTypeHandler.ComputeTransactionType(originalTx)
+	return txType != process.RelayedTxV2
+}
+
 func (txProc *txProcessor) removeValueAndConsumedFeeFromUser(
 	userTx *transaction.Transaction,
 	relayedTxValue *big.Int,
+	shouldIncreaseNonce bool,
 ) error {
 	userAcnt, err := txProc.getAccountFromAddress(userTx.SndAddr)
 	if err != nil {
@@ -615,7 +670,9 @@ func (txProc *txProcessor) removeValueAndConsumedFeeFromUser(
 	if err != nil {
 		return err
 	}
-	userAcnt.IncreaseNonce(1)
+	if shouldIncreaseNonce {
+		userAcnt.IncreaseNonce(1)
+	}
 
 	err = txProc.accounts.SaveAccount(userAcnt)
 	if err != nil {
@@ -659,7 +716,8 @@ func (txProc *txProcessor) processUserTx(
 	txType, dstShardTxType := txProc.txTypeHandler.ComputeTransactionType(userTx)
 	err = txProc.checkTxValues(userTx, acntSnd, acntDst, true)
 	if err != nil {
-		errRemove := txProc.removeValueAndConsumedFeeFromUser(userTx, relayedTxValue)
+		shouldIncreaseNonce := txProc.shouldIncreaseNonceOfFailedUserTx(originalTx, err)
+		errRemove := txProc.removeValueAndConsumedFeeFromUser(userTx, relayedTxValue, shouldIncreaseNonce)
 		if errRemove != nil {
 			return vmcommon.UserError, errRemove
 		}
@@ -705,7 +763,7 @@ func (txProc *txProcessor) processUserTx(
 		returnCode, err = txProc.scProcessor.ExecuteBuiltInFunction(scrFromTx, acntSnd, acntDst)
 	default:
 		err = process.ErrWrongTransaction
-		errRemove := txProc.removeValueAndConsumedFeeFromUser(userTx, relayedTxValue)
+		errRemove := txProc.removeValueAndConsumedFeeFromUser(userTx, relayedTxValue, true)
 		if errRemove != nil {
 			return vmcommon.UserError, errRemove
 		}
@@ -861,6 +919,9 @@ func (txProc *txProcessor) EpochConfirmed(epoch uint32) {
 	txProc.flagRelayedTx.Toggle(epoch >= txProc.relayedTxEnableEpoch)
 	log.Debug("txProcessor: relayed transactions", "enabled", txProc.flagRelayedTx.IsSet())
 
+	txProc.flagRelayedTxV2.Toggle(epoch >= txProc.relayedTxV2EnableEpoch)
+	log.Debug("txProcessor: relayed transactions v2", "enabled", txProc.flagRelayedTxV2.IsSet())
+
 	txProc.flagPenalizedTooMuchGas.Toggle(epoch >= txProc.penalizedTooMuchGasEnableEpoch)
 	log.Debug("txProcessor: penalized too much gas", "enabled", txProc.flagPenalizedTooMuchGas.IsSet())
 
@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -1282,7 +1283,7 @@ func TestTxProcessor_ProcessTransactionScTxShouldNotBeCalledWhenAdrDstIsNotInNod
 	argsTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  mock.NewPubkeyConverterMock(32),
 		ShardCoordinator: shardCoordinator,
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	computeType, _ := coordinator.NewTxTypeHandler(argsTxTypeHandler)
@@ -1659,7 +1660,7 @@ func TestTxProcessor_ProcessRelayedTransaction(t *testing.T) {
 	argTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubKeyConverter,
 		ShardCoordinator: shardC,
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argTxTypeHandler)
@@ -2184,7 +2185,7 @@ func TestTxProcessor_ProcessRelayedTransactionDisabled(t *testing.T) {
 	argTxTypeHandler := coordinator.ArgNewTxTypeHandler{
 		PubkeyConverter:  pubKeyConverter,
 		ShardCoordinator: shardC,
-		BuiltInFuncNames: make(map[string]struct{}),
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
 		ArgumentParser:   parsers.NewCallArgsParser(),
 	}
 	txTypeHandler, _ := coordinator.NewTxTypeHandler(argTxTypeHandler)
@@ -2212,6 +2213,192 @@ func TestTxProcessor_ProcessRelayedTransactionDisabled(t *testing.T) {
 	assert.True(t, called)
 }
 
+func createRelayedTxV2Data(rcvAddr []byte, nonce uint64, userTxData []byte) []byte {
+	return []byte(core.RelayedTransactionV2 +
+		"@" + hex.EncodeToString(rcvAddr) +
+		"@" + hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes()) +
+		"@" + hex.EncodeToString(userTxData) +
+		"@" + hex.EncodeToString([]byte("signature")))
+}
+
+func createArgsForRelayedTxV2Processor(relayerAcnt, userAcnt, finalAcnt state.UserAccountHandler) txproc.ArgsNewTxProcessor {
+	pubKeyConverter := mock.NewPubkeyConverterMock(4)
+
+	adb := &mock.AccountsStub{}
+	adb.LoadAccountCalled = func(address []byte) (state.AccountHandler, error) {
+		for _, acnt := range []state.UserAccountHandler{relayerAcnt, userAcnt, finalAcnt} {
+			if bytes.Equal(address, acnt.AddressBytes()) {
+				return acnt, nil
+			}
+		}
+
+		return nil, errors.New("failure")
+	}
+	shardC, _ := sharding.NewMultiShardCoordinator(1, 0)
+
+	argTxTypeHandler := coordinator.ArgNewTxTypeHandler{
+		PubkeyConverter:  pubKeyConverter,
+		ShardCoordinator: shardC,
+		BuiltInFunctions: builtInFunctions.NewBuiltInFunctionContainer(),
+		ArgumentParser:   parsers.NewCallArgsParser(),
+	}
+	txTypeHandler, _ := coordinator.NewTxTypeHandler(argTxTypeHandler)
+
+	args := createArgsForTxProcessor()
+	args.Accounts = adb
+	args.ScProcessor = &mock.SCProcessorMock{}
+	args.ShardCoordinator = shardC
+	args.TxTypeHandler = txTypeHandler
+	args.PubkeyConv = pubKeyConverter
+	args.ArgsParser = smartContract.NewArgumentParser()
+
+	return args
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV2(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("sSRC"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("user"))
+	userAcnt.Balance = big.NewInt(10)
+	finalAcnt, _ := state.NewUserAccount([]byte("sDST"))
+	finalAcnt.Balance = big.NewInt(10)
+
+	execTx, _ := txproc.NewTxProcessor(createArgsForRelayedTxV2Processor(relayerAcnt, userAcnt, finalAcnt))
+
+	tx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(0),
+		SndAddr:  relayerAcnt.AddressBytes(),
+		RcvAddr:  userAcnt.AddressBytes(),
+		GasPrice: 1,
+		GasLimit: 1,
+		Data:     createRelayedTxV2Data(finalAcnt.AddressBytes(), 0, nil),
+	}
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, returnCode)
+	assert.Equal(t, uint64(1), relayerAcnt.GetNonce())
+	assert.Equal(t, uint64(1), userAcnt.GetNonce())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV2WrongUserNonceShouldNotConsumeUserNonce(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("sSRC"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("user"))
+	userAcnt.Balance = big.NewInt(10)
+	userAcnt.IncreaseNonce(5)
+	finalAcnt, _ := state.NewUserAccount([]byte("sDST"))
+	finalAcnt.Balance = big.NewInt(10)
+
+	execTx, _ := txproc.NewTxProcessor(createArgsForRelayedTxV2Processor(relayerAcnt, userAcnt, finalAcnt))
+
+	tx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(0),
+		SndAddr:  relayerAcnt.AddressBytes(),
+		RcvAddr:  userAcnt.AddressBytes(),
+		GasPrice: 1,
+		GasLimit: 1,
+		Data:     createRelayedTxV2Data(finalAcnt.AddressBytes(), 4, nil),
+	}
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.Equal(t, uint64(1), relayerAcnt.GetNonce())
+	assert.Equal(t, uint64(5), userAcnt.GetNonce())
+
+	tx.Nonce = 1
+	tx.Data = createRelayedTxV2Data(finalAcnt.AddressBytes(), 6, nil)
+	returnCode, err = execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.Equal(t, uint64(2), relayerAcnt.GetNonce())
+	assert.Equal(t, uint64(5), userAcnt.GetNonce())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV2Errors(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("sSRC"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("user"))
+	finalAcnt, _ := state.NewUserAccount([]byte("sDST"))
+
+	badTxs := make([]data.TransactionHandler, 0)
+	args := createArgsForRelayedTxV2Processor(relayerAcnt, userAcnt, finalAcnt)
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			badTxs = append(badTxs, txs...)
+			return nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	tx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(1),
+		SndAddr:  relayerAcnt.AddressBytes(),
+		RcvAddr:  userAcnt.AddressBytes(),
+		GasPrice: 1,
+		GasLimit: 1,
+		Data:     createRelayedTxV2Data(finalAcnt.AddressBytes(), 0, nil),
+	}
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+
+	tx.Nonce = 1
+	tx.Value = big.NewInt(0)
+	tx.Data = []byte(core.RelayedTransactionV2 + "@" + hex.EncodeToString(finalAcnt.AddressBytes()))
+	returnCode, err = execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+
+	assert.Equal(t, 2, len(badTxs))
+	assert.Equal(t, uint64(2), relayerAcnt.GetNonce())
+	assert.Equal(t, uint64(0), userAcnt.GetNonce())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV2Disabled(t *testing.T) {
+	t.Parallel()
+
+	relayerAcnt, _ := state.NewUserAccount([]byte("sSRC"))
+	relayerAcnt.Balance = big.NewInt(100)
+	userAcnt, _ := state.NewUserAccount([]byte("user"))
+	finalAcnt, _ := state.NewUserAccount([]byte("sDST"))
+
+	args := createArgsForRelayedTxV2Processor(relayerAcnt, userAcnt, finalAcnt)
+	args.RelayedTxV2EnableEpoch = maxEpoch
+	called := false
+	args.BadTxForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			called = true
+			return nil
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	tx := &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(0),
+		SndAddr:  relayerAcnt.AddressBytes(),
+		RcvAddr:  userAcnt.AddressBytes(),
+		GasPrice: 1,
+		GasLimit: 1,
+		Data:     createRelayedTxV2Data(finalAcnt.AddressBytes(), 0, nil),
+	}
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.True(t, called)
+}
+
 func TestTxProcessor_ConsumeMoveBalanceWithUserTx(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,7 @@ type transactionCostEstimator struct {
 	txTypeHandler      process.TxTypeHandler
 	feeHandler         process.FeeHandler
 	query              external.SCQueryService
+	argsParser         process.CallArgumentsParser
 	storePerByteCost   uint64
 	compilePerByteCost uint64
 	mutExecution       sync.RWMutex
@@ -24,6 +25,7 @@ func NewTransactionCostEstimator(
 	txTypeHandler process.TxTypeHandler,
 	feeHandler process.FeeHandler,
 	query external.SCQueryService,
+	argsParser process.CallArgumentsParser,
 	gasSchedule core.GasScheduleNotifier,
 ) (*transactionCostEstimator, error) {
 	if check.IfNil(txTypeHandler) {
@@ -35,6 +37,9 @@ func NewTransactionCostEstimator(
 	if check.IfNil(query) {
 		return nil, external.ErrNilSCQueryService
 	}
+	if check.IfNil(argsParser) {
+		return nil, process.ErrNilArgumentParser
+	}
 
 	compileCost, storeCost := getOperationCost(gasSchedule.LatestGasSchedule())
 
@@ -42,6 +47,7 @@ func NewTransactionCostEstimator(
 		txTypeHandler:      txTypeHandler,
 		feeHandler:         feeHandler,
 		query:              query,
+		argsParser:         argsParser,
 		storePerByteCost:   compileCost,
 		compilePerByteCost: storeCost,
 	}, nil
@@ -78,9 +84,14 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 	tce.mutExecution.RLock()
 	defer tce.mutExecution.RUnlock()
 
-	txType, _ := tce.txTypeHandler.ComputeTransactionType(tx)
 	tx.GasPrice = 1
 
+	return tce.computeTransactionGasLimit(tx)
+}
+
+func (tce *transactionCostEstimator) computeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+	txType, _ := tce.txTypeHandler.ComputeTransactionType(tx)
+
 	switch txType {
 	case process.MoveBalance:
 		return &transaction.CostResponse{
@@ -97,6 +108,8 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 			GasUnits:   0,
 			RetMessage: "cannot compute cost of the relayed transaction",
 		}, nil
+	case process.RelayedTxV2:
+		return tce.computeRelayedTxV2GasLimit(tx)
 	default:
 		return &transaction.CostResponse{
 			GasUnits:   0,
@@ -104,6 +117,45 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 		}, nil
 	}
 }
+
+// computeRelayedTxV2GasLimit returns the gas needed by the relayer for the move balance part of the relayed
+// transaction, added to the gas needed by the user transaction
+func (tce *transactionCostEstimator) computeRelayedTxV2GasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+	_, args, err := tce.argsParser.ParseData(string(tx.Data))
+	if err != nil {
+		return &transaction.CostResponse{
+			GasUnits:   0,
+			RetMessage: err.Error(),
+		}, nil
+	}
+
+	userTx, err := CreateRelayedTxV2UserTx(tx, args)
+	if err != nil {
+		return &transaction.CostResponse{
+			GasUnits:   0,
+			RetMessage: err.Error(),
+		}, nil
+	}
+
+	userTxType, _ := tce.txTypeHandler.ComputeTransactionType(userTx)
+	if userTxType == process.RelayedTx || userTxType == process.RelayedTxV2 {
+		return &transaction.CostResponse{
+			GasUnits:   0,
+			RetMessage: process.ErrRecursiveRelayedTxIsNotAllowed.Error(),
+		}, nil
+	}
+
+	userTxCost, err := tce.computeTransactionGasLimit(userTx)
+	if err != nil || len(userTxCost.RetMessage) > 0 {
+		return userTxCost, err
+	}
+
+	relayerGasLimit := tce.feeHandler.ComputeGasLimit(tx)
+	return &transaction.CostResponse{
+		GasUnits: relayerGasLimit + userTxCost.GasUnits,
+	}, nil
+}
+
 func (tce *transactionCostEstimator) computeScDeployGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	scDeployCost := uint64(len(tx.Data)) * (tce.storePerByteCost + tce.compilePerByteCost)
 	baseCost := tce.feeHandler.ComputeGasLimit(tx)
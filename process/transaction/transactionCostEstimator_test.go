@@ -1,11 +1,13 @@
 package transaction
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -32,7 +34,7 @@ func TestTransactionCostEstimator_NilTxTypeHandler(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(nil, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, gasSchedule)
+	tce, err := NewTransactionCostEstimator(nil, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, parsers.NewCallArgsParser(), gasSchedule)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilTxTypeHandler, err)
@@ -42,7 +44,7 @@ func TestTransactionCostEstimator_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, nil, &mock.ScQueryStub{}, gasSchedule)
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, nil, &mock.ScQueryStub{}, parsers.NewCallArgsParser(), gasSchedule)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -52,17 +54,27 @@ func TestTransactionCostEstimator_NilQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, nil, gasSchedule)
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, nil, parsers.NewCallArgsParser(), gasSchedule)
 
 	require.Nil(t, tce)
 	require.Equal(t, external.ErrNilSCQueryService, err)
 }
 
+func TestTransactionCostEstimator_NilArgumentsParserShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, nil, gasSchedule)
+
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilArgumentParser, err)
+}
+
 func TestTransactionCostEstimator_Ok(t *testing.T) {
 	t.Parallel()
 
 	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
-	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, gasSchedule)
+	tce, err := NewTransactionCostEstimator(&mock.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.ScQueryStub{}, parsers.NewCallArgsParser(), gasSchedule)
 
 	require.Nil(t, err)
 	require.False(t, check.IfNil(tce))
@@ -81,7 +93,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
 			return consumedGasUnits
 		},
-	}, &mock.ScQueryStub{}, gasSchedule)
+	}, &mock.ScQueryStub{}, parsers.NewCallArgsParser(), gasSchedule)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -104,7 +116,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
 			return 1000, nil
 		},
-	}, gasSchedule)
+	}, parsers.NewCallArgsParser(), gasSchedule)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -125,7 +137,7 @@ func TestComputeTransactionGasLimit_SmartContractDeploy(t *testing.T) {
 		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
 			return gasLimitBaseTx
 		},
-	}, &mock.ScQueryStub{}, gasSchedule)
+	}, &mock.ScQueryStub{}, parsers.NewCallArgsParser(), gasSchedule)
 
 	tx := &transaction.Transaction{
 		Data: []byte("data"),
@@ -153,7 +165,7 @@ func TestComputeTransactionGasLimit_SmartContractCall(t *testing.T) {
 		ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (u uint64, err error) {
 			return consumedGasUnits.Uint64(), nil
 		},
-	}, gasSchedule)
+	}, parsers.NewCallArgsParser(), gasSchedule)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -173,6 +185,7 @@ func TestTransactionCostEstimator_RelayedTxShouldErr(t *testing.T) {
 		},
 		&mock.FeeHandlerStub{},
 		&mock.ScQueryStub{},
+		parsers.NewCallArgsParser(),
 		gasSchedule,
 	)
 
@@ -181,3 +194,89 @@ func TestTransactionCostEstimator_RelayedTxShouldErr(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "cannot compute cost of the relayed transaction", cost.RetMessage)
 }
+
+func createRelayedTxV2Data(rcvAddr []byte, nonce uint64, userTxData []byte) []byte {
+	return []byte(core.RelayedTransactionV2 +
+		"@" + hex.EncodeToString(rcvAddr) +
+		"@" + hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes()) +
+		"@" + hex.EncodeToString(userTxData) +
+		"@" + hex.EncodeToString([]byte("signature")))
+}
+
+func createRelayedTxV2CostEstimator() *transactionCostEstimator {
+	gasSchedule := mock.NewGasScheduleNotifierMock(createGasMap(1))
+	tce, _ := NewTransactionCostEstimator(
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				funcName, _, _ := parsers.NewCallArgsParser().ParseData(string(tx.GetData()))
+				switch funcName {
+				case core.RelayedTransactionV2:
+					return process.RelayedTxV2, process.RelayedTxV2
+				case core.RelayedTransaction:
+					return process.RelayedTx, process.RelayedTx
+				default:
+					return process.MoveBalance, process.MoveBalance
+				}
+			},
+		},
+		&mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 100 + uint64(len(tx.GetData()))
+			},
+		},
+		&mock.ScQueryStub{},
+		parsers.NewCallArgsParser(),
+		gasSchedule,
+	)
+
+	return tce
+}
+
+func TestTransactionCostEstimator_RelayedTxV2ShouldAddRelayerAndUserTxCosts(t *testing.T) {
+	t.Parallel()
+
+	tce := createRelayedTxV2CostEstimator()
+	userTxData := []byte("hello")
+	tx := &transaction.Transaction{
+		SndAddr: []byte("relayer"),
+		RcvAddr: []byte("user"),
+		Value:   big.NewInt(0),
+		Data:    createRelayedTxV2Data([]byte("receiver"), 7, userTxData),
+	}
+
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Empty(t, cost.RetMessage)
+	expectedGasUnits := 100 + uint64(len(tx.Data)) + 100 + uint64(len(userTxData))
+	require.Equal(t, expectedGasUnits, cost.GasUnits)
+}
+
+func TestTransactionCostEstimator_RelayedTxV2InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tce := createRelayedTxV2CostEstimator()
+	tx := &transaction.Transaction{
+		Value: big.NewInt(0),
+		Data:  []byte(core.RelayedTransactionV2 + "@" + hex.EncodeToString([]byte("receiver"))),
+	}
+
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), cost.GasUnits)
+	require.Equal(t, process.ErrInvalidArguments.Error(), cost.RetMessage)
+}
+
+func TestTransactionCostEstimator_RelayedTxV2WithRelayedUserTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tce := createRelayedTxV2CostEstimator()
+	tx := &transaction.Transaction{
+		Value: big.NewInt(0),
+		Data:  createRelayedTxV2Data([]byte("receiver"), 7, []byte(core.RelayedTransaction+"@01")),
+	}
+
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), cost.GasUnits)
+	require.Equal(t, process.ErrRecursiveRelayedTxIsNotAllowed.Error(), cost.RetMessage)
+}
@@ -124,3 +124,12 @@ var ErrNilTimeCache = errors.New("nil time cache")
 // ErrNilTxGasHandler signals that a nil tx gas handler was provided
 var ErrNilTxGasHandler = errors.New("nil tx gas handler")
 
+// ErrTxReplacementUnderpriced signals that a transaction does not offer a gas price high enough in order to replace
+// an existing transaction having the same sender and nonce
+var ErrTxReplacementUnderpriced = errors.New("transaction replacement underpriced")
+
+// ErrLogDBIsClosed is raised when the log structured DB is closed
+var ErrLogDBIsClosed = errors.New("logDB is closed")
+
+// ErrDBNotFound signals that the requested database does not exist on disk
+var ErrDBNotFound = errors.New("database not found")
@@ -12,18 +12,20 @@ func TestGetCacherFromConfig(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.CacheConfig{
-		Capacity:    100,
-		Shards:      2,
-		Type:        "lru",
-		SizeInBytes: 128,
+		Capacity:                          100,
+		Shards:                            2,
+		Type:                              "lru",
+		SizeInBytes:                       128,
+		ReplacementGasPriceBumpPercentage: 10,
 	}
 
 	storageCacheConfig := GetCacherFromConfig(cfg)
 	assert.Equal(t, storageUnit.CacheConfig{
-		Capacity:    cfg.Capacity,
-		SizeInBytes: cfg.SizeInBytes,
-		Type:        storageUnit.CacheType(cfg.Type),
-		Shards:      cfg.Shards,
+		Capacity:                          cfg.Capacity,
+		SizeInBytes:                       cfg.SizeInBytes,
+		Type:                              storageUnit.CacheType(cfg.Type),
+		Shards:                            cfg.Shards,
+		ReplacementGasPriceBumpPercentage: cfg.ReplacementGasPriceBumpPercentage,
 	}, storageCacheConfig)
 }
 
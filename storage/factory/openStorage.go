@@ -103,6 +103,43 @@ func (o *openStorageUnits) GetMostRecentBootstrapStorageUnit() (storage.Storer,
 	return storer, nil
 }
 
+// OpenDB opens the database described by the provided config from the directory of the given shard and epoch. The
+// database is not created if it does not exist
+func (o *openStorageUnits) OpenDB(dbConfig config.DBConfig, shardID uint32, epoch uint32) (storage.Persister, error) {
+	parentDir := filepath.Join(o.workingDir, o.defaultDBPath, o.chainID)
+	persisterPath := filepath.Join(
+		parentDir,
+		fmt.Sprintf("%s_%d", o.defaultEpochString, epoch),
+		fmt.Sprintf("%s_%s", o.defaultShardString, core.GetShardIDString(shardID)),
+		dbConfig.FilePath,
+	)
+
+	return o.openExistingDB(dbConfig, persisterPath)
+}
+
+// OpenStaticDB opens the database described by the provided config from the static directory of the given shard. The
+// database is not created if it does not exist
+func (o *openStorageUnits) OpenStaticDB(dbConfig config.DBConfig, shardID uint32, staticString string) (storage.Persister, error) {
+	persisterPath := filepath.Join(
+		o.workingDir,
+		o.defaultDBPath,
+		o.chainID,
+		staticString,
+		fmt.Sprintf("%s_%s", o.defaultShardString, core.GetShardIDString(shardID)),
+		dbConfig.FilePath,
+	)
+
+	return o.openExistingDB(dbConfig, persisterPath)
+}
+
+func (o *openStorageUnits) openExistingDB(dbConfig config.DBConfig, persisterPath string) (storage.Persister, error) {
+	if !core.DoesFileExist(persisterPath) {
+		return nil, fmt.Errorf("%w: %s", storage.ErrDBNotFound, persisterPath)
+	}
+
+	return createDB(NewPersisterFactory(dbConfig), persisterPath)
+}
+
 func createDB(persisterFactory *PersisterFactory, persisterPath string) (storage.Persister, error) {
 	var persister storage.Persister
 	var err error
@@ -2,15 +2,20 @@ package factory
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgsOpenStorageUnits() ArgsNewOpenStorageUnits {
@@ -147,3 +152,52 @@ func TestGetMostRecentBootstrapStorageUnit(t *testing.T) {
 	assert.NotNil(t, storer)
 
 }
+
+func TestOpenDB_MissingDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsOpenStorageUnits()
+	args.WorkingDir = "missing working dir"
+	suoh, _ := NewStorageUnitOpenHandler(args)
+
+	persister, err := suoh.OpenDB(config.DBConfig{FilePath: "MiniBlocks", Type: "MemoryDB"}, 0, 1)
+	assert.Nil(t, persister)
+	assert.True(t, errors.Is(err, storage.ErrDBNotFound))
+
+	persister, err = suoh.OpenStaticDB(config.DBConfig{FilePath: "AccountsTrie", Type: "MemoryDB"}, 0, "Static")
+	assert.Nil(t, persister)
+	assert.True(t, errors.Is(err, storage.ErrDBNotFound))
+}
+
+func TestOpenDB_ShouldOpenTheDatabaseOfTheEpochAndShard(t *testing.T) {
+	t.Parallel()
+
+	workingDir, err := ioutil.TempDir("", "openStorage")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	args := createMockArgsOpenStorageUnits()
+	args.WorkingDir = workingDir
+	args.DefaultDBPath = "db"
+	args.ChainID = "chain"
+	suoh, _ := NewStorageUnitOpenHandler(args)
+
+	err = os.MkdirAll(filepath.Join(workingDir, "db", "chain", "Epoch_1", "Shard_metachain", "MiniBlocks"), os.ModePerm)
+	require.Nil(t, err)
+	err = os.MkdirAll(filepath.Join(workingDir, "db", "chain", "Static", "Shard_0", "AccountsTrie"), os.ModePerm)
+	require.Nil(t, err)
+
+	persister, err := suoh.OpenDB(config.DBConfig{FilePath: "MiniBlocks", Type: "MemoryDB"}, core.MetachainShardId, 1)
+	assert.Nil(t, err)
+	assert.NotNil(t, persister)
+
+	persister, err = suoh.OpenDB(config.DBConfig{FilePath: "MiniBlocks", Type: "MemoryDB"}, 0, 1)
+	assert.Nil(t, persister)
+	assert.True(t, errors.Is(err, storage.ErrDBNotFound))
+
+	persister, err = suoh.OpenStaticDB(config.DBConfig{FilePath: "AccountsTrie", Type: "MemoryDB"}, 0, "Static")
+	assert.Nil(t, err)
+	assert.NotNil(t, persister)
+}
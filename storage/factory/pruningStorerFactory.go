@@ -236,6 +236,11 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	err = psf.setupTxPoolJournal(store, &successfullyCreatedStorers)
+	if err != nil {
+		return nil, err
+	}
+
 	return store, err
 }
 
@@ -407,6 +412,11 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	err = psf.setupTxPoolJournal(store, &successfullyCreatedStorers)
+	if err != nil {
+		return nil, err
+	}
+
 	return store, err
 }
 
@@ -428,6 +438,30 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, eventsHashesByTxHashPruningStorer)
 	chainStorer.AddStorer(dataRetriever.ResultsHashesByTxHashUnit, eventsHashesByTxHashPruningStorer)
 
+	// Create the eventsIndex (PRUNING) storer
+	eventsIndexConfig := psf.generalConfig.DbLookupExtensions.EventsIndexStorageConfig
+	eventsIndexStorerArgs := psf.createPruningStorerArgs(eventsIndexConfig)
+	eventsIndexPruningStorer, err := pruning.NewPruningStorer(eventsIndexStorerArgs)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, eventsIndexPruningStorer)
+	chainStorer.AddStorer(dataRetriever.EventsIndexUnit, eventsIndexPruningStorer)
+
+	if psf.generalConfig.DbLookupExtensions.AddressTransactionsIndexEnabled {
+		// Create the addressTransactions (PRUNING) storer
+		addressTransactionsConfig := psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig
+		addressTransactionsStorerArgs := psf.createPruningStorerArgs(addressTransactionsConfig)
+		addressTransactionsPruningStorer, errCreate := pruning.NewPruningStorer(addressTransactionsStorerArgs)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		*createdStorers = append(*createdStorers, addressTransactionsPruningStorer)
+		chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsPruningStorer)
+	}
+
 	// Create the miniblocksMetadata (PRUNING) storer
 	miniblocksMetadataConfig := psf.generalConfig.DbLookupExtensions.MiniblocksMetadataStorageConfig
 	miniblocksMetadataPruningStorerArgs := psf.createPruningStorerArgs(miniblocksMetadataConfig)
@@ -470,6 +504,29 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	return nil
 }
 
+func (psf *StorageServiceFactory) setupTxPoolJournal(chainStorer *dataRetriever.ChainStorer, createdStorers *[]storage.Storer) error {
+	if !psf.generalConfig.TxPoolJournal.Enabled {
+		return nil
+	}
+
+	// Create the txPoolJournal (STATIC) storer
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	txPoolJournalConfig := psf.generalConfig.TxPoolJournal.Storage
+	txPoolJournalDbConfig := GetDBFromConfig(txPoolJournalConfig.DB)
+	txPoolJournalDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, txPoolJournalConfig.DB.FilePath)
+	txPoolJournalCacherConfig := GetCacherFromConfig(txPoolJournalConfig.Cache)
+	txPoolJournalBloomFilter := GetBloomFromConfig(txPoolJournalConfig.Bloom)
+	txPoolJournalUnit, err := storageUnit.NewStorageUnitFromConf(txPoolJournalCacherConfig, txPoolJournalDbConfig, txPoolJournalBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, txPoolJournalUnit)
+	chainStorer.AddStorer(dataRetriever.TxPoolJournalUnit, txPoolJournalUnit)
+
+	return nil
+}
+
 func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.StorageConfig) *pruning.StorerArgs {
 	cleanOldEpochsData := psf.generalConfig.StoragePruning.CleanOldEpochsData
 	numOfEpochsToKeep := uint32(psf.generalConfig.StoragePruning.NumEpochsToKeep)
@@ -133,6 +133,11 @@ type PersisterFactory interface {
 	IsInterfaceNil() bool
 }
 
+// Compactor defines a persister able to reclaim the space taken by the removed and overwritten entries
+type Compactor interface {
+	Compact() error
+}
+
 // UnitOpenerHandler defines which actions should be done for opening storage units
 type UnitOpenerHandler interface {
 	GetMostRecentBootstrapStorageUnit() (Storer, error)
@@ -7,6 +7,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const resourceUnavailable = "resource temporarily unavailable"
@@ -101,3 +102,8 @@ func (bldb *baseLevelDb) RangeKeys(handler func(key []byte, value []byte) bool)
 
 	iterator.Release()
 }
+
+// Compact compacts the whole key range of the underlying database, discarding the deleted and overwritten entries
+func (bldb *baseLevelDb) Compact() error {
+	return bldb.db.CompactRange(util.Range{})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/persisterTests"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -195,3 +196,13 @@ func TestSerialDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestSerialDB_Conformance(t *testing.T) {
+	persisterTests.RunConformanceTests(
+		t,
+		func(path string) (storage.Persister, error) {
+			return leveldb.NewSerialDB(path, 10, 1, 10)
+		},
+		true,
+	)
+}
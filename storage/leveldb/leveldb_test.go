@@ -11,6 +11,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/persisterTests"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -318,3 +319,13 @@ func TestDB_PutGetLargeValue(t *testing.T) {
 
 	assert.Equal(t, buffLargeValue, recovered)
 }
+
+func TestDB_Conformance(t *testing.T) {
+	persisterTests.RunConformanceTests(
+		t,
+		func(path string) (storage.Persister, error) {
+			return leveldb.NewDB(path, 10, 1, 10)
+		},
+		true,
+	)
+}
@@ -0,0 +1,84 @@
+package logdb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+const removed = "removed"
+
+type batch struct {
+	records    []*record
+	cachedData map[string][]byte
+	mutBatch   sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		records:    make([]*record, 0),
+		cachedData: make(map[string][]byte),
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch. The key and the value are copied as the batch is written
+// later, after the caller might have reused the provided slices
+func (b *batch) Put(key []byte, val []byte) error {
+	clonedKey := cloneBytes(key)
+	clonedVal := cloneBytes(val)
+
+	b.mutBatch.Lock()
+	b.records = append(b.records, &record{key: clonedKey, val: clonedVal, op: opPut})
+	b.cachedData[string(clonedKey)] = clonedVal
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	clonedKey := cloneBytes(key)
+
+	b.mutBatch.Lock()
+	b.records = append(b.records, &record{key: clonedKey, op: opRemove})
+	b.cachedData[string(key)] = []byte(removed)
+	b.mutBatch.Unlock()
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.records = make([]*record, 0)
+	b.cachedData = make(map[string][]byte)
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+func (b *batch) getRecords() []*record {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.records
+}
+
+func cloneBytes(buff []byte) []byte {
+	clonedBuff := make([]byte, len(buff))
+	copy(clonedBuff, buff)
+
+	return clonedBuff
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
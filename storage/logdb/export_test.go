@@ -0,0 +1,9 @@
+package logdb
+
+// DataFileSize -
+func (s *DB) DataFileSize() int64 {
+	s.mutFile.RLock()
+	defer s.mutFile.RUnlock()
+
+	return s.fileSize
+}
@@ -0,0 +1,693 @@
+package logdb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+const (
+	// read + write + execute for owner only
+	rwxOwner = 0700
+	// read + write for owner only
+	rwOwner = 0600
+
+	dataFileName        = "data.log"
+	compactionExtension = ".compact"
+
+	// while the DB is open, the data file is compacted only after reaching this size, when more than half of it is
+	// held by overwritten or removed values
+	minCompactionFileSize = 64 * 1024 * 1024
+)
+
+var log = logger.GetOrCreate("storage/logdb")
+
+type valuePointer struct {
+	recordOffset int64
+	recordSize   int64
+	keyLen       uint32
+	valLen       uint32
+}
+
+// DB is a log structured persister: all the writes are appended to a single data file, while an in-memory index keeps
+// for each key the position of its latest value. A read needs a single disk access and all the writes are sequential.
+// The space held by the overwritten and by the removed values is reclaimed by compacting the data file, either when
+// opening it or when the stale records take more than half of it.
+type DB struct {
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+
+	file      *os.File
+	index     map[string]*valuePointer
+	fileSize  int64
+	liveBytes int64
+	closed    bool
+	mutFile   sync.RWMutex
+
+	isCompacting  atomic.Flag
+	mutCompaction sync.Mutex
+
+	cancel context.CancelFunc
+}
+
+// NewDB is a constructor for the log structured persister
+// It creates the data file in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (*DB, error) {
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	// a leftover of a compaction interrupted before replacing the data file
+	_ = os.Remove(filepath.Join(path, dataFileName+compactionExtension))
+
+	file, err := os.OpenFile(filepath.Join(path, dataFileName), os.O_RDWR|os.O_CREATE, rwOwner)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		batch:             NewBatch(),
+		file:              file,
+		index:             make(map[string]*valuePointer),
+		cancel:            cancel,
+	}
+
+	err = dbStore.loadIndex()
+	if err != nil {
+		cancel()
+		_ = file.Close()
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	if dbStore.hasMostlyStaleRecords() {
+		err = dbStore.compact()
+		if err != nil {
+			log.Warn("logdb compact", "path", path, "error", err.Error())
+		}
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	return dbStore, nil
+}
+
+// loadIndex replays the data file in order to rebuild the index. An unreadable tail, left by a write interrupted
+// by a crash, is dropped
+func (s *DB) loadIndex() error {
+	fileInfo, err := s.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(io.NewSectionReader(s.file, 0, fileInfo.Size()))
+	header := make([]byte, recordHeaderSize)
+	offset := int64(0)
+	for {
+		r, errRead := readRecord(reader, header)
+		if errRead == io.EOF {
+			break
+		}
+		if errRead != nil {
+			log.Warn("corrupted DB file, dropping the unreadable records",
+				"path", s.path,
+				"offset", offset,
+				"error", errRead.Error(),
+			)
+			break
+		}
+
+		s.applyRecord(r, offset)
+		offset += r.size()
+	}
+
+	if offset < fileInfo.Size() {
+		err = s.file.Truncate(offset)
+		if err != nil {
+			return err
+		}
+	}
+	s.fileSize = offset
+
+	return nil
+}
+
+func readRecord(reader io.Reader, header []byte) (*record, error) {
+	_, err := io.ReadFull(reader, header)
+	if err != nil {
+		return nil, err
+	}
+
+	keyLen, valLen, err := decodeRecordHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make([]byte, keyLen+valLen)
+	_, err = io.ReadFull(reader, payload)
+	if err != nil {
+		return nil, errCorruptedRecord
+	}
+
+	return decodeRecord(header, payload)
+}
+
+func (s *DB) applyRecord(r *record, offset int64) {
+	key := string(r.key)
+	old, exists := s.index[key]
+	if exists {
+		s.liveBytes -= old.recordSize
+	}
+
+	if r.op == opRemove {
+		delete(s.index, key)
+		return
+	}
+
+	s.index[key] = &valuePointer{
+		recordOffset: offset,
+		recordSize:   r.size(),
+		keyLen:       uint32(len(r.key)),
+		valLen:       uint32(len(r.val)),
+	}
+	s.liveBytes += r.size()
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.mutBatch.Lock()
+			err := s.putBatch()
+			s.mutBatch.Unlock()
+			if err != nil {
+				log.Warn("logdb putBatch", "error", err.Error())
+			}
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch()
+	if err != nil {
+		log.Warn("logdb putBatch", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// putBatch appends the batch records to the data file, with a single write, and updates the index. The batch mutex
+// has to be held by the caller. When the stale records take more than half of the data file, the compaction is started
+// in background, so that neither the reads nor the writes wait for it
+func (s *DB) putBatch() error {
+	records := s.batch.getRecords()
+	if len(records) == 0 {
+		return nil
+	}
+
+	shouldCompact, err := s.writeRecords(records)
+	if err != nil {
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	if shouldCompact {
+		s.compactInBackground()
+	}
+
+	return nil
+}
+
+func (s *DB) writeRecords(records []*record) (bool, error) {
+	s.mutFile.Lock()
+	defer s.mutFile.Unlock()
+
+	if s.closed {
+		return false, storage.ErrLogDBIsClosed
+	}
+
+	buff := make([]byte, 0)
+	for _, r := range records {
+		buff = appendRecord(buff, r)
+	}
+
+	_, err := s.file.WriteAt(buff, s.fileSize)
+	if err != nil {
+		// drop a partial write, the next batch is written at the same offset
+		_ = s.file.Truncate(s.fileSize)
+		return false, err
+	}
+
+	err = s.file.Sync()
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range records {
+		s.applyRecord(r, s.fileSize)
+		s.fileSize += r.size()
+	}
+
+	return s.fileSize >= minCompactionFileSize && s.hasMostlyStaleRecords(), nil
+}
+
+func (s *DB) compactInBackground() {
+	wasCompacting := s.isCompacting.Set()
+	if wasCompacting {
+		return
+	}
+
+	go func() {
+		err := s.compact()
+		if err != nil && err != storage.ErrLogDBIsClosed {
+			log.Warn("logdb compact", "path", s.path, "error", err.Error())
+		}
+
+		s.isCompacting.Unset()
+	}()
+}
+
+func (s *DB) hasMostlyStaleRecords() bool {
+	return s.fileSize-s.liveBytes > s.fileSize/2
+}
+
+// compact rewrites the data file keeping only the latest value of each key. The live records are copied without
+// holding the file mutex, from a snapshot of the index. The file mutex is then held only for appending the records
+// written in the meantime and for replacing the data file
+func (s *DB) compact() error {
+	s.mutCompaction.Lock()
+	defer s.mutCompaction.Unlock()
+
+	s.mutFile.RLock()
+	if s.closed {
+		s.mutFile.RUnlock()
+		return storage.ErrLogDBIsClosed
+	}
+	snapshotIndex := make(map[string]*valuePointer, len(s.index))
+	for key, pointer := range s.index {
+		snapshotIndex[key] = pointer
+	}
+	snapshotSize := s.fileSize
+	s.mutFile.RUnlock()
+
+	dataFilePath := filepath.Join(s.path, dataFileName)
+	compactedFilePath := dataFilePath + compactionExtension
+	compactedFile, err := os.OpenFile(compactedFilePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, rwOwner)
+	if err != nil {
+		return err
+	}
+
+	newIndex, newSize, err := s.writeLiveRecords(compactedFile, snapshotIndex)
+	if err != nil {
+		_ = compactedFile.Close()
+		_ = os.Remove(compactedFilePath)
+		return err
+	}
+
+	s.mutFile.Lock()
+	defer s.mutFile.Unlock()
+
+	if s.closed {
+		_ = compactedFile.Close()
+		_ = os.Remove(compactedFilePath)
+		return storage.ErrLogDBIsClosed
+	}
+
+	tailRecords, err := s.copyTailRecords(compactedFile, snapshotSize, newSize)
+	errClose := compactedFile.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(compactedFilePath)
+		return err
+	}
+
+	err = s.file.Close()
+	if err != nil {
+		_ = os.Remove(compactedFilePath)
+		return err
+	}
+
+	errRename := os.Rename(compactedFilePath, dataFilePath)
+	if errRename != nil {
+		// the old data file is still in place
+		s.file, err = os.OpenFile(dataFilePath, os.O_RDWR, rwOwner)
+		if err != nil {
+			s.closed = true
+			return err
+		}
+
+		return errRename
+	}
+
+	s.file, err = os.OpenFile(dataFilePath, os.O_RDWR, rwOwner)
+	if err != nil {
+		s.closed = true
+		return err
+	}
+
+	log.Debug("logdb compacted", "path", s.path, "old size", s.fileSize, "new size", newSize)
+
+	s.index = newIndex
+	s.fileSize = newSize
+	s.liveBytes = newSize
+	for _, r := range tailRecords {
+		s.applyRecord(r, s.fileSize)
+		s.fileSize += r.size()
+	}
+
+	return nil
+}
+
+// copyTailRecords appends to the compacted file the records written in the data file after the index snapshot was
+// taken. The file mutex has to be held by the caller
+func (s *DB) copyTailRecords(compactedFile *os.File, snapshotSize int64, compactedSize int64) ([]*record, error) {
+	tail := make([]byte, s.fileSize-snapshotSize)
+	if len(tail) > 0 {
+		_, err := s.file.ReadAt(tail, snapshotSize)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = compactedFile.WriteAt(tail, compactedSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := compactedFile.Sync()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*record, 0)
+	reader := bytes.NewReader(tail)
+	header := make([]byte, recordHeaderSize)
+	for {
+		r, errRead := readRecord(reader, header)
+		if errRead == io.EOF {
+			return records, nil
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		records = append(records, r)
+	}
+}
+
+func (s *DB) writeLiveRecords(file *os.File, index map[string]*valuePointer) (map[string]*valuePointer, int64, error) {
+	keys := make([]string, 0, len(index))
+	for key := range index {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	writer := bufio.NewWriter(file)
+	newIndex := make(map[string]*valuePointer, len(keys))
+	offset := int64(0)
+	buff := make([]byte, 0)
+	for _, key := range keys {
+		val, err := s.readValue(index[key])
+		if err != nil {
+			return nil, 0, err
+		}
+
+		r := &record{key: []byte(key), val: val, op: opPut}
+		buff = appendRecord(buff[:0], r)
+		_, err = writer.Write(buff)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		newIndex[key] = &valuePointer{
+			recordOffset: offset,
+			recordSize:   r.size(),
+			keyLen:       uint32(len(r.key)),
+			valLen:       uint32(len(r.val)),
+		}
+		offset += r.size()
+	}
+
+	err := writer.Flush()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return newIndex, offset, nil
+}
+
+func (s *DB) readValue(pointer *valuePointer) ([]byte, error) {
+	val := make([]byte, pointer.valLen)
+	_, err := s.file.ReadAt(val, pointer.recordOffset+recordHeaderSize+int64(pointer.keyLen))
+	if err != nil {
+		return nil, err
+	}
+
+	return val, nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	if s.isClosed() {
+		return storage.ErrLogDBIsClosed
+	}
+
+	s.mutBatch.RLock()
+	err := s.batch.Put(key, val)
+	s.mutBatch.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	s.mutBatch.RLock()
+	defer s.mutBatch.RUnlock()
+
+	data := s.batch.Get(key)
+	if data != nil {
+		if string(data) == removed {
+			return nil, storage.ErrKeyNotFound
+		}
+		return data, nil
+	}
+
+	s.mutFile.RLock()
+	defer s.mutFile.RUnlock()
+
+	if s.closed {
+		return nil, storage.ErrLogDBIsClosed
+	}
+
+	pointer, ok := s.index[string(key)]
+	if !ok {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return s.readValue(pointer)
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	s.mutBatch.RLock()
+	defer s.mutBatch.RUnlock()
+
+	data := s.batch.Get(key)
+	if data != nil {
+		if string(data) == removed {
+			return storage.ErrKeyNotFound
+		}
+		return nil
+	}
+
+	s.mutFile.RLock()
+	defer s.mutFile.RUnlock()
+
+	if s.closed {
+		return storage.ErrLogDBIsClosed
+	}
+
+	_, ok := s.index[string(key)]
+	if !ok {
+		return storage.ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *DB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// RangeKeys will call the handler function for each (key, value) pair, in the order of the keys. The pending batch is
+// written first, so that all the data is iterated
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutBatch.Lock()
+	err := s.putBatch()
+	s.mutBatch.Unlock()
+	if err != nil {
+		log.Warn("logdb putBatch", "error", err.Error())
+	}
+
+	s.mutFile.RLock()
+	keys := make([]string, 0, len(s.index))
+	for key := range s.index {
+		keys = append(keys, key)
+	}
+	s.mutFile.RUnlock()
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		val, errGet := s.Get([]byte(key))
+		if errGet != nil {
+			// removed in the meantime
+			continue
+		}
+
+		shouldContinue := handler([]byte(key), val)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Compact writes the pending batch and rewrites the data file keeping only the latest value of each key
+func (s *DB) Compact() error {
+	s.mutBatch.Lock()
+
+	err := s.putBatch()
+	s.mutBatch.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return s.compact()
+}
+
+func (s *DB) isClosed() bool {
+	s.mutFile.RLock()
+	defer s.mutFile.RUnlock()
+
+	return s.closed
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	err := s.putBatch()
+	s.mutBatch.Unlock()
+	if err != nil && err != storage.ErrLogDBIsClosed {
+		log.Warn("logdb putBatch", "error", err.Error())
+	}
+
+	s.cancel()
+
+	s.mutFile.Lock()
+	defer s.mutFile.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	return s.file.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	if s.isClosed() {
+		return storage.ErrLogDBIsClosed
+	}
+
+	s.mutBatch.RLock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.RUnlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+
+	s.mutFile.Lock()
+	wasClosed := s.closed
+	s.closed = true
+	s.mutFile.Unlock()
+
+	if !wasClosed {
+		err := s.file.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,261 @@
+package logdb_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/logdb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/persisterTests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) (*logdb.DB, string) {
+	dir, _ := ioutil.TempDir("", "logdb_temp")
+	db, err := logdb.NewDB(dir, batchDelaySeconds, maxBatchSize)
+	require.Nil(t, err)
+
+	return db, dir
+}
+
+func TestDB_Conformance(t *testing.T) {
+	persisterTests.RunConformanceTests(
+		t,
+		func(path string) (storage.Persister, error) {
+			return logdb.NewDB(path, 10, 100)
+		},
+		true,
+	)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	db, dir := createLogDb(t, 1, 100)
+	defer func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	err := db.Put([]byte("key"), []byte("value"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), db.DataFileSize())
+
+	time.Sleep(time.Second * 3)
+
+	assert.True(t, db.DataFileSize() > 0)
+	val, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+}
+
+func TestDB_PutShouldCopyTheKeyAndTheValue(t *testing.T) {
+	db, dir := createLogDb(t, 10, 100)
+	defer func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	key := []byte("key")
+	value := []byte("value")
+	err := db.Put(key, value)
+	require.Nil(t, err)
+
+	copy(key, "abc")
+	copy(value, "abcde")
+
+	val, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+
+	require.Nil(t, db.Compact())
+	val, err = db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+}
+
+func TestDB_CallsAfterCloseShouldErr(t *testing.T) {
+	db, dir := createLogDb(t, 10, 1)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	require.Nil(t, db.Close())
+	// closing twice is not an error
+	assert.Nil(t, db.Close())
+
+	assert.Equal(t, storage.ErrLogDBIsClosed, db.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, storage.ErrLogDBIsClosed, db.Remove([]byte("key")))
+	_, err := db.Get([]byte("key"))
+	assert.Equal(t, storage.ErrLogDBIsClosed, err)
+}
+
+func TestDB_CorruptedTailShouldBeDropped(t *testing.T) {
+	db, dir := createLogDb(t, 10, 1)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	require.Nil(t, db.Put([]byte("key1"), []byte("value1")))
+	require.Nil(t, db.Put([]byte("key2"), []byte("value2")))
+	require.Nil(t, db.Close())
+
+	// simulate a write interrupted by a crash: the last record is only partially written
+	dataFilePath := filepath.Join(dir, "data.log")
+	fileInfo, err := os.Stat(dataFilePath)
+	require.Nil(t, err)
+	require.Nil(t, os.Truncate(dataFilePath, fileInfo.Size()-3))
+
+	db, err = logdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	val, err := db.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), val)
+	assert.NotNil(t, db.Has([]byte("key2")))
+
+	// the new writes are appended after the last valid record
+	require.Nil(t, db.Put([]byte("key3"), []byte("value3")))
+	require.Nil(t, db.Close())
+
+	db, err = logdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	val, err = db.Get([]byte("key3"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value3"), val)
+}
+
+func TestDB_CompactShouldKeepOnlyTheLatestValues(t *testing.T) {
+	db, dir := createLogDb(t, 10, 1)
+	defer func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	require.Nil(t, db.Put([]byte("key1"), []byte("value1")))
+	require.Nil(t, db.Put([]byte("key1"), []byte("value2")))
+	require.Nil(t, db.Put([]byte("key2"), []byte("value")))
+	require.Nil(t, db.Remove([]byte("key2")))
+	sizeBeforeCompaction := db.DataFileSize()
+
+	require.Nil(t, db.Compact())
+
+	assert.True(t, db.DataFileSize() < sizeBeforeCompaction)
+	val, err := db.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), val)
+	assert.NotNil(t, db.Has([]byte("key2")))
+
+	require.Nil(t, db.Put([]byte("key3"), []byte("value3")))
+	val, err = db.Get([]byte("key3"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value3"), val)
+}
+
+func TestDB_CompactShouldWriteThePendingBatch(t *testing.T) {
+	db, dir := createLogDb(t, 10, 100)
+	defer func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	require.Nil(t, db.Put([]byte("key"), []byte("value")))
+	require.Equal(t, int64(0), db.DataFileSize())
+
+	require.Nil(t, db.Compact())
+
+	assert.True(t, db.DataFileSize() > 0)
+	val, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+}
+
+func TestDB_CompactConcurrentWithWritesShouldKeepAllTheValues(t *testing.T) {
+	db, dir := createLogDb(t, 10, 1)
+	defer func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	numKeys := 100
+	for i := 0; i < numKeys; i++ {
+		require.Nil(t, db.Put([]byte(fmt.Sprintf("key%d", i)), []byte("old value")))
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		for i := 0; i < 10; i++ {
+			assert.Nil(t, db.Compact())
+		}
+		wg.Done()
+	}()
+	go func() {
+		for i := 0; i < numKeys; i++ {
+			key := []byte(fmt.Sprintf("key%d", i))
+			if i%10 == 0 {
+				assert.Nil(t, db.Remove(key))
+				continue
+			}
+			assert.Nil(t, db.Put(key, []byte("new value")))
+		}
+		wg.Done()
+	}()
+	wg.Wait()
+
+	require.Nil(t, db.Compact())
+	for i := 0; i < numKeys; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		if i%10 == 0 {
+			assert.NotNil(t, db.Has(key))
+			continue
+		}
+
+		val, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("new value"), val)
+	}
+}
+
+func TestDB_CompactClosedShouldErr(t *testing.T) {
+	db, dir := createLogDb(t, 10, 1)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	require.Nil(t, db.Close())
+
+	assert.Equal(t, storage.ErrLogDBIsClosed, db.Compact())
+}
+
+func TestDB_ReopenShouldCompactTheStaleRecords(t *testing.T) {
+	db, dir := createLogDb(t, 10, 1)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	for i := 0; i < 10; i++ {
+		require.Nil(t, db.Put([]byte("key"), []byte("value")))
+	}
+	sizeBeforeReopen := db.DataFileSize()
+	require.Nil(t, db.Close())
+
+	db, err := logdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	assert.Equal(t, sizeBeforeReopen/10, db.DataFileSize())
+	val, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+}
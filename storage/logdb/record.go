@@ -0,0 +1,74 @@
+package logdb
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+const (
+	// the header holds the checksum, the key length, the value length and the operation
+	recordHeaderSize = 13
+	maxRecordPayload = 1 << 30
+
+	opPut    = byte(0)
+	opRemove = byte(1)
+)
+
+var errCorruptedRecord = errors.New("corrupted record")
+
+type record struct {
+	key []byte
+	val []byte
+	op  byte
+}
+
+func (r *record) size() int64 {
+	return int64(recordHeaderSize + len(r.key) + len(r.val))
+}
+
+// appendRecord appends the encoded record to the buffer. The checksum covers everything following it.
+func appendRecord(buff []byte, r *record) []byte {
+	start := len(buff)
+	buff = append(buff, make([]byte, recordHeaderSize)...)
+	header := buff[start:]
+	binary.BigEndian.PutUint32(header[4:8], uint32(len(r.key)))
+	binary.BigEndian.PutUint32(header[8:12], uint32(len(r.val)))
+	header[12] = r.op
+
+	buff = append(buff, r.key...)
+	buff = append(buff, r.val...)
+	binary.BigEndian.PutUint32(buff[start:start+4], crc32.ChecksumIEEE(buff[start+4:]))
+
+	return buff
+}
+
+// decodeRecordHeader returns the lengths of the key and of the value following the header
+func decodeRecordHeader(header []byte) (uint32, uint32, error) {
+	keyLen := binary.BigEndian.Uint32(header[4:8])
+	valLen := binary.BigEndian.Uint32(header[8:12])
+	if uint64(keyLen)+uint64(valLen) > maxRecordPayload {
+		return 0, 0, errCorruptedRecord
+	}
+	if header[12] != opPut && header[12] != opRemove {
+		return 0, 0, errCorruptedRecord
+	}
+
+	return keyLen, valLen, nil
+}
+
+func decodeRecord(header []byte, payload []byte) (*record, error) {
+	checksum := crc32.ChecksumIEEE(header[4:])
+	checksum = crc32.Update(checksum, crc32.IEEETable, payload)
+	if checksum != binary.BigEndian.Uint32(header[0:4]) {
+		return nil, errCorruptedRecord
+	}
+
+	keyLen := binary.BigEndian.Uint32(header[4:8])
+
+	return &record{
+		key: payload[:keyLen],
+		val: payload[keyLen:],
+		op:  header[12],
+	}, nil
+}
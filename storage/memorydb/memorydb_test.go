@@ -3,7 +3,9 @@ package memorydb_test
 import (
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/persisterTests"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -127,3 +129,13 @@ func Test_RangeKeys(t *testing.T) {
 
 	assert.Equal(t, keysVals, recovered)
 }
+
+func TestDB_Conformance(t *testing.T) {
+	persisterTests.RunConformanceTests(
+		t,
+		func(_ string) (storage.Persister, error) {
+			return memorydb.New(), nil
+		},
+		false,
+	)
+}
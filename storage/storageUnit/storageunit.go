@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/logdb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 )
@@ -43,11 +44,11 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
-// More to be added
+// LvlDB, LvlDBSerial and LogDB are the supported on-disk DBs
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
+	LogDB       DBType = "LogDB"
 	MemoryDB    DBType = "MemoryDB"
 )
 
@@ -78,6 +79,8 @@ type CacheConfig struct {
 	Capacity             uint32
 	SizePerSender        uint32
 	Shards               uint32
+
+	ReplacementGasPriceBumpPercentage uint32
 }
 
 // String returns a readable representation of the object
@@ -438,6 +441,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case LogDB:
+			db, err = logdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
@@ -28,11 +28,16 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+
+	// ReplacementGasPriceBumpPercentage is the minimum gas price increase (in percents) that allows a transaction to
+	// replace an existing one, having the same sender and nonce. Zero means that replacement is disabled.
+	ReplacementGasPriceBumpPercentage uint32
 }
 
 type senderConstraints struct {
-	maxNumTxs   uint32
-	maxNumBytes uint32
+	maxNumTxs                         uint32
+	maxNumBytes                       uint32
+	replacementGasPriceBumpPercentage uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -66,8 +71,9 @@ func (config *ConfigSourceMe) verify() error {
 
 func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	return senderConstraints{
-		maxNumBytes: config.NumBytesPerSenderThreshold,
-		maxNumTxs:   config.CountPerSenderThreshold,
+		maxNumBytes:                       config.NumBytesPerSenderThreshold,
+		maxNumTxs:                         config.CountPerSenderThreshold,
+		replacementGasPriceBumpPercentage: config.ReplacementGasPriceBumpPercentage,
 	}
 }
 
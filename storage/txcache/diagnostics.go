@@ -0,0 +1,76 @@
+package txcache
+
+import (
+	"sort"
+)
+
+// SenderDiagnostics holds information about the transactions of a sender, useful for explaining
+// why they are (or are not) selected for processing
+type SenderDiagnostics struct {
+	Sender              []byte
+	NumTxs              uint64
+	AccountNonce        uint64
+	IsAccountNonceKnown bool
+	LowestTxNonce       uint64
+	NonceGap            uint64
+	NumFailedSelections int64
+	IsInGracePeriod     bool
+	Score               uint32
+}
+
+// GetSenderDiagnostics returns the diagnostics of a sender, if the sender has transactions in the cache
+func (cache *TxCache) GetSenderDiagnostics(sender []byte) (*SenderDiagnostics, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(string(sender))
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.getDiagnostics(), true
+}
+
+// GetSendersDiagnostics returns the diagnostics of all senders in the cache.
+// Senders skipped for more selection passes come first, then the ones with larger initial nonce gaps.
+func (cache *TxCache) GetSendersDiagnostics() []*SenderDiagnostics {
+	snapshotOfSenders := cache.txListBySender.getSnapshotDescending()
+	diagnostics := make([]*SenderDiagnostics, 0, len(snapshotOfSenders))
+	for _, listForSender := range snapshotOfSenders {
+		diagnostics = append(diagnostics, listForSender.getDiagnostics())
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].NumFailedSelections != diagnostics[j].NumFailedSelections {
+			return diagnostics[i].NumFailedSelections > diagnostics[j].NumFailedSelections
+		}
+
+		return diagnostics[i].NonceGap > diagnostics[j].NonceGap
+	})
+
+	return diagnostics
+}
+
+func (listForSender *txListForSender) getDiagnostics() *SenderDiagnostics {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	diagnostics := &SenderDiagnostics{
+		Sender:              []byte(listForSender.sender),
+		NumTxs:              listForSender.countTx(),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		IsAccountNonceKnown: listForSender.accountNonceKnown.IsSet(),
+		NumFailedSelections: listForSender.numFailedSelections.Get(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+		Score:               listForSender.getLastComputedScore(),
+	}
+
+	lowestNonceTx := listForSender.getLowestNonceTx()
+	if lowestNonceTx == nil {
+		return diagnostics
+	}
+
+	diagnostics.LowestTxNonce = lowestNonceTx.Tx.GetNonce()
+	if diagnostics.IsAccountNonceKnown && diagnostics.LowestTxNonce > diagnostics.AccountNonce {
+		diagnostics.NonceGap = diagnostics.LowestTxNonce - diagnostics.AccountNonce
+	}
+
+	return diagnostics
+}
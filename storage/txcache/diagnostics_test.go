@@ -0,0 +1,72 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxCache_GetSenderDiagnostics_UnknownSender(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	diagnostics, ok := cache.GetSenderDiagnostics([]byte("alice"))
+	require.False(t, ok)
+	require.Nil(t, diagnostics)
+}
+
+func TestTxCache_GetSenderDiagnostics_WithoutAccountNonce(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-4"), "alice", 4))
+	cache.AddTx(createTx([]byte("hash-alice-5"), "alice", 5))
+
+	diagnostics, ok := cache.GetSenderDiagnostics([]byte("alice"))
+	require.True(t, ok)
+	require.Equal(t, []byte("alice"), diagnostics.Sender)
+	require.Equal(t, uint64(2), diagnostics.NumTxs)
+	require.False(t, diagnostics.IsAccountNonceKnown)
+	require.Equal(t, uint64(4), diagnostics.LowestTxNonce)
+	require.Equal(t, uint64(0), diagnostics.NonceGap)
+	require.Equal(t, int64(0), diagnostics.NumFailedSelections)
+}
+
+func TestTxCache_GetSenderDiagnostics_WithInitialGap(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-4"), "alice", 4))
+	cache.NotifyAccountNonce([]byte("alice"), 1)
+
+	_ = cache.doSelectTransactions(10, 10)
+	_ = cache.doSelectTransactions(10, 10)
+
+	diagnostics, ok := cache.GetSenderDiagnostics([]byte("alice"))
+	require.True(t, ok)
+	require.True(t, diagnostics.IsAccountNonceKnown)
+	require.Equal(t, uint64(1), diagnostics.AccountNonce)
+	require.Equal(t, uint64(3), diagnostics.NonceGap)
+	require.Equal(t, int64(2), diagnostics.NumFailedSelections)
+	require.True(t, diagnostics.IsInGracePeriod)
+	require.Equal(t, cache.getScoreOfSender("alice"), diagnostics.Score)
+}
+
+func TestTxCache_GetSendersDiagnostics_StuckSendersFirst(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	cache.AddTx(createTx([]byte("hash-alice-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-bob-7"), "bob", 7))
+	cache.AddTx(createTx([]byte("hash-carol-3"), "carol", 3))
+	cache.NotifyAccountNonce([]byte("alice"), 1)
+	cache.NotifyAccountNonce([]byte("bob"), 1)
+	cache.NotifyAccountNonce([]byte("carol"), 2)
+
+	_ = cache.doSelectTransactions(10, 10)
+
+	diagnostics := cache.GetSendersDiagnostics()
+	require.Len(t, diagnostics, 3)
+	require.Equal(t, []byte("bob"), diagnostics[0].Sender)
+	require.Equal(t, uint64(6), diagnostics[0].NonceGap)
+	require.Equal(t, []byte("carol"), diagnostics[1].Sender)
+	require.Equal(t, uint64(1), diagnostics[1].NonceGap)
+	require.Equal(t, []byte("alice"), diagnostics[2].Sender)
+	require.Equal(t, int64(0), diagnostics[2].NumFailedSelections)
+}
@@ -71,7 +71,17 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	}
 
 	addedInByHash := cache.txByHash.addTx(tx)
-	addedInBySender, evicted := cache.txListBySender.addTx(tx)
+	addedInBySender, evicted, replaced, err := cache.txListBySender.addTx(tx)
+	if err != nil {
+		// The sender's list has rejected the transaction (e.g. underpriced replacement)
+		log.Trace("TxCache.AddTx(): transaction rejected", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "err", err)
+		if addedInByHash {
+			cache.txByHash.removeTx(string(tx.TxHash))
+		}
+
+		return true, false
+	}
+
 	if addedInByHash != addedInBySender {
 		// This can happen  when two go-routines concur to add the same transaction:
 		// - A adds to "txByHash"
@@ -81,6 +91,11 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 		log.Trace("TxCache.AddTx(): slight inconsistency detected:", "name", cache.name, "tx", tx.TxHash, "sender", tx.Tx.GetSndAddr(), "addedInByHash", addedInByHash, "addedInBySender", addedInBySender)
 	}
 
+	if len(replaced) > 0 {
+		log.Trace("TxCache.AddTx(): transaction replaced", "name", cache.name, "tx", tx.TxHash, "replaced", replaced)
+		cache.txByHash.removeTx(string(replaced))
+	}
+
 	if len(evicted) > 0 {
 		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
 		cache.txByHash.RemoveTxsBulk(evicted)
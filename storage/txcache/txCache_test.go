@@ -153,6 +153,37 @@ func Test_AddTx_AppliesSizeConstraintsPerSenderForNumBytes(t *testing.T) {
 	require.True(t, cache.areInternalMapsConsistent())
 }
 
+func Test_AddTx_ReplacesTransactionWithSameNonceAndHigherGasPrice(t *testing.T) {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                              "test",
+		NumChunks:                         16,
+		NumBytesPerSenderThreshold:        maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:           math.MaxUint32,
+		ReplacementGasPriceBumpPercentage: 10,
+	}, txGasHandler)
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("tx-alice-1"), "alice", 1, 128, 42, 100))
+	cache.AddTx(createTxWithParams([]byte("tx-alice-2"), "alice", 2, 128, 42, 100))
+
+	// Underpriced, discarded
+	ok, added := cache.AddTx(createTxWithParams([]byte("tx-alice-2+"), "alice", 2, 128, 42, 105))
+	require.True(t, ok)
+	require.False(t, added)
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2"}, cache.getHashesForSender("alice"))
+	require.False(t, cache.Has([]byte("tx-alice-2+")))
+	require.True(t, cache.areInternalMapsConsistent())
+
+	// Sufficiently priced, replaces the existing transaction
+	ok, added = cache.AddTx(createTxWithParams([]byte("tx-alice-2++"), "alice", 2, 128, 42, 110))
+	require.True(t, ok)
+	require.True(t, added)
+	require.Equal(t, []string{"tx-alice-1", "tx-alice-2++"}, cache.getHashesForSender("alice"))
+	require.False(t, cache.Has([]byte("tx-alice-2")))
+	require.True(t, cache.areInternalMapsConsistent())
+}
+
 func Test_RemoveByTxHash(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
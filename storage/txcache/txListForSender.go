@@ -3,6 +3,7 @@ package txcache
 import (
 	"bytes"
 	"container/list"
+	"math/big"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
@@ -49,14 +50,30 @@ func newTxListForSender(sender string, constraints *senderConstraints, onScoreCh
 
 // AddTx adds a transaction in sender's list
 // This is a "sorted" insert
-func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte) {
+// If replacement is enabled, a transaction having the same nonce as an existing one (but a sufficiently higher gas price)
+// replaces the existing transaction, whose hash is returned separately from the ones evicted due to the sender's limits.
+// If the gas price is not high enough, the incoming transaction is discarded and storage.ErrTxReplacementUnderpriced is returned.
+func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) (bool, [][]byte, []byte, error) {
 	// We don't allow concurrent interceptor goroutines to mutate a given sender's list
 	listForSender.mutex.Lock()
 	defer listForSender.mutex.Unlock()
 
+	elementToReplace, err := listForSender.findElementToReplace(tx)
+	if err == storage.ErrTxReplacementUnderpriced {
+		return false, nil, nil, err
+	}
+	if err != nil {
+		return false, nil, nil, nil
+	}
+	if elementToReplace != nil {
+		replacedTx := elementToReplace.Value.(*WrappedTransaction)
+		evicted := listForSender.replaceTx(elementToReplace, tx, gasHandler, txFeeHelper)
+		return true, evicted, replacedTx.TxHash, nil
+	}
+
 	insertionPlace, err := listForSender.findInsertionPlace(tx)
 	if err != nil {
-		return false, nil
+		return false, nil, nil, nil
 	}
 
 	if insertionPlace == nil {
@@ -68,7 +85,67 @@ func (listForSender *txListForSender) AddTx(tx *WrappedTransaction, gasHandler T
 	listForSender.onAddedTransaction(tx, gasHandler, txFeeHelper)
 	evicted := listForSender.applySizeConstraints()
 	listForSender.triggerScoreChange()
-	return true, evicted
+	return true, evicted, nil, nil
+}
+
+// replaceTx returns the transactions evicted due to the sender's limits, which do not include the replaced one
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) replaceTx(element *list.Element, tx *WrappedTransaction, gasHandler TxGasHandler, txFeeHelper feeHelper) [][]byte {
+	newElement := listForSender.items.InsertAfter(tx, element)
+	listForSender.items.Remove(element)
+	if listForSender.copyBatchIndex == element {
+		// A selection is in progress and the replaced transaction is the next one to be copied
+		listForSender.copyBatchIndex = newElement
+	}
+	listForSender.onRemovedListElement(element)
+	listForSender.onAddedTransaction(tx, gasHandler, txFeeHelper)
+
+	evicted := listForSender.applySizeConstraints()
+	listForSender.triggerScoreChange()
+	return evicted
+}
+
+// findElementToReplace returns the element holding the transaction with the same nonce as the incoming one, if replacement
+// is enabled and the incoming transaction offers a sufficiently higher gas price.
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) findElementToReplace(incomingTx *WrappedTransaction) (*list.Element, error) {
+	bumpPercentage := listForSender.constraints.replacementGasPriceBumpPercentage
+	if bumpPercentage == 0 {
+		return nil, nil
+	}
+
+	incomingNonce := incomingTx.Tx.GetNonce()
+
+	for element := listForSender.items.Back(); element != nil; element = element.Prev() {
+		currentTx := element.Value.(*WrappedTransaction)
+		currentTxNonce := currentTx.Tx.GetNonce()
+
+		if currentTxNonce < incomingNonce {
+			// The list is sorted by nonce, thus there is no transaction to replace
+			return nil, nil
+		}
+		if currentTxNonce > incomingNonce {
+			continue
+		}
+		if incomingTx.sameAs(currentTx) {
+			return nil, storage.ErrItemAlreadyInCache
+		}
+		if !isGasPriceBumpSufficient(currentTx.Tx.GetGasPrice(), incomingTx.Tx.GetGasPrice(), bumpPercentage) {
+			return nil, storage.ErrTxReplacementUnderpriced
+		}
+
+		return element, nil
+	}
+
+	return nil, nil
+}
+
+func isGasPriceBumpSufficient(currentGasPrice uint64, incomingGasPrice uint64, bumpPercentage uint32) bool {
+	// incomingGasPrice * 100 >= currentGasPrice * (100 + bumpPercentage), computed without overflows
+	incoming := big.NewInt(0).Mul(big.NewInt(0).SetUint64(incomingGasPrice), big.NewInt(100))
+	minIncoming := big.NewInt(0).Mul(big.NewInt(0).SetUint64(currentGasPrice), big.NewInt(100+int64(bumpPercentage)))
+
+	return incoming.Cmp(minIncoming) >= 0
 }
 
 // This function should only be used in critical section (listForSender.mutex)
@@ -4,6 +4,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -53,16 +54,105 @@ func TestListForSender_AddTx_IgnoresDuplicates(t *testing.T) {
 	list := newUnconstrainedListToTest()
 	txGasHandler, txFeeHelper := dummyParams()
 
-	added, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
+	added, _, _, _ := list.AddTx(createTx([]byte("tx1"), ".", 1), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	added, _, _, _ = list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.True(t, added)
-	added, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
+	added, _, _, _ = list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.False(t, added)
 }
 
+func TestListForSender_AddTx_ReplacesWhenGasPriceBumpIsSufficient(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	added, evicted, replaced, _ := list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 110), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Len(t, evicted, 0)
+	require.Equal(t, []byte("tx2"), replaced)
+	require.Equal(t, []string{"tx1", "tx2++", "tx3"}, list.getTxHashesAsStrings())
+	require.Equal(t, uint64(3), list.countTx())
+	require.Equal(t, int64(3*128), list.totalBytes.Get())
+}
+
+func TestListForSender_AddTx_DiscardsWhenGasPriceBumpIsInsufficient(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+
+	added, evicted, replaced, err := list.AddTx(createTxWithParams([]byte("tx2+"), ".", 2, 128, 42, 109), txGasHandler, txFeeHelper)
+	require.False(t, added)
+	require.Len(t, evicted, 0)
+	require.Nil(t, replaced)
+	require.Equal(t, storage.ErrTxReplacementUnderpriced, err)
+	require.Equal(t, []string{"tx1", "tx2"}, list.getTxHashesAsStrings())
+
+	// Duplicates are still ignored
+	added, evicted, replaced, err = list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	require.False(t, added)
+	require.Len(t, evicted, 0)
+	require.Nil(t, replaced)
+	require.Nil(t, err)
+}
+
+func TestListForSender_AddTx_ReplacementAppliesSizeConstraints(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	list.constraints.maxNumBytes = 512
+	txGasHandler, txFeeHelper := dummyParams()
+
+	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 128, 42, 100), txGasHandler, txFeeHelper)
+	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
+
+	added, evicted, replaced, _ := list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 256, 42, 200), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
+	require.Equal(t, []byte("tx2"), replaced)
+	require.Equal(t, []string{"tx1", "tx2++"}, list.getTxHashesAsStrings())
+}
+
+func TestListForSender_SelectBatchTo_WhenReplacementBetweenBatches(t *testing.T) {
+	list := newListWithReplacementToTest(10)
+	txGasHandler, txFeeHelper := dummyParams()
+
+	for index := 0; index < 4; index++ {
+		list.AddTx(createTxWithParams([]byte{byte(index)}, ".", uint64(index), 128, 42, 100), txGasHandler, txFeeHelper)
+	}
+
+	destination := make([]*WrappedTransaction, 4)
+
+	journal := list.selectBatchTo(true, destination, 2)
+	require.Equal(t, 2, journal.copied)
+
+	// The next transaction to be selected (nonce 2) is replaced
+	added, evicted, replaced, err := list.AddTx(createTxWithParams([]byte("2++"), ".", 2, 128, 42, 110), txGasHandler, txFeeHelper)
+	require.True(t, added)
+	require.Len(t, evicted, 0)
+	require.Equal(t, []byte{2}, replaced)
+	require.Nil(t, err)
+
+	journal = list.selectBatchTo(false, destination[2:], 2)
+	require.Equal(t, 2, journal.copied)
+	require.Equal(t, []byte("2++"), destination[2].TxHash)
+	require.Equal(t, []byte{3}, destination[3].TxHash)
+}
+
+func TestIsGasPriceBumpSufficient(t *testing.T) {
+	require.True(t, isGasPriceBumpSufficient(100, 110, 10))
+	require.False(t, isGasPriceBumpSufficient(100, 109, 10))
+	require.True(t, isGasPriceBumpSufficient(0, 0, 10))
+	require.True(t, isGasPriceBumpSufficient(math.MaxUint64/2, math.MaxUint64, 100))
+	require.False(t, isGasPriceBumpSufficient(math.MaxUint64, math.MaxUint64, 1))
+}
+
 func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing.T) {
 	list := newListToTest(math.MaxUint32, 3)
 	txGasHandler, txFeeHelper := dummyParams()
@@ -73,17 +163,17 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumTransactions(t *testing
 	list.AddTx(createTx([]byte("tx2"), ".", 2), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx4"}, list.getTxHashesAsStrings())
 
-	_, evicted := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
+	_, evicted, _, _ := list.AddTx(createTx([]byte("tx3"), ".", 3), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx3" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _, _ = list.AddTx(createTxWithParams([]byte("tx2++"), ".", 2, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3"}, hashesAsStrings(evicted))
 
 	// Though Undesirably to some extent, "tx3++"" is added, then evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2++", "tx2"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx3++"}, hashesAsStrings(evicted))
 }
@@ -95,20 +185,20 @@ func TestListForSender_AddTx_AppliesSizeConstraintsForNumBytes(t *testing.T) {
 	list.AddTx(createTxWithParams([]byte("tx1"), ".", 1, 128, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx2"), ".", 2, 512, 42, 42), txGasHandler, txFeeHelper)
 	list.AddTx(createTxWithParams([]byte("tx3"), ".", 3, 256, 42, 42), txGasHandler, txFeeHelper)
-	_, evicted := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _, _ := list.AddTx(createTxWithParams([]byte("tx5"), ".", 4, 256, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5"}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _, _ = list.AddTx(createTxWithParams([]byte("tx5--"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx5--"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{}, hashesAsStrings(evicted))
 
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
+	_, evicted, _, _ = list.AddTx(createTxWithParams([]byte("tx4"), ".", 4, 128, 42, 42), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3", "tx4"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx5--"}, hashesAsStrings(evicted))
 
 	// Gives priority to higher gas - though undesirably to some extent, "tx4" is evicted
-	_, evicted = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
+	_, evicted, _, _ = list.AddTx(createTxWithParams([]byte("tx3++"), ".", 3, 256, 42, 100), txGasHandler, txFeeHelper)
 	require.Equal(t, []string{"tx1", "tx2", "tx3++", "tx3"}, list.getTxHashesAsStrings())
 	require.Equal(t, []string{"tx4"}, hashesAsStrings(evicted))
 }
@@ -396,6 +486,14 @@ func newUnconstrainedListToTest() *txListForSender {
 	}, func(_ *txListForSender, _ senderScoreParams) {})
 }
 
+func newListWithReplacementToTest(replacementGasPriceBumpPercentage uint32) *txListForSender {
+	return newTxListForSender(".", &senderConstraints{
+		maxNumBytes:                       math.MaxUint32,
+		maxNumTxs:                         math.MaxUint32,
+		replacementGasPriceBumpPercentage: replacementGasPriceBumpPercentage,
+	}, func(_ *txListForSender, _ senderScoreParams) {})
+}
+
 func newListToTest(maxNumBytes uint32, maxNumTxs uint32) *txListForSender {
 	return newTxListForSender(".", &senderConstraints{
 		maxNumBytes: maxNumBytes,
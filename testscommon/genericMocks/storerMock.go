@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/container"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // StorerMock -
@@ -200,5 +201,5 @@ func (sm *StorerMock) IsInterfaceNil() bool {
 }
 
 func (sm *StorerMock) newErrNotFound(key []byte, epoch uint32) error {
-	return fmt.Errorf("StorerMock: not found in %s: key = %s, epoch = %d", sm.Name, hex.EncodeToString(key), epoch)
+	return fmt.Errorf("StorerMock: %w in %s: key = %s, epoch = %d", storage.ErrKeyNotFound, sm.Name, hex.EncodeToString(key), epoch)
 }
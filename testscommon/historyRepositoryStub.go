@@ -9,12 +9,17 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
-	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
-	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
-	GetEpochByHashCalled               func(hash []byte) (uint32, error)
-	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
-	IsEnabledCalled                    func() bool
+	RecordBlockCalled                      func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	OnNotarizedBlocksCalled                func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+	GetMiniblockMetadataByTxHashCalled     func(hash []byte) (*dblookupext.MiniblockMetadata, error)
+	GetEpochByHashCalled                   func(hash []byte) (uint32, error)
+	GetEventsHashesByTxHashCalled          func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetNumEventLocationsCalled             func(address []byte, identifier []byte, epoch uint32) (uint64, error)
+	GetEventLocationsCalled                func(address []byte, identifier []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.EventLocations, error)
+	GetNumAddressTransactionsCalled        func(address []byte, epoch uint32) (uint64, error)
+	GetAddressTransactionsCalled           func(address []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.AddressTransactions, error)
+	GetAddressTransactionsFirstEpochCalled func(epoch uint32) (uint32, error)
+	IsEnabledCalled                        func() bool
 }
 
 // RecordBlock -
@@ -67,6 +72,46 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetNumEventLocations -
+func (hp *HistoryRepositoryStub) GetNumEventLocations(address []byte, identifier []byte, epoch uint32) (uint64, error) {
+	if hp.GetNumEventLocationsCalled != nil {
+		return hp.GetNumEventLocationsCalled(address, identifier, epoch)
+	}
+	return 0, nil
+}
+
+// GetEventLocations -
+func (hp *HistoryRepositoryStub) GetEventLocations(address []byte, identifier []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.EventLocations, error) {
+	if hp.GetEventLocationsCalled != nil {
+		return hp.GetEventLocationsCalled(address, identifier, epoch, fromIndex, toIndex)
+	}
+	return nil, nil
+}
+
+// GetNumAddressTransactions -
+func (hp *HistoryRepositoryStub) GetNumAddressTransactions(address []byte, epoch uint32) (uint64, error) {
+	if hp.GetNumAddressTransactionsCalled != nil {
+		return hp.GetNumAddressTransactionsCalled(address, epoch)
+	}
+	return 0, nil
+}
+
+// GetAddressTransactions -
+func (hp *HistoryRepositoryStub) GetAddressTransactions(address []byte, epoch uint32, fromIndex uint64, toIndex uint64) (*dblookupext.AddressTransactions, error) {
+	if hp.GetAddressTransactionsCalled != nil {
+		return hp.GetAddressTransactionsCalled(address, epoch, fromIndex, toIndex)
+	}
+	return nil, nil
+}
+
+// GetAddressTransactionsFirstEpoch -
+func (hp *HistoryRepositoryStub) GetAddressTransactionsFirstEpoch(epoch uint32) (uint32, error) {
+	if hp.GetAddressTransactionsFirstEpochCalled != nil {
+		return hp.GetAddressTransactionsFirstEpochCalled(epoch)
+	}
+	return 0, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -0,0 +1,289 @@
+package persisterTests
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// PersisterCreator creates a persister storing its data in the provided directory. Opening twice the same directory
+// has to return the data written before closing it. RangeKeys is checked right after the writes, thus the persisters
+// iterating only over the written batches have to be created with a batch size of 1
+type PersisterCreator func(path string) (storage.Persister, error)
+
+// RunConformanceTests runs the tests every storage.Persister implementation has to pass. The writes can be batched,
+// but they have to be visible to the reads right away. The tests checking that the data survives closing and reopening
+// the persister run only for the durable implementations. The errors returned for the missing keys are not checked,
+// as the implementations return different ones
+func RunConformanceTests(t *testing.T, createPersister PersisterCreator, isDurable bool) {
+	t.Run("get after put", func(t *testing.T) {
+		testGetAfterPut(t, createPersister)
+	})
+	t.Run("get missing key", func(t *testing.T) {
+		testGetMissingKey(t, createPersister)
+	})
+	t.Run("overwrite", func(t *testing.T) {
+		testOverwrite(t, createPersister)
+	})
+	t.Run("remove", func(t *testing.T) {
+		testRemove(t, createPersister)
+	})
+	t.Run("large value", func(t *testing.T) {
+		testLargeValue(t, createPersister)
+	})
+	t.Run("range keys", func(t *testing.T) {
+		testRangeKeys(t, createPersister)
+	})
+	t.Run("range keys stops when the handler returns false", func(t *testing.T) {
+		testRangeKeysStop(t, createPersister)
+	})
+	t.Run("range keys with nil handler", func(t *testing.T) {
+		testRangeKeysNilHandler(t, createPersister)
+	})
+	t.Run("many writes", func(t *testing.T) {
+		testManyWrites(t, createPersister)
+	})
+
+	if !isDurable {
+		return
+	}
+
+	t.Run("data survives reopening", func(t *testing.T) {
+		testReopen(t, createPersister)
+	})
+	t.Run("destroy removes the data", func(t *testing.T) {
+		testDestroy(t, createPersister)
+	})
+	t.Run("destroy closed removes the data", func(t *testing.T) {
+		testDestroyClosed(t, createPersister)
+	})
+}
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "persister_conformance")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func openPersister(t *testing.T, createPersister PersisterCreator, dir string) storage.Persister {
+	persister, err := createPersister(dir)
+	require.Nil(t, err)
+	require.False(t, persister.IsInterfaceNil())
+	require.Nil(t, persister.Init())
+
+	return persister
+}
+
+func createPersisterInTempDir(t *testing.T, createPersister PersisterCreator) (storage.Persister, func()) {
+	dir := createTempDir(t)
+	persister := openPersister(t, createPersister, dir)
+	closeAndRemove := func() {
+		_ = persister.Close()
+		_ = os.RemoveAll(dir)
+	}
+
+	return persister, closeAndRemove
+}
+
+func testGetAfterPut(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	require.Nil(t, persister.Put([]byte("key"), []byte("value")))
+
+	val, err := persister.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), val)
+	assert.Nil(t, persister.Has([]byte("key")))
+}
+
+func testGetMissingKey(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	val, err := persister.Get([]byte("missing"))
+	assert.Nil(t, val)
+	assert.Error(t, err)
+	assert.Error(t, persister.Has([]byte("missing")))
+}
+
+func testOverwrite(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	require.Nil(t, persister.Put([]byte("key"), []byte("value1")))
+	require.Nil(t, persister.Put([]byte("key"), []byte("value2")))
+
+	val, err := persister.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), val)
+}
+
+func testRemove(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	require.Nil(t, persister.Put([]byte("key"), []byte("value")))
+	require.Nil(t, persister.Remove([]byte("key")))
+	// removing a missing key is not an error
+	assert.Nil(t, persister.Remove([]byte("missing")))
+
+	val, err := persister.Get([]byte("key"))
+	assert.Nil(t, val)
+	assert.Error(t, err)
+	assert.Error(t, persister.Has([]byte("key")))
+}
+
+func testLargeValue(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	largeValue := bytes.Repeat([]byte{0xAB}, 4*1024*1024)
+	require.Nil(t, persister.Put([]byte("key"), largeValue))
+
+	val, err := persister.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, largeValue, val)
+}
+
+func testRangeKeys(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	expected := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	}
+	for key, val := range expected {
+		require.Nil(t, persister.Put([]byte(key), val))
+	}
+	require.Nil(t, persister.Put([]byte("removed"), []byte("value")))
+	require.Nil(t, persister.Remove([]byte("removed")))
+
+	recovered := make(map[string][]byte)
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+
+	assert.Equal(t, expected, recovered)
+}
+
+func testRangeKeysStop(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	for i := 0; i < 10; i++ {
+		require.Nil(t, persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value")))
+	}
+
+	numCalls := 0
+	persister.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return false
+	})
+
+	assert.Equal(t, 1, numCalls)
+}
+
+func testRangeKeysNilHandler(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	require.Nil(t, persister.Put([]byte("key"), []byte("value")))
+
+	assert.NotPanics(t, func() {
+		persister.RangeKeys(nil)
+	})
+}
+
+func testManyWrites(t *testing.T, createPersister PersisterCreator) {
+	persister, closeAndRemove := createPersisterInTempDir(t, createPersister)
+	defer closeAndRemove()
+
+	numKeys := 1000
+	for i := 0; i < numKeys; i++ {
+		require.Nil(t, persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	for i := 0; i < numKeys; i += 2 {
+		require.Nil(t, persister.Remove([]byte(fmt.Sprintf("key%d", i))))
+	}
+
+	for i := 0; i < numKeys; i++ {
+		val, err := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+		if i%2 == 0 {
+			assert.Error(t, err)
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), val)
+	}
+}
+
+func testReopen(t *testing.T, createPersister PersisterCreator) {
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	persister := openPersister(t, createPersister, dir)
+	require.Nil(t, persister.Put([]byte("key1"), []byte("value1")))
+	require.Nil(t, persister.Put([]byte("key2"), []byte("value2")))
+	require.Nil(t, persister.Put([]byte("key2"), []byte("value3")))
+	require.Nil(t, persister.Put([]byte("removed"), []byte("value")))
+	require.Nil(t, persister.Remove([]byte("removed")))
+	require.Nil(t, persister.Close())
+
+	persister = openPersister(t, createPersister, dir)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	val, err := persister.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), val)
+
+	val, err = persister.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value3"), val)
+
+	assert.Error(t, persister.Has([]byte("removed")))
+}
+
+func testDestroy(t *testing.T, createPersister PersisterCreator) {
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	persister := openPersister(t, createPersister, dir)
+	require.Nil(t, persister.Put([]byte("key"), []byte("value")))
+	require.Nil(t, persister.Destroy())
+
+	_, err := os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func testDestroyClosed(t *testing.T, createPersister PersisterCreator) {
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	persister := openPersister(t, createPersister, dir)
+	require.Nil(t, persister.Put([]byte("key"), []byte("value")))
+	require.Nil(t, persister.Close())
+	require.Nil(t, persister.DestroyClosed())
+
+	_, err := os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
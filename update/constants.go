@@ -4,3 +4,7 @@ import "time"
 
 // TimeoutGettingTrieNodes represents the maximum time allowed between 2 nodes fetches (and commits)
 const TimeoutGettingTrieNodes = time.Minute * 10
+
+// JSONLinesExportFolder is the name of the folder, placed inside the hardfork export folder, holding the JSON Lines
+// export of the state
+const JSONLinesExportFolder = "jsonl"
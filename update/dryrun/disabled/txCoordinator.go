@@ -0,0 +1,108 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// TxCoordinator implements the TransactionCoordinator interface but does nothing as it is disabled
+type TxCoordinator struct {
+}
+
+// RequestMiniBlocks does nothing as it is disabled
+func (txc *TxCoordinator) RequestMiniBlocks(_ data.HeaderHandler) {
+}
+
+// RequestBlockTransactions does nothing as it is disabled
+func (txc *TxCoordinator) RequestBlockTransactions(_ *block.Body) {
+}
+
+// IsDataPreparedForProcessing returns nil as it is disabled
+func (txc *TxCoordinator) IsDataPreparedForProcessing(_ func() time.Duration) error {
+	return nil
+}
+
+// SaveTxsToStorage returns nil as it is disabled
+func (txc *TxCoordinator) SaveTxsToStorage(_ *block.Body) error {
+	return nil
+}
+
+// RestoreBlockDataFromStorage returns 0 and nil as it is disabled
+func (txc *TxCoordinator) RestoreBlockDataFromStorage(_ *block.Body) (int, error) {
+	return 0, nil
+}
+
+// RemoveBlockDataFromPool returns nil as it is disabled
+func (txc *TxCoordinator) RemoveBlockDataFromPool(_ *block.Body) error {
+	return nil
+}
+
+// RemoveTxsFromPool returns nil as it is disabled
+func (txc *TxCoordinator) RemoveTxsFromPool(_ *block.Body) error {
+	return nil
+}
+
+// ProcessBlockTransaction returns nil as it is disabled
+func (txc *TxCoordinator) ProcessBlockTransaction(_ *block.Body, _ func() time.Duration) error {
+	return nil
+}
+
+// CreateBlockStarted does nothing as it is disabled
+func (txc *TxCoordinator) CreateBlockStarted() {
+}
+
+// CreateMbsAndProcessCrossShardTransactionsDstMe returns empty values as it is disabled
+func (txc *TxCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe(
+	_ data.HeaderHandler,
+	_ map[string]struct{},
+	_ func() bool,
+) (block.MiniBlockSlice, uint32, bool, error) {
+	return make(block.MiniBlockSlice, 0), 0, false, nil
+}
+
+// CreateMbsAndProcessTransactionsFromMe returns an empty slice as it is disabled
+func (txc *TxCoordinator) CreateMbsAndProcessTransactionsFromMe(_ func() bool) block.MiniBlockSlice {
+	return make(block.MiniBlockSlice, 0)
+}
+
+// CreatePostProcessMiniBlocks returns an empty slice as it is disabled
+func (txc *TxCoordinator) CreatePostProcessMiniBlocks() block.MiniBlockSlice {
+	return make(block.MiniBlockSlice, 0)
+}
+
+// CreateMarshalizedData returns an empty map as it is disabled
+func (txc *TxCoordinator) CreateMarshalizedData(_ *block.Body) map[string][][]byte {
+	return make(map[string][][]byte)
+}
+
+// GetAllCurrentUsedTxs returns an empty map as it is disabled
+func (txc *TxCoordinator) GetAllCurrentUsedTxs(_ block.Type) map[string]data.TransactionHandler {
+	return make(map[string]data.TransactionHandler)
+}
+
+// CreateReceiptsHash returns an empty hash as it is disabled
+func (txc *TxCoordinator) CreateReceiptsHash() ([]byte, error) {
+	return make([]byte, 0), nil
+}
+
+// VerifyCreatedBlockTransactions returns nil as it is disabled
+func (txc *TxCoordinator) VerifyCreatedBlockTransactions(_ data.HeaderHandler, _ *block.Body) error {
+	return nil
+}
+
+// CreateMarshalizedReceipts returns an empty slice as it is disabled
+func (txc *TxCoordinator) CreateMarshalizedReceipts() ([]byte, error) {
+	return make([]byte, 0), nil
+}
+
+// VerifyCreatedMiniBlocks returns nil as it is disabled
+func (txc *TxCoordinator) VerifyCreatedMiniBlocks(_ data.HeaderHandler, _ *block.Body) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (txc *TxCoordinator) IsInterfaceNil() bool {
+	return txc == nil
+}
@@ -0,0 +1,395 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+)
+
+var _ update.StateSyncer = (*localStateSyncer)(nil)
+
+var log = logger.GetOrCreate("update/dryrun")
+
+// ArgsLocalStateSyncer defines the arguments needed to create a new local state syncer
+type ArgsLocalStateSyncer struct {
+	StorageReader    update.LocalStorageReader
+	AccountsTries    map[uint32]data.Trie
+	PeerAccountsTrie data.Trie
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+}
+
+type localStateSyncer struct {
+	storageReader    update.LocalStorageReader
+	accountsTries    map[uint32]data.Trie
+	peerAccountsTrie data.Trie
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+
+	synced               bool
+	epochStartMetaBlock  *block.MetaBlock
+	unFinishedMetaBlocks map[string]*block.MetaBlock
+	tries                map[string]data.Trie
+	miniBlocks           map[string]*block.MiniBlock
+	transactions         map[string]data.TransactionHandler
+}
+
+// NewLocalStateSyncer creates a state syncer which, instead of requesting the data from the network, reads it from
+// the local databases of one or more nodes. The databases must hold the state of every shard at the epoch start
+func NewLocalStateSyncer(args ArgsLocalStateSyncer) (*localStateSyncer, error) {
+	if check.IfNil(args.StorageReader) {
+		return nil, update.ErrNilStorageReader
+	}
+	if len(args.AccountsTries) == 0 {
+		return nil, update.ErrNoAccountsTries
+	}
+	for shardID, accountsTrie := range args.AccountsTries {
+		if check.IfNil(accountsTrie) {
+			return nil, fmt.Errorf("%w for shard %d", state.ErrNilTrie, shardID)
+		}
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, update.ErrNilHasher
+	}
+
+	return &localStateSyncer{
+		storageReader:    args.StorageReader,
+		accountsTries:    args.AccountsTries,
+		peerAccountsTrie: args.PeerAccountsTrie,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+	}, nil
+}
+
+// SyncAllState reads from the local databases the epoch start metaBlock of the given epoch, the unFinished metaBlocks,
+// the tries of all the shards, the pending miniBlocks and their transactions
+func (lss *localStateSyncer) SyncAllState(epoch uint32) error {
+	lss.synced = false
+
+	epochStartMetaBlock, err := lss.getMetaBlock([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return fmt.Errorf("%w while reading the epoch start metaBlock of epoch %d", err, epoch)
+	}
+	if !epochStartMetaBlock.IsStartOfEpochBlock() && epochStartMetaBlock.Nonce > 0 {
+		return update.ErrNotEpochStartBlock
+	}
+	lss.epochStartMetaBlock = epochStartMetaBlock
+
+	lss.unFinishedMetaBlocks, err = lss.readUnFinishedMetaBlocks(epochStartMetaBlock)
+	if err != nil {
+		return err
+	}
+
+	lss.tries, err = lss.recreateTries(epochStartMetaBlock)
+	if err != nil {
+		return err
+	}
+
+	lss.miniBlocks, err = lss.readPendingMiniBlocks(epochStartMetaBlock, lss.unFinishedMetaBlocks)
+	if err != nil {
+		return err
+	}
+
+	lss.transactions, err = lss.readPendingTransactions(lss.miniBlocks)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("local state read",
+		"epoch", epoch,
+		"unFinished metaBlocks", len(lss.unFinishedMetaBlocks),
+		"tries", len(lss.tries),
+		"pending miniBlocks", len(lss.miniBlocks),
+		"pending transactions", len(lss.transactions),
+	)
+	lss.synced = true
+
+	return nil
+}
+
+func (lss *localStateSyncer) getMetaBlock(key []byte) (*block.MetaBlock, error) {
+	buff, err := lss.storageReader.Get(dataRetriever.MetaBlockUnit, key)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = lss.marshalizer.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return metaBlock, nil
+}
+
+// readUnFinishedMetaBlocks reads the first pending metaBlocks of all the shards and all the metaBlocks between them
+// and the epoch start metaBlock, which is also part of the result, as the network header syncer does
+func (lss *localStateSyncer) readUnFinishedMetaBlocks(epochStartMetaBlock *block.MetaBlock) (map[string]*block.MetaBlock, error) {
+	epochStartHash, err := core.CalculateHash(lss.marshalizer, lss.hasher, epochStartMetaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	unFinishedMetaBlocks := map[string]*block.MetaBlock{
+		string(epochStartHash): epochStartMetaBlock,
+	}
+	lowestPendingNonce := epochStartMetaBlock.Nonce
+	for _, shardData := range epochStartMetaBlock.EpochStart.LastFinalizedHeaders {
+		metaHash := string(shardData.FirstPendingMetaBlock)
+		if _, ok := unFinishedMetaBlocks[metaHash]; ok {
+			continue
+		}
+
+		metaBlock, errGet := lss.getMetaBlock(shardData.FirstPendingMetaBlock)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w while reading the first pending metaBlock %x of shard %d",
+				errGet, shardData.FirstPendingMetaBlock, shardData.ShardID)
+		}
+
+		unFinishedMetaBlocks[metaHash] = metaBlock
+		if metaBlock.Nonce < lowestPendingNonce {
+			lowestPendingNonce = metaBlock.Nonce
+		}
+	}
+
+	attestingMetaBlock := epochStartMetaBlock
+	for attestingMetaBlock.Nonce > lowestPendingNonce+1 {
+		prevHash := attestingMetaBlock.GetPrevHash()
+		metaBlock, ok := unFinishedMetaBlocks[string(prevHash)]
+		if !ok {
+			metaBlock, err = lss.getMetaBlock(prevHash)
+			if err != nil {
+				return nil, fmt.Errorf("%w while reading the unFinished metaBlock with nonce %d",
+					err, attestingMetaBlock.Nonce-1)
+			}
+			unFinishedMetaBlocks[string(prevHash)] = metaBlock
+		}
+
+		attestingMetaBlock = metaBlock
+	}
+
+	return unFinishedMetaBlocks, nil
+}
+
+func (lss *localStateSyncer) recreateTries(epochStartMetaBlock *block.MetaBlock) (map[string]data.Trie, error) {
+	tries := make(map[string]data.Trie)
+	for _, shardData := range epochStartMetaBlock.EpochStart.LastFinalizedHeaders {
+		err := lss.recreateAccountsTries(tries, shardData.ShardID, shardData.RootHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := lss.recreateAccountsTries(tries, core.MetachainShardId, epochStartMetaBlock.RootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if check.IfNil(lss.peerAccountsTrie) {
+		return nil, fmt.Errorf("%w: peer accounts trie", update.ErrMissingShardState)
+	}
+	validatorsTrie, err := lss.peerAccountsTrie.Recreate(epochStartMetaBlock.ValidatorStatsRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while recreating the peer accounts trie: %s", update.ErrMissingShardState, err.Error())
+	}
+	tries[genesis.CreateTrieIdentifier(core.MetachainShardId, genesis.ValidatorAccount)] = validatorsTrie
+
+	return tries, nil
+}
+
+// recreateAccountsTries recreates the accounts trie of the shard and the data tries of all its accounts
+func (lss *localStateSyncer) recreateAccountsTries(tries map[string]data.Trie, shardID uint32, rootHash []byte) error {
+	localTrie, ok := lss.accountsTries[shardID]
+	if !ok {
+		return fmt.Errorf("%w: no accounts trie for shard %d", update.ErrMissingShardState, shardID)
+	}
+
+	accountsTrie, err := localTrie.Recreate(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w: accounts trie of shard %d, root hash %x: %s",
+			update.ErrMissingShardState, shardID, rootHash, err.Error())
+	}
+	tries[genesis.CreateTrieIdentifier(shardID, genesis.UserAccount)] = accountsTrie
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := accountsTrie.GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return err
+	}
+
+	dataTrieIdentifier := genesis.CreateTrieIdentifier(shardID, genesis.DataTrie)
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		errUnmarshal := lss.marshalizer.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			continue
+		}
+		if len(account.RootHash) == 0 {
+			continue
+		}
+
+		identifier := genesis.AddRootHashToIdentifier(dataTrieIdentifier, string(account.RootHash))
+		if _, ok = tries[identifier]; ok {
+			continue
+		}
+
+		dataTrie, errRecreate := localTrie.Recreate(account.RootHash)
+		if errRecreate != nil {
+			return fmt.Errorf("%w: data trie of account %x from shard %d: %s",
+				update.ErrMissingShardState, leaf.Key(), shardID, errRecreate.Error())
+		}
+		tries[identifier] = dataTrie
+	}
+
+	return nil
+}
+
+func (lss *localStateSyncer) readPendingMiniBlocks(
+	epochStartMetaBlock *block.MetaBlock,
+	unFinishedMetaBlocks map[string]*block.MetaBlock,
+) (map[string]*block.MiniBlock, error) {
+	pendingMiniBlockHeaders, err := update.GetPendingMiniBlocks(epochStartMetaBlock, unFinishedMetaBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks := make(map[string]*block.MiniBlock, len(pendingMiniBlockHeaders))
+	for _, miniBlockHeader := range pendingMiniBlockHeaders {
+		buff, errGet := lss.storageReader.Get(dataRetriever.MiniBlockUnit, miniBlockHeader.Hash)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w while reading the pending miniBlock %x", errGet, miniBlockHeader.Hash)
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = lss.marshalizer.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlocks[string(miniBlockHeader.Hash)] = miniBlock
+	}
+
+	return miniBlocks, nil
+}
+
+func (lss *localStateSyncer) readPendingTransactions(miniBlocks map[string]*block.MiniBlock) (map[string]data.TransactionHandler, error) {
+	miniBlockHashes := make([]string, 0, len(miniBlocks))
+	for hash := range miniBlocks {
+		miniBlockHashes = append(miniBlockHashes, hash)
+	}
+	sort.Strings(miniBlockHashes)
+
+	transactions := make(map[string]data.TransactionHandler)
+	for _, miniBlockHash := range miniBlockHashes {
+		miniBlock := miniBlocks[miniBlockHash]
+		for _, txHash := range miniBlock.TxHashes {
+			tx, err := lss.readTransaction(miniBlock.Type, txHash)
+			if err != nil {
+				return nil, fmt.Errorf("%w while reading the transaction %x of the pending miniBlock %x",
+					err, txHash, []byte(miniBlockHash))
+			}
+
+			transactions[string(txHash)] = tx
+		}
+	}
+
+	return transactions, nil
+}
+
+func (lss *localStateSyncer) readTransaction(miniBlockType block.Type, txHash []byte) (data.TransactionHandler, error) {
+	var tx data.TransactionHandler
+	var unitType dataRetriever.UnitType
+	switch miniBlockType {
+	case block.TxBlock:
+		tx, unitType = &transaction.Transaction{}, dataRetriever.TransactionUnit
+	case block.SmartContractResultBlock:
+		tx, unitType = &smartContractResult.SmartContractResult{}, dataRetriever.UnsignedTransactionUnit
+	case block.RewardsBlock:
+		tx, unitType = &rewardTx.RewardTx{}, dataRetriever.RewardTransactionUnit
+	default:
+		return nil, update.ErrInvalidMiniBlockType
+	}
+
+	buff, err := lss.storageReader.Get(unitType, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lss.marshalizer.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// GetEpochStartMetaBlock returns the epoch start metaBlock read from the local databases
+func (lss *localStateSyncer) GetEpochStartMetaBlock() (*block.MetaBlock, error) {
+	if !lss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	return lss.epochStartMetaBlock, nil
+}
+
+// GetUnFinishedMetaBlocks returns the unFinished metaBlocks read from the local databases
+func (lss *localStateSyncer) GetUnFinishedMetaBlocks() (map[string]*block.MetaBlock, error) {
+	if !lss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	return lss.unFinishedMetaBlocks, nil
+}
+
+// GetAllTries returns the tries recreated from the local databases
+func (lss *localStateSyncer) GetAllTries() (map[string]data.Trie, error) {
+	if !lss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	return lss.tries, nil
+}
+
+// GetAllTransactions returns the pending transactions read from the local databases
+func (lss *localStateSyncer) GetAllTransactions() (map[string]data.TransactionHandler, error) {
+	if !lss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	return lss.transactions, nil
+}
+
+// GetAllMiniBlocks returns the pending miniBlocks read from the local databases
+func (lss *localStateSyncer) GetAllMiniBlocks() (map[string]*block.MiniBlock, error) {
+	if !lss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	return lss.miniBlocks, nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (lss *localStateSyncer) IsInterfaceNil() bool {
+	return lss == nil
+}
@@ -0,0 +1,272 @@
+package dryrun
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var marshalizer = &mock.MarshalizerMock{}
+var hasher = &mock.HasherMock{}
+
+type storageReaderStub struct {
+	units map[dataRetriever.UnitType]map[string][]byte
+}
+
+func newStorageReaderStub() *storageReaderStub {
+	return &storageReaderStub{
+		units: make(map[dataRetriever.UnitType]map[string][]byte),
+	}
+}
+
+func (srs *storageReaderStub) put(unitType dataRetriever.UnitType, key []byte, value []byte) {
+	if srs.units[unitType] == nil {
+		srs.units[unitType] = make(map[string][]byte)
+	}
+	srs.units[unitType][string(key)] = value
+}
+
+func (srs *storageReaderStub) Get(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+	value, ok := srs.units[unitType][string(key)]
+	if !ok {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return value, nil
+}
+
+func (srs *storageReaderStub) IsInterfaceNil() bool {
+	return srs == nil
+}
+
+type localChain struct {
+	storageReader    *storageReaderStub
+	accountsTries    map[uint32]data.Trie
+	peerAccountsTrie data.Trie
+	epochStartMeta   *block.MetaBlock
+	txHash           []byte
+}
+
+func createTrie() data.Trie {
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+
+	return tr
+}
+
+func createAccounts(t *testing.T, tr data.Trie, numAccounts int, shardID byte) []byte {
+	adb, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	for i := 0; i < numAccounts; i++ {
+		address := make([]byte, 32)
+		address[0] = byte(i + 1)
+		address[31] = shardID
+
+		account, errLoad := adb.LoadAccount(address)
+		require.Nil(t, errLoad)
+		userAccount := account.(state.UserAccountHandler)
+		require.Nil(t, userAccount.AddToBalance(big.NewInt(int64(100*(i+1)))))
+		if i%2 == 0 {
+			require.Nil(t, userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value")))
+		}
+		require.Nil(t, adb.SaveAccount(account))
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func putInStorage(t *testing.T, storageReader *storageReaderStub, unitType dataRetriever.UnitType, key []byte, object interface{}) {
+	buff, err := marshalizer.Marshal(object)
+	require.Nil(t, err)
+	storageReader.put(unitType, key, buff)
+}
+
+// createLocalChain creates the state of one shard and of the metachain, an epoch start metaBlock whose first pending
+// metaBlock is two nonces behind and a pending miniBlock holding one transaction
+func createLocalChain(t *testing.T, epoch uint32) *localChain {
+	chain := &localChain{
+		storageReader: newStorageReaderStub(),
+		accountsTries: map[uint32]data.Trie{
+			0:                     createTrie(),
+			core.MetachainShardId: createTrie(),
+		},
+		peerAccountsTrie: createTrie(),
+	}
+	shardRootHash := createAccounts(t, chain.accountsTries[0], 5, 0)
+	metaRootHash := createAccounts(t, chain.accountsTries[core.MetachainShardId], 2, 255)
+
+	tx := &transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("sender"), RcvAddr: []byte("receiver")}
+	chain.txHash, _ = core.CalculateHash(marshalizer, hasher, tx)
+	putInStorage(t, chain.storageReader, dataRetriever.TransactionUnit, chain.txHash, tx)
+
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{chain.txHash}, SenderShardID: core.MetachainShardId, ReceiverShardID: 0, Type: block.TxBlock}
+	miniBlockHash, _ := core.CalculateHash(marshalizer, hasher, miniBlock)
+	putInStorage(t, chain.storageReader, dataRetriever.MiniBlockUnit, miniBlockHash, miniBlock)
+
+	firstPendingMeta := &block.MetaBlock{Nonce: 8, Epoch: epoch - 1}
+	firstPendingMetaHash, _ := core.CalculateHash(marshalizer, hasher, firstPendingMeta)
+	putInStorage(t, chain.storageReader, dataRetriever.MetaBlockUnit, firstPendingMetaHash, firstPendingMeta)
+
+	intermediateMeta := &block.MetaBlock{Nonce: 9, Epoch: epoch - 1, PrevHash: firstPendingMetaHash}
+	intermediateMetaHash, _ := core.CalculateHash(marshalizer, hasher, intermediateMeta)
+	putInStorage(t, chain.storageReader, dataRetriever.MetaBlockUnit, intermediateMetaHash, intermediateMeta)
+
+	chain.epochStartMeta = &block.MetaBlock{
+		Nonce:    10,
+		Epoch:    epoch,
+		PrevHash: intermediateMetaHash,
+		RootHash: metaRootHash,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{
+					ShardID:               0,
+					RootHash:              shardRootHash,
+					FirstPendingMetaBlock: firstPendingMetaHash,
+					PendingMiniBlockHeaders: []block.MiniBlockHeader{
+						{Hash: miniBlockHash, SenderShardID: core.MetachainShardId, ReceiverShardID: 0, TxCount: 1, Type: block.TxBlock},
+					},
+				},
+			},
+		},
+	}
+	putInStorage(t, chain.storageReader, dataRetriever.MetaBlockUnit, []byte(core.EpochStartIdentifier(epoch)), chain.epochStartMeta)
+
+	return chain
+}
+
+func createArgsLocalStateSyncer(chain *localChain) ArgsLocalStateSyncer {
+	return ArgsLocalStateSyncer{
+		StorageReader:    chain.storageReader,
+		AccountsTries:    chain.accountsTries,
+		PeerAccountsTrie: chain.peerAccountsTrie,
+		Marshalizer:      marshalizer,
+		Hasher:           hasher,
+	}
+}
+
+func TestNewLocalStateSyncer(t *testing.T) {
+	t.Parallel()
+
+	chain := createLocalChain(t, 2)
+
+	args := createArgsLocalStateSyncer(chain)
+	args.StorageReader = nil
+	syncer, err := NewLocalStateSyncer(args)
+	assert.True(t, check.IfNil(syncer))
+	assert.Equal(t, update.ErrNilStorageReader, err)
+
+	args = createArgsLocalStateSyncer(chain)
+	args.AccountsTries = nil
+	syncer, err = NewLocalStateSyncer(args)
+	assert.True(t, check.IfNil(syncer))
+	assert.Equal(t, update.ErrNoAccountsTries, err)
+
+	args = createArgsLocalStateSyncer(chain)
+	args.AccountsTries = map[uint32]data.Trie{0: nil}
+	syncer, err = NewLocalStateSyncer(args)
+	assert.True(t, check.IfNil(syncer))
+	assert.True(t, errors.Is(err, state.ErrNilTrie))
+
+	args = createArgsLocalStateSyncer(chain)
+	args.Marshalizer = nil
+	syncer, err = NewLocalStateSyncer(args)
+	assert.True(t, check.IfNil(syncer))
+	assert.Equal(t, update.ErrNilMarshalizer, err)
+
+	args = createArgsLocalStateSyncer(chain)
+	args.Hasher = nil
+	syncer, err = NewLocalStateSyncer(args)
+	assert.True(t, check.IfNil(syncer))
+	assert.Equal(t, update.ErrNilHasher, err)
+
+	syncer, err = NewLocalStateSyncer(createArgsLocalStateSyncer(chain))
+	assert.False(t, check.IfNil(syncer))
+	assert.Nil(t, err)
+}
+
+func TestLocalStateSyncer_GettersBeforeSyncShouldErr(t *testing.T) {
+	t.Parallel()
+
+	syncer, _ := NewLocalStateSyncer(createArgsLocalStateSyncer(createLocalChain(t, 2)))
+
+	_, err := syncer.GetEpochStartMetaBlock()
+	assert.Equal(t, update.ErrNotSynced, err)
+	_, err = syncer.GetAllTries()
+	assert.Equal(t, update.ErrNotSynced, err)
+	_, err = syncer.GetAllMiniBlocks()
+	assert.Equal(t, update.ErrNotSynced, err)
+}
+
+func TestLocalStateSyncer_SyncAllStateShouldReadEverything(t *testing.T) {
+	t.Parallel()
+
+	chain := createLocalChain(t, 2)
+	syncer, _ := NewLocalStateSyncer(createArgsLocalStateSyncer(chain))
+
+	err := syncer.SyncAllState(2)
+	require.Nil(t, err)
+
+	epochStartMeta, _ := syncer.GetEpochStartMetaBlock()
+	assert.Equal(t, chain.epochStartMeta, epochStartMeta)
+
+	unFinishedMetaBlocks, _ := syncer.GetUnFinishedMetaBlocks()
+	assert.Equal(t, 3, len(unFinishedMetaBlocks))
+
+	tries, _ := syncer.GetAllTries()
+	_, found := tries[genesis.CreateTrieIdentifier(0, genesis.UserAccount)]
+	assert.True(t, found)
+	_, found = tries[genesis.CreateTrieIdentifier(core.MetachainShardId, genesis.ValidatorAccount)]
+	assert.True(t, found)
+	// the accounts tries of the two shards, the peer accounts trie and one data trie holding the same key and value
+	// for each shard, as identical data tries have the same root hash
+	assert.Equal(t, 5, len(tries))
+
+	miniBlocks, _ := syncer.GetAllMiniBlocks()
+	assert.Equal(t, 1, len(miniBlocks))
+
+	transactions, _ := syncer.GetAllTransactions()
+	_, found = transactions[string(chain.txHash)]
+	assert.True(t, found)
+}
+
+func TestLocalStateSyncer_SyncAllStateMissingDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chain := createLocalChain(t, 2)
+	syncer, _ := NewLocalStateSyncer(createArgsLocalStateSyncer(chain))
+	err := syncer.SyncAllState(3)
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+
+	chain = createLocalChain(t, 2)
+	delete(chain.accountsTries, 0)
+	syncer, _ = NewLocalStateSyncer(createArgsLocalStateSyncer(chain))
+	err = syncer.SyncAllState(2)
+	assert.True(t, errors.Is(err, update.ErrMissingShardState))
+
+	chain = createLocalChain(t, 2)
+	chain.storageReader.units[dataRetriever.TransactionUnit] = nil
+	syncer, _ = NewLocalStateSyncer(createArgsLocalStateSyncer(chain))
+	err = syncer.SyncAllState(2)
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+}
@@ -0,0 +1,57 @@
+package dryrun
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/update"
+)
+
+var _ update.PendingTransactionProcessor = (*pendingTransactionsCounter)(nil)
+
+// pendingTransactionsCounter replaces the pending transactions processor during a simulation. Executing the pending
+// transactions requires the virtual machines and the whole processing configuration, so they are only counted and
+// left out of the created miniBlocks
+type pendingTransactionsCounter struct {
+	accounts        state.AccountsAdapter
+	numMiniBlocks   int
+	numTransactions int
+}
+
+func newPendingTransactionsCounter(accounts state.AccountsAdapter) (*pendingTransactionsCounter, error) {
+	if check.IfNil(accounts) {
+		return nil, update.ErrNilAccounts
+	}
+
+	return &pendingTransactionsCounter{
+		accounts: accounts,
+	}, nil
+}
+
+// ProcessTransactionsDstMe counts the transactions of the miniBlock and returns an empty miniBlock of the same type
+func (ptc *pendingTransactionsCounter) ProcessTransactionsDstMe(mbInfo *update.MbInfo) (*block.MiniBlock, error) {
+	ptc.numMiniBlocks++
+	ptc.numTransactions += len(mbInfo.TxsInfo)
+
+	return &block.MiniBlock{
+		TxHashes:        make([][]byte, 0),
+		ReceiverShardID: mbInfo.ReceiverShardID,
+		SenderShardID:   mbInfo.SenderShardID,
+		Type:            mbInfo.Type,
+	}, nil
+}
+
+// RootHash returns the root hash of the imported accounts
+func (ptc *pendingTransactionsCounter) RootHash() ([]byte, error) {
+	return ptc.accounts.RootHash()
+}
+
+// Commit commits the imported accounts
+func (ptc *pendingTransactionsCounter) Commit() ([]byte, error) {
+	return ptc.accounts.Commit()
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ptc *pendingTransactionsCounter) IsInterfaceNil() bool {
+	return ptc == nil
+}
@@ -0,0 +1,36 @@
+package dryrun
+
+// ShardSimulationResult holds the outcome of a simulated hardfork for one shard. The pending transactions are not
+// executed, so the genesis block of a shard with pending transactions is partial: its root hash is the imported one
+// and its miniBlocks are empty
+type ShardSimulationResult struct {
+	ShardID                uint32 `json:"shardID"`
+	ExpectedRootHash       string `json:"expectedRootHash"`
+	ImportedRootHash       string `json:"importedRootHash"`
+	GenesisBlockHash       string `json:"genesisBlockHash"`
+	GenesisBlockRootHash   string `json:"genesisBlockRootHash"`
+	NumPendingMiniBlocks   int    `json:"numPendingMiniBlocks"`
+	NumPendingTransactions int    `json:"numPendingTransactions"`
+	Partial                bool   `json:"partial"`
+	RootHashMismatch       bool   `json:"rootHashMismatch"`
+}
+
+// SimulationResult holds the outcome of a simulated hardfork for all the shards
+type SimulationResult struct {
+	Epoch                   uint32                   `json:"epoch"`
+	EpochStartMetaBlockHash string                   `json:"epochStartMetaBlockHash"`
+	Partial                 bool                     `json:"partial"`
+	Shards                  []*ShardSimulationResult `json:"shards"`
+}
+
+// HasMismatches returns true if, for any shard, the imported state does not have the root hash committed in the
+// epoch start metaBlock
+func (sr *SimulationResult) HasMismatches() bool {
+	for _, shardResult := range sr.Shards {
+		if shardResult.RootHashMismatch {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,466 @@
+package dryrun
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	triesFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/dryrun/disabled"
+	"github.com/ElrondNetwork/elrond-go/update/genesis"
+	hardForkProcess "github.com/ElrondNetwork/elrond-go/update/process"
+	"github.com/ElrondNetwork/elrond-go/update/storing"
+)
+
+// ArgsHardforkSimulator defines the arguments needed to create a new hardfork simulator
+type ArgsHardforkSimulator struct {
+	StateSyncer              update.StateSyncer
+	NumOfShards              uint32
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	AddressPubKeyConverter   core.PubkeyConverter
+	ValidatorPubKeyConverter core.PubkeyConverter
+	GenesisNodesSetupHandler update.GenesisNodesSetupHandler
+	TrieStorageManagers      map[string]data.StorageManager
+	HardforkConfig           config.HardforkConfig
+	ExportFolder             string
+	ChainID                  string
+}
+
+type hardforkSimulator struct {
+	stateSyncer              update.StateSyncer
+	numOfShards              uint32
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	addressPubKeyConverter   core.PubkeyConverter
+	validatorPubKeyConverter core.PubkeyConverter
+	genesisNodesSetupHandler update.GenesisNodesSetupHandler
+	trieStorageManagers      map[string]data.StorageManager
+	hardforkConfig           config.HardforkConfig
+	exportFolder             string
+	chainID                  string
+}
+
+// NewHardforkSimulator creates a simulator which runs, without any network, the hardfork export at a chosen epoch
+// followed by the import and by the creation of the new genesis blocks of all the shards
+func NewHardforkSimulator(args ArgsHardforkSimulator) (*hardforkSimulator, error) {
+	if check.IfNil(args.StateSyncer) {
+		return nil, update.ErrNilStateSyncer
+	}
+	if args.NumOfShards < 1 {
+		return nil, sharding.ErrInvalidNumberOfShards
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, update.ErrNilHasher
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, fmt.Errorf("%w for address", update.ErrNilPubKeyConverter)
+	}
+	if check.IfNil(args.ValidatorPubKeyConverter) {
+		return nil, fmt.Errorf("%w for validators", update.ErrNilPubKeyConverter)
+	}
+	if check.IfNil(args.GenesisNodesSetupHandler) {
+		return nil, update.ErrNilGenesisNodesSetupHandler
+	}
+	if check.IfNil(args.TrieStorageManagers[triesFactory.UserAccountTrie]) {
+		return nil, update.ErrNilTrieStorageManagers
+	}
+	if len(args.ExportFolder) == 0 {
+		return nil, update.ErrEmptyExportFolderPath
+	}
+	if len(args.ChainID) == 0 {
+		return nil, update.ErrEmptyChainID
+	}
+
+	return &hardforkSimulator{
+		stateSyncer:              args.StateSyncer,
+		numOfShards:              args.NumOfShards,
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		addressPubKeyConverter:   args.AddressPubKeyConverter,
+		validatorPubKeyConverter: args.ValidatorPubKeyConverter,
+		genesisNodesSetupHandler: args.GenesisNodesSetupHandler,
+		trieStorageManagers:      args.TrieStorageManagers,
+		hardforkConfig:           args.HardforkConfig,
+		exportFolder:             args.ExportFolder,
+		chainID:                  args.ChainID,
+	}, nil
+}
+
+// Simulate exports the state of the given epoch in the export folder, imports it back and creates the genesis blocks
+// of all the shards, as the nodes do after a hardfork. The imported root hashes are compared against the ones
+// committed in the epoch start metaBlock. The pending transactions are only counted, so the result is marked as
+// partial whenever there are any
+func (hs *hardforkSimulator) Simulate(epoch uint32) (*SimulationResult, error) {
+	err := hs.exportState(epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while exporting the state", err)
+	}
+
+	importHandler, err := hs.importState()
+	if err != nil {
+		return nil, fmt.Errorf("%w while importing the state", err)
+	}
+
+	shardIDs := make([]uint32, 0, hs.numOfShards+1)
+	for shardID := uint32(0); shardID < hs.numOfShards; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	genesisBlocks, counters, err := hs.createGenesisBlocks(importHandler, shardIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the genesis blocks", err)
+	}
+
+	return hs.createResult(epoch, shardIDs, importHandler, genesisBlocks, counters)
+}
+
+func (hs *hardforkSimulator) exportState(epoch uint32) error {
+	err := os.MkdirAll(hs.exportFolder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(hs.numOfShards, core.MetachainShardId)
+	if err != nil {
+		return err
+	}
+
+	hardforkStorer, err := createHardforkStorer(
+		hs.hardforkConfig.ExportKeysStorageConfig,
+		hs.hardforkConfig.ExportStateStorageConfig,
+		hs.exportFolder,
+		hs.marshalizer,
+	)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := genesis.NewStateExporter(genesis.ArgsNewStateExporter{
+		ShardCoordinator:         shardCoordinator,
+		StateSyncer:              hs.stateSyncer,
+		Marshalizer:              hs.marshalizer,
+		Hasher:                   hs.hasher,
+		HardforkStorer:           hardforkStorer,
+		ExportFolder:             hs.exportFolder,
+		AddressPubKeyConverter:   hs.addressPubKeyConverter,
+		ValidatorPubKeyConverter: hs.validatorPubKeyConverter,
+		GenesisNodesSetupHandler: hs.genesisNodesSetupHandler,
+	})
+	if err != nil {
+		_ = hardforkStorer.Close()
+		return err
+	}
+
+	log.Info("simulating the hardfork export", "epoch", epoch, "folder", hs.exportFolder)
+
+	return exporter.ExportAll(epoch)
+}
+
+// importState reopens the exported data with the import storage configuration, as a node started after the
+// hardfork does, so that a mismatch between the export and the import configurations is also detected
+func (hs *hardforkSimulator) importState() (update.ImportHandler, error) {
+	hardforkStorer, err := createHardforkStorer(
+		hs.hardforkConfig.ImportKeysStorageConfig,
+		hs.hardforkConfig.ImportStateStorageConfig,
+		hs.exportFolder,
+		hs.marshalizer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	importHandler, err := genesis.NewStateImport(genesis.ArgsNewStateImport{
+		Hasher:              hs.hasher,
+		Marshalizer:         hs.marshalizer,
+		ShardID:             core.MetachainShardId,
+		StorageConfig:       hs.hardforkConfig.ImportStateStorageConfig,
+		TrieStorageManagers: hs.trieStorageManagers,
+		HardforkStorer:      hardforkStorer,
+	})
+	if err != nil {
+		_ = hardforkStorer.Close()
+		return nil, err
+	}
+
+	log.Info("simulating the hardfork import", "folder", hs.exportFolder)
+
+	err = importHandler.ImportAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return importHandler, nil
+}
+
+func (hs *hardforkSimulator) createGenesisBlocks(
+	importHandler update.ImportHandler,
+	shardIDs []uint32,
+) (map[uint32]data.HeaderHandler, map[uint32]*pendingTransactionsCounter, error) {
+	mapHardForkBlockProcessor := make(map[uint32]update.HardForkBlockProcessor, len(shardIDs))
+	counters := make(map[uint32]*pendingTransactionsCounter, len(shardIDs))
+	for _, shardID := range shardIDs {
+		hardForkBlockProcessor, counter, err := hs.createHardForkBlockProcessor(importHandler, shardID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		mapHardForkBlockProcessor[shardID] = hardForkBlockProcessor
+		counters[shardID] = counter
+	}
+
+	args := update.ArgsHardForkProcessor{
+		Hasher:                    hs.hasher,
+		Marshalizer:               hs.marshalizer,
+		ShardIDs:                  shardIDs,
+		MapBodies:                 make(map[uint32]*block.Body, len(shardIDs)),
+		MapHardForkBlockProcessor: mapHardForkBlockProcessor,
+	}
+
+	lastPostMbs, err := update.CreateBody(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args.PostMbs = lastPostMbs
+	err = update.CreatePostMiniBlocks(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	genesisBlocks := make(map[uint32]data.HeaderHandler, len(shardIDs))
+	for _, shardID := range shardIDs {
+		genesisBlock, errCreate := mapHardForkBlockProcessor[shardID].CreateBlock(
+			args.MapBodies[shardID],
+			hs.chainID,
+			hs.hardforkConfig.StartRound,
+			hs.hardforkConfig.StartNonce,
+			hs.hardforkConfig.StartEpoch,
+		)
+		if errCreate != nil {
+			return nil, nil, fmt.Errorf("%w for shard %d", errCreate, shardID)
+		}
+
+		genesisBlocks[shardID] = genesisBlock
+	}
+
+	return genesisBlocks, counters, nil
+}
+
+// createHardForkBlockProcessor creates the block creator used by the nodes after a hardfork. The self shard ID is
+// set to a value which matches no shard so that the simulated blocks are not saved in any storage
+func (hs *hardforkSimulator) createHardForkBlockProcessor(
+	importHandler update.ImportHandler,
+	shardID uint32,
+) (update.HardForkBlockProcessor, *pendingTransactionsCounter, error) {
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(hs.numOfShards, shardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accounts := importHandler.GetAccountsDBForShard(shardID)
+	if check.IfNil(accounts) {
+		return nil, nil, fmt.Errorf("%w: no accounts imported for shard %d", update.ErrMissingShardState, shardID)
+	}
+
+	counter, err := newPendingTransactionsCounter(accounts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if shardID != core.MetachainShardId {
+		shardBlockCreator, errCreate := hardForkProcess.NewShardBlockCreatorAfterHardFork(hardForkProcess.ArgsNewShardBlockCreatorAfterHardFork{
+			Hasher:             hs.hasher,
+			ImportHandler:      importHandler,
+			Marshalizer:        hs.marshalizer,
+			PendingTxProcessor: counter,
+			ShardCoordinator:   shardCoordinator,
+			Storage:            dataRetriever.NewChainStorer(),
+			TxCoordinator:      &disabled.TxCoordinator{},
+			SelfShardID:        core.AllShardId,
+		})
+
+		return shardBlockCreator, counter, errCreate
+	}
+
+	validatorAccounts, err := hs.createValidatorAccounts()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metaBlockCreator, err := hardForkProcess.NewMetaBlockCreatorAfterHardfork(hardForkProcess.ArgsNewMetaBlockCreatorAfterHardFork{
+		Hasher:             hs.hasher,
+		ImportHandler:      importHandler,
+		Marshalizer:        hs.marshalizer,
+		PendingTxProcessor: counter,
+		ShardCoordinator:   shardCoordinator,
+		Storage:            dataRetriever.NewChainStorer(),
+		TxCoordinator:      &disabled.TxCoordinator{},
+		ValidatorAccounts:  validatorAccounts,
+		SelfShardID:        core.AllShardId,
+	})
+
+	return metaBlockCreator, counter, err
+}
+
+// createValidatorAccounts uses the exported peer accounts trie as the validators state is not imported
+func (hs *hardforkSimulator) createValidatorAccounts() (state.AccountsAdapter, error) {
+	tries, err := hs.stateSyncer.GetAllTries()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsTrie, ok := tries[genesis.CreateTrieIdentifier(core.MetachainShardId, genesis.ValidatorAccount)]
+	if !ok || check.IfNil(validatorsTrie) {
+		return nil, fmt.Errorf("%w: peer accounts trie", update.ErrMissingShardState)
+	}
+
+	return state.NewAccountsDB(validatorsTrie, hs.hasher, hs.marshalizer, factory.NewPeerAccountCreator())
+}
+
+func (hs *hardforkSimulator) createResult(
+	epoch uint32,
+	shardIDs []uint32,
+	importHandler update.ImportHandler,
+	genesisBlocks map[uint32]data.HeaderHandler,
+	counters map[uint32]*pendingTransactionsCounter,
+) (*SimulationResult, error) {
+	epochStartMetaBlock, err := hs.stateSyncer.GetEpochStartMetaBlock()
+	if err != nil {
+		return nil, err
+	}
+	epochStartMetaBlockHash, err := core.CalculateHash(hs.marshalizer, hs.hasher, epochStartMetaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedRootHashes := map[uint32][]byte{
+		core.MetachainShardId: epochStartMetaBlock.RootHash,
+	}
+	for _, shardData := range epochStartMetaBlock.EpochStart.LastFinalizedHeaders {
+		expectedRootHashes[shardData.ShardID] = shardData.RootHash
+	}
+
+	result := &SimulationResult{
+		Epoch:                   epoch,
+		EpochStartMetaBlockHash: hex.EncodeToString(epochStartMetaBlockHash),
+		Shards:                  make([]*ShardSimulationResult, 0, len(shardIDs)),
+	}
+	for _, shardID := range shardIDs {
+		importedRootHash, errRootHash := importHandler.GetAccountsDBForShard(shardID).RootHash()
+		if errRootHash != nil {
+			return nil, errRootHash
+		}
+
+		genesisBlock := genesisBlocks[shardID]
+		genesisBlockHash, errHash := core.CalculateHash(hs.marshalizer, hs.hasher, genesisBlock)
+		if errHash != nil {
+			return nil, errHash
+		}
+
+		expectedRootHash := expectedRootHashes[shardID]
+		shardResult := &ShardSimulationResult{
+			ShardID:                shardID,
+			ExpectedRootHash:       hex.EncodeToString(expectedRootHash),
+			ImportedRootHash:       hex.EncodeToString(importedRootHash),
+			GenesisBlockHash:       hex.EncodeToString(genesisBlockHash),
+			GenesisBlockRootHash:   hex.EncodeToString(genesisBlock.GetRootHash()),
+			NumPendingMiniBlocks:   counters[shardID].numMiniBlocks,
+			NumPendingTransactions: counters[shardID].numTransactions,
+			Partial:                counters[shardID].numTransactions > 0,
+			RootHashMismatch:       !bytes.Equal(expectedRootHash, importedRootHash),
+		}
+		if shardResult.RootHashMismatch {
+			log.Warn("hardfork simulation root hash mismatch",
+				"shard", shardID,
+				"expected", expectedRootHash,
+				"imported", importedRootHash,
+			)
+		}
+		if shardResult.Partial {
+			log.Warn("hardfork simulation did not execute the pending transactions",
+				"shard", shardID,
+				"num miniblocks", shardResult.NumPendingMiniBlocks,
+				"num transactions", shardResult.NumPendingTransactions,
+			)
+		}
+
+		result.Partial = result.Partial || shardResult.Partial
+
+		result.Shards = append(result.Shards, shardResult)
+	}
+
+	return result, nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (hs *hardforkSimulator) IsInterfaceNil() bool {
+	return hs == nil
+}
+
+func createHardforkStorer(
+	keysStorageConfig config.StorageConfig,
+	stateStorageConfig config.StorageConfig,
+	folder string,
+	marshalizer marshal.Marshalizer,
+) (update.HardforkStorer, error) {
+	keysStorer, err := createStorer(keysStorageConfig, folder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys storer", err)
+	}
+	keysVals, err := createStorer(stateStorageConfig, folder)
+	if err != nil {
+		_ = keysStorer.Close()
+		return nil, fmt.Errorf("%w while creating keys-values storer", err)
+	}
+
+	hardforkStorer, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: marshalizer,
+	})
+	if err != nil {
+		_ = keysStorer.Close()
+		_ = keysVals.Close()
+		return nil, err
+	}
+
+	return hardforkStorer, nil
+}
+
+func createStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
+
+	store, err := storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(storageConfig.Bloom),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
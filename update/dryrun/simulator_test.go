@@ -0,0 +1,203 @@
+package dryrun
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	triesFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStorageConfig(filePath string) config.StorageConfig {
+	return config.StorageConfig{
+		Cache: config.CacheConfig{
+			Capacity: 10000,
+			Type:     "LRU",
+			Shards:   1,
+		},
+		DB: config.DBConfig{
+			FilePath:          filePath,
+			Type:              "LvlDBSerial",
+			BatchDelaySeconds: 30,
+			MaxBatchSize:      6,
+			MaxOpenFiles:      10,
+		},
+	}
+}
+
+func createTrieStorageManagers() map[string]data.StorageManager {
+	userAccountsStorageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	peerAccountsStorageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+
+	return map[string]data.StorageManager{
+		triesFactory.UserAccountTrie: userAccountsStorageManager,
+		triesFactory.PeerAccountTrie: peerAccountsStorageManager,
+	}
+}
+
+func createMockArgsHardforkSimulator() ArgsHardforkSimulator {
+	return ArgsHardforkSimulator{
+		StateSyncer:              &mock.SyncStateStub{},
+		NumOfShards:              1,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		AddressPubKeyConverter:   &mock.PubkeyConverterStub{},
+		ValidatorPubKeyConverter: &mock.PubkeyConverterStub{},
+		GenesisNodesSetupHandler: &mock.GenesisNodesSetupHandlerStub{},
+		TrieStorageManagers:      createTrieStorageManagers(),
+		HardforkConfig: config.HardforkConfig{
+			ExportStateStorageConfig: createStorageConfig("ExportState"),
+			ExportKeysStorageConfig:  createStorageConfig("ExportKeys"),
+			ImportStateStorageConfig: createStorageConfig("ExportState"),
+			ImportKeysStorageConfig:  createStorageConfig("ExportKeys"),
+			StartRound:               100,
+			StartNonce:               100,
+			StartEpoch:               3,
+		},
+		ExportFolder: "export",
+		ChainID:      "chainID",
+	}
+}
+
+func TestNewHardforkSimulator(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHardforkSimulator()
+	args.StateSyncer = nil
+	simulator, err := NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, update.ErrNilStateSyncer, err)
+
+	args = createMockArgsHardforkSimulator()
+	args.NumOfShards = 0
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, sharding.ErrInvalidNumberOfShards, err)
+
+	args = createMockArgsHardforkSimulator()
+	args.Marshalizer = nil
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, update.ErrNilMarshalizer, err)
+
+	args = createMockArgsHardforkSimulator()
+	args.Hasher = nil
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, update.ErrNilHasher, err)
+
+	args = createMockArgsHardforkSimulator()
+	args.AddressPubKeyConverter = nil
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.True(t, errors.Is(err, update.ErrNilPubKeyConverter))
+
+	args = createMockArgsHardforkSimulator()
+	args.ValidatorPubKeyConverter = nil
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.True(t, errors.Is(err, update.ErrNilPubKeyConverter))
+
+	args = createMockArgsHardforkSimulator()
+	args.GenesisNodesSetupHandler = nil
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, update.ErrNilGenesisNodesSetupHandler, err)
+
+	args = createMockArgsHardforkSimulator()
+	args.TrieStorageManagers = nil
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, update.ErrNilTrieStorageManagers, err)
+
+	args = createMockArgsHardforkSimulator()
+	args.ExportFolder = ""
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, update.ErrEmptyExportFolderPath, err)
+
+	args = createMockArgsHardforkSimulator()
+	args.ChainID = ""
+	simulator, err = NewHardforkSimulator(args)
+	assert.True(t, check.IfNil(simulator))
+	assert.Equal(t, update.ErrEmptyChainID, err)
+
+	simulator, err = NewHardforkSimulator(createMockArgsHardforkSimulator())
+	assert.False(t, check.IfNil(simulator))
+	assert.Nil(t, err)
+}
+
+func TestHardforkSimulator_SimulateSyncErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	exportFolder, err := ioutil.TempDir("", "hardforkSimulator")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(exportFolder)
+	}()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsHardforkSimulator()
+	args.ExportFolder = exportFolder
+	args.StateSyncer = &mock.SyncStateStub{
+		SyncAllStateCalled: func(epoch uint32) error {
+			return expectedErr
+		},
+	}
+	simulator, _ := NewHardforkSimulator(args)
+
+	result, err := simulator.Simulate(2)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestHardforkSimulator_SimulateShouldMatchTheEpochStartRootHashes(t *testing.T) {
+	t.Parallel()
+
+	exportFolder, err := ioutil.TempDir("", "hardforkSimulator")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(exportFolder)
+	}()
+
+	chain := createLocalChain(t, 2)
+	syncer, _ := NewLocalStateSyncer(createArgsLocalStateSyncer(chain))
+
+	args := createMockArgsHardforkSimulator()
+	args.StateSyncer = syncer
+	args.ExportFolder = exportFolder
+	simulator, _ := NewHardforkSimulator(args)
+
+	result, err := simulator.Simulate(2)
+	require.Nil(t, err)
+	assert.False(t, result.HasMismatches())
+	assert.True(t, result.Partial)
+	assert.Equal(t, uint32(2), result.Epoch)
+	require.Equal(t, 2, len(result.Shards))
+
+	shardResult := result.Shards[0]
+	assert.Equal(t, uint32(0), shardResult.ShardID)
+	assert.Equal(t, shardResult.ExpectedRootHash, shardResult.ImportedRootHash)
+	assert.Equal(t, shardResult.ExpectedRootHash, shardResult.GenesisBlockRootHash)
+	assert.Equal(t, 1, shardResult.NumPendingMiniBlocks)
+	assert.Equal(t, 1, shardResult.NumPendingTransactions)
+	assert.True(t, shardResult.Partial)
+
+	metaResult := result.Shards[1]
+	assert.Equal(t, core.MetachainShardId, metaResult.ShardID)
+	assert.Equal(t, metaResult.ExpectedRootHash, metaResult.GenesisBlockRootHash)
+	assert.Equal(t, 0, metaResult.NumPendingTransactions)
+	assert.False(t, metaResult.Partial)
+}
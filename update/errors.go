@@ -271,3 +271,15 @@ var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for mi
 
 // ErrInvalidNumConcurrentTrieSyncers signals that the number of concurrent trie syncers is invalid
 var ErrInvalidNumConcurrentTrieSyncers = errors.New("invalid num concurrent trie syncers")
+
+// ErrNilExportHandler signals that a nil export handler has been provided
+var ErrNilExportHandler = errors.New("nil export handler")
+
+// ErrNilStorageReader signals that a nil storage reader has been provided
+var ErrNilStorageReader = errors.New("nil storage reader")
+
+// ErrNoAccountsTries signals that no accounts trie has been provided
+var ErrNoAccountsTries = errors.New("no accounts tries")
+
+// ErrMissingShardState signals that the state of a shard was not found in the local databases
+var ErrMissingShardState = errors.New("missing shard state")
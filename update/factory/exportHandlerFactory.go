@@ -55,6 +55,7 @@ type ArgsExporter struct {
 	ExportTriesStorageConfig  config.StorageConfig
 	ExportStateStorageConfig  config.StorageConfig
 	ExportStateKeysConfig     config.StorageConfig
+	ExportJSONLines           bool
 	MaxTrieLevelInMemory      uint
 	WhiteListHandler          process.WhiteListHandler
 	WhiteListerVerifiedTxs    process.WhiteListHandler
@@ -100,6 +101,7 @@ type exportHandlerFactory struct {
 	exportTriesStorageConfig  config.StorageConfig
 	exportStateStorageConfig  config.StorageConfig
 	exportStateKeysConfig     config.StorageConfig
+	exportJSONLines           bool
 	maxTrieLevelInMemory      uint
 	whiteListHandler          process.WhiteListHandler
 	whiteListerVerifiedTxs    process.WhiteListHandler
@@ -254,6 +256,7 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		exportTriesStorageConfig:  args.ExportTriesStorageConfig,
 		exportStateStorageConfig:  args.ExportStateStorageConfig,
 		exportStateKeysConfig:     args.ExportStateKeysConfig,
+		exportJSONLines:           args.ExportJSONLines,
 		interceptorsContainer:     args.InterceptorsContainer,
 		whiteListHandler:          args.WhiteListHandler,
 		whiteListerVerifiedTxs:    args.WhiteListerVerifiedTxs,
@@ -482,7 +485,12 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		AddressPubKeyConverter:   e.addressPubKeyConverter,
 		GenesisNodesSetupHandler: e.genesisNodesSetupHandler,
 	}
-	exportHandler, err := genesis.NewStateExporter(argsExporter)
+	stateExporter, err := genesis.NewStateExporter(argsExporter)
+	if err != nil {
+		return nil, err
+	}
+
+	exportHandler, err := e.createExportHandler(stateExporter, stateSyncer)
 	if err != nil {
 		return nil, err
 	}
@@ -505,6 +513,31 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 	return exportHandler, nil
 }
 
+// createExportHandler returns the state exporter or, if the JSON Lines export is enabled, a chain of exporters which
+// also writes the human-readable export of the same state. A failed JSON Lines export does not stop the hardfork
+func (e *exportHandlerFactory) createExportHandler(
+	stateExporter update.ExportHandler,
+	stateSyncer update.StateSyncer,
+) (update.ExportHandler, error) {
+	if !e.exportJSONLines {
+		return stateExporter, nil
+	}
+
+	argsJSONLinesExporter := genesis.ArgsNewJSONLinesStateExporter{
+		ShardCoordinator:       e.shardCoordinator,
+		StateSyncer:            stateSyncer,
+		Marshalizer:            e.marshalizer,
+		ExportFolder:           path.Join(e.exportFolder, update.JSONLinesExportFolder),
+		AddressPubKeyConverter: e.addressPubKeyConverter,
+	}
+	jsonLinesExporter, err := genesis.NewJSONLinesStateExporter(argsJSONLinesExporter)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis.NewExportHandlersChain(stateExporter, jsonLinesExporter)
+}
+
 func (e *exportHandlerFactory) prepareFolders(folder string) error {
 	err := os.RemoveAll(folder)
 	if err != nil {
@@ -0,0 +1,58 @@
+package genesis
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/update"
+)
+
+var _ update.ExportHandler = (*exportHandlersChain)(nil)
+
+type exportHandlersChain struct {
+	mainExportHandler      update.ExportHandler
+	optionalExportHandlers []update.ExportHandler
+}
+
+// NewExportHandlersChain creates an export handler which calls the main export handler followed, in order, by the
+// optional ones. Only the errors of the main export handler stop the export, as the optional export handlers write
+// auxiliary data which is not needed by the hardfork
+func NewExportHandlersChain(
+	mainExportHandler update.ExportHandler,
+	optionalExportHandlers ...update.ExportHandler,
+) (*exportHandlersChain, error) {
+	if check.IfNil(mainExportHandler) {
+		return nil, update.ErrNilExportHandler
+	}
+	for _, exportHandler := range optionalExportHandlers {
+		if check.IfNil(exportHandler) {
+			return nil, update.ErrNilExportHandler
+		}
+	}
+
+	return &exportHandlersChain{
+		mainExportHandler:      mainExportHandler,
+		optionalExportHandlers: optionalExportHandlers,
+	}, nil
+}
+
+// ExportAll calls the ExportAll method of the main export handler and, if it succeeds, of every optional export
+// handler. The errors of the optional export handlers are only logged
+func (ehc *exportHandlersChain) ExportAll(epoch uint32) error {
+	err := ehc.mainExportHandler.ExportAll(epoch)
+	if err != nil {
+		return err
+	}
+
+	for index, exportHandler := range ehc.optionalExportHandlers {
+		err = exportHandler.ExportAll(epoch)
+		if err != nil {
+			log.Warn("optional export failed", "index", index, "epoch", epoch, "error", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ehc *exportHandlersChain) IsInterfaceNil() bool {
+	return ehc == nil
+}
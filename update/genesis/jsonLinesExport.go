@@ -0,0 +1,326 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/jsonlines"
+)
+
+var _ update.ExportHandler = (*jsonLinesStateExport)(nil)
+
+// ArgsNewJSONLinesStateExporter defines the arguments needed to create a new JSON Lines state exporter
+type ArgsNewJSONLinesStateExporter struct {
+	ShardCoordinator       sharding.Coordinator
+	StateSyncer            update.StateSyncer
+	Marshalizer            marshal.Marshalizer
+	ExportFolder           string
+	AddressPubKeyConverter core.PubkeyConverter
+}
+
+type jsonLinesStateExport struct {
+	shardCoordinator       sharding.Coordinator
+	stateSyncer            update.StateSyncer
+	marshalizer            marshal.Marshalizer
+	exportFolder           string
+	addressPubKeyConverter core.PubkeyConverter
+	esdtKeyPrefix          []byte
+}
+
+// NewJSONLinesStateExporter creates an exporter which writes the accounts, the ESDT balances, the storage of the system
+// smart contracts and the pending miniblocks as human-readable JSON Lines files, one file for each type and shard. The
+// export is meant for auditing the state before a hardfork and can not be imported. It does not sync the state itself,
+// so it has to run after the state exporter which syncs it, sharing the same state syncer
+func NewJSONLinesStateExporter(args ArgsNewJSONLinesStateExporter) (*jsonLinesStateExport, error) {
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, data.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.StateSyncer) {
+		return nil, update.ErrNilStateSyncer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, data.ErrNilMarshalizer
+	}
+	if len(args.ExportFolder) == 0 {
+		return nil, update.ErrEmptyExportFolderPath
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, fmt.Errorf("%w for address", update.ErrNilPubKeyConverter)
+	}
+
+	return &jsonLinesStateExport{
+		shardCoordinator:       args.ShardCoordinator,
+		stateSyncer:            args.StateSyncer,
+		marshalizer:            args.Marshalizer,
+		exportFolder:           args.ExportFolder,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		esdtKeyPrefix:          []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+	}, nil
+}
+
+// ExportAll writes as JSON Lines files the data from every shard, already synced for the given epoch start block
+func (je *jsonLinesStateExport) ExportAll(epoch uint32) error {
+	writer, err := jsonlines.NewFilesWriter(je.exportFolder)
+	if err != nil {
+		return err
+	}
+
+	err = je.exportAllAccounts(writer)
+	if err != nil {
+		_ = writer.Close()
+		return err
+	}
+
+	err = je.exportPendingMiniBlocks(writer)
+	if err != nil {
+		_ = writer.Close()
+		return err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	log.Info("state exported as JSON Lines", "folder", je.exportFolder, "epoch", epoch)
+
+	return nil
+}
+
+func (je *jsonLinesStateExport) exportAllAccounts(writer update.JSONLinesWriter) error {
+	tries, err := je.stateSyncer.GetAllTries()
+	if err != nil {
+		return err
+	}
+
+	accountsTriesKeys := make([]string, 0)
+	for key := range tries {
+		accType, _, errGet := GetTrieTypeAndShId(TrieIdentifier + atSep + key)
+		if errGet != nil {
+			return errGet
+		}
+		if accType == UserAccount {
+			accountsTriesKeys = append(accountsTriesKeys, key)
+		}
+	}
+	sort.Strings(accountsTriesKeys)
+
+	log.Debug("starting JSON Lines export for accounts tries", "len", len(accountsTriesKeys))
+	for _, key := range accountsTriesKeys {
+		_, shardID, _ := GetTrieTypeAndShId(TrieIdentifier + atSep + key)
+		if shardID >= je.shardCoordinator.NumberOfShards() && shardID != core.MetachainShardId {
+			return sharding.ErrInvalidShardId
+		}
+
+		err = je.exportAccountsTrie(writer, shardID, tries[key], tries)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (je *jsonLinesStateExport) exportAccountsTrie(
+	writer update.JSONLinesWriter,
+	shardID uint32,
+	accountsTrie data.Trie,
+	tries map[string]data.Trie,
+) error {
+	rootHash, err := accountsTrie.RootHash()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := accountsTrie.GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return err
+	}
+
+	for leaf := range leavesChannel {
+		account := state.NewEmptyUserAccount()
+		errUnmarshal := je.marshalizer.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			continue
+		}
+
+		err = writer.Write(jsonlines.AccountsRecords, shardID, je.createAccountRecord(leaf.Key(), account))
+		if err != nil {
+			return err
+		}
+
+		if len(account.RootHash) == 0 {
+			continue
+		}
+
+		dataTrieKey := AddRootHashToIdentifier(CreateTrieIdentifier(shardID, DataTrie), string(account.RootHash))
+		dataTrie, found := tries[dataTrieKey]
+		if !found {
+			return fmt.Errorf("%w: data trie of account %s", update.ErrNotSynced, je.addressPubKeyConverter.Encode(leaf.Key()))
+		}
+
+		err = je.exportDataTrie(writer, shardID, leaf.Key(), dataTrie)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (je *jsonLinesStateExport) createAccountRecord(address []byte, account state.UserAccountHandler) *jsonlines.AccountRecord {
+	record := &jsonlines.AccountRecord{
+		Address:         je.addressPubKeyConverter.Encode(address),
+		Nonce:           account.GetNonce(),
+		Balance:         account.GetBalance().String(),
+		DeveloperReward: account.GetDeveloperReward().String(),
+		Username:        string(account.GetUserName()),
+		CodeHash:        hex.EncodeToString(account.GetCodeHash()),
+		CodeMetadata:    hex.EncodeToString(account.GetCodeMetadata()),
+		RootHash:        hex.EncodeToString(account.GetRootHash()),
+	}
+	if len(account.GetOwnerAddress()) > 0 {
+		record.OwnerAddress = je.addressPubKeyConverter.Encode(account.GetOwnerAddress())
+	}
+
+	return record
+}
+
+// exportDataTrie writes the ESDT balances held in the data trie of the account and, for the system smart contracts,
+// the whole storage
+func (je *jsonLinesStateExport) exportDataTrie(writer update.JSONLinesWriter, shardID uint32, address []byte, dataTrie data.Trie) error {
+	rootHash, err := dataTrie.RootHash()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannel, err := dataTrie.GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return err
+	}
+
+	encodedAddress := je.addressPubKeyConverter.Encode(address)
+	isSystemSC := shardID == core.MetachainShardId && core.IsSmartContractAddress(address)
+	for leaf := range leavesChannel {
+		value, errTrim := trimDataTrieValue(leaf.Value(), leaf.Key(), address)
+		if errTrim != nil {
+			return fmt.Errorf("%w for account %s", errTrim, encodedAddress)
+		}
+
+		if bytes.HasPrefix(leaf.Key(), je.esdtKeyPrefix) {
+			err = je.exportESDTBalance(writer, shardID, encodedAddress, leaf.Key()[len(je.esdtKeyPrefix):], value)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !isSystemSC {
+			continue
+		}
+
+		err = writer.Write(jsonlines.SystemSCStorageRecords, shardID, &jsonlines.SystemSCStorageRecord{
+			Address:    encodedAddress,
+			StorageKey: hex.EncodeToString(leaf.Key()),
+			Value:      hex.EncodeToString(value),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (je *jsonLinesStateExport) exportESDTBalance(
+	writer update.JSONLinesWriter,
+	shardID uint32,
+	encodedAddress string,
+	tokenIdentifier []byte,
+	value []byte,
+) error {
+	esdtToken := &esdt.ESDigitalToken{}
+	err := je.marshalizer.Unmarshal(esdtToken, value)
+	if err != nil {
+		return fmt.Errorf("%w for token %s of account %s", err, tokenIdentifier, encodedAddress)
+	}
+
+	balance := "0"
+	if esdtToken.Value != nil {
+		balance = esdtToken.Value.String()
+	}
+
+	return writer.Write(jsonlines.ESDTBalancesRecords, shardID, &jsonlines.ESDTBalanceRecord{
+		Address:    encodedAddress,
+		Token:      string(tokenIdentifier),
+		Balance:    balance,
+		Properties: hex.EncodeToString(esdtToken.Properties),
+	})
+}
+
+func (je *jsonLinesStateExport) exportPendingMiniBlocks(writer update.JSONLinesWriter) error {
+	miniBlocks, err := je.stateSyncer.GetAllMiniBlocks()
+	if err != nil {
+		return err
+	}
+
+	hashes := make([]string, 0, len(miniBlocks))
+	for hash := range miniBlocks {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	log.Debug("starting JSON Lines export for pending miniBlocks", "len", len(hashes))
+	for _, hash := range hashes {
+		miniBlock := miniBlocks[hash]
+		record := &jsonlines.PendingMiniBlockRecord{
+			Hash:            hex.EncodeToString([]byte(hash)),
+			Type:            miniBlock.Type.String(),
+			SenderShardID:   miniBlock.SenderShardID,
+			ReceiverShardID: miniBlock.ReceiverShardID,
+			TxHashes:        make([]string, 0, len(miniBlock.TxHashes)),
+		}
+		for _, txHash := range miniBlock.TxHashes {
+			record.TxHashes = append(record.TxHashes, hex.EncodeToString(txHash))
+		}
+
+		err = writer.Write(jsonlines.PendingMiniBlocksRecords, miniBlock.ReceiverShardID, record)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trimDataTrieValue removes the key and the address appended to the values saved in the accounts data tries
+func trimDataTrieValue(value []byte, key []byte, address []byte) ([]byte, error) {
+	tailLength := len(key) + len(address)
+	if len(value) < tailLength {
+		return nil, fmt.Errorf("%w for data trie key %x", state.ErrNegativeValue, key)
+	}
+
+	return value[:len(value)-tailLength], nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (je *jsonLinesStateExport) IsInterfaceNil() bool {
+	return je == nil
+}
@@ -0,0 +1,286 @@
+package genesis
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/jsonlines"
+	"github.com/ElrondNetwork/elrond-go/update/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsNewJSONLinesStateExporter(exportFolder string) ArgsNewJSONLinesStateExporter {
+	return ArgsNewJSONLinesStateExporter{
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		StateSyncer:      &mock.SyncStateStub{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		ExportFolder:     exportFolder,
+		AddressPubKeyConverter: &mock.PubkeyConverterStub{
+			EncodeCalled: func(pkBytes []byte) string {
+				return string(pkBytes)
+			},
+		},
+	}
+}
+
+func createLeavesTrieStub(leaves ...core.KeyValueHolder) *mock.TrieStub {
+	return &mock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetAllLeavesOnChannelCalled: func(_ []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder, len(leaves))
+			for _, leaf := range leaves {
+				ch <- leaf
+			}
+			close(ch)
+
+			return ch, nil
+		},
+	}
+}
+
+func readJSONLinesFile(t *testing.T, path string) []map[string]interface{} {
+	file, err := os.Open(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestNewJSONLinesStateExporter(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewJSONLinesStateExporter("test")
+	args.ShardCoordinator = nil
+	exporter, err := NewJSONLinesStateExporter(args)
+	assert.True(t, check.IfNil(exporter))
+	assert.Equal(t, data.ErrNilShardCoordinator, err)
+
+	args = createMockArgsNewJSONLinesStateExporter("test")
+	args.StateSyncer = nil
+	exporter, err = NewJSONLinesStateExporter(args)
+	assert.True(t, check.IfNil(exporter))
+	assert.Equal(t, update.ErrNilStateSyncer, err)
+
+	args = createMockArgsNewJSONLinesStateExporter("test")
+	args.Marshalizer = nil
+	exporter, err = NewJSONLinesStateExporter(args)
+	assert.True(t, check.IfNil(exporter))
+	assert.Equal(t, data.ErrNilMarshalizer, err)
+
+	args = createMockArgsNewJSONLinesStateExporter("")
+	exporter, err = NewJSONLinesStateExporter(args)
+	assert.True(t, check.IfNil(exporter))
+	assert.Equal(t, update.ErrEmptyExportFolderPath, err)
+
+	args = createMockArgsNewJSONLinesStateExporter("test")
+	args.AddressPubKeyConverter = nil
+	exporter, err = NewJSONLinesStateExporter(args)
+	assert.True(t, check.IfNil(exporter))
+	assert.True(t, errors.Is(err, update.ErrNilPubKeyConverter))
+
+	exporter, err = NewJSONLinesStateExporter(createMockArgsNewJSONLinesStateExporter("test"))
+	assert.False(t, check.IfNil(exporter))
+	assert.Nil(t, err)
+}
+
+func TestJSONLinesStateExport_ExportAllShouldNotSyncTheStateAgain(t *testing.T) {
+	t.Parallel()
+
+	exportFolder := "testFilesJSONLinesExportNoSync"
+	defer func() {
+		_ = os.RemoveAll(exportFolder)
+	}()
+
+	args := createMockArgsNewJSONLinesStateExporter(exportFolder)
+	args.StateSyncer = &mock.SyncStateStub{
+		SyncAllStateCalled: func(_ uint32) error {
+			assert.Fail(t, "should have not synced the state again")
+			return nil
+		},
+		GetAllTriesCalled: func() (map[string]data.Trie, error) {
+			return make(map[string]data.Trie), nil
+		},
+		GetAllMiniBlocksCalled: func() (map[string]*block.MiniBlock, error) {
+			return make(map[string]*block.MiniBlock), nil
+		},
+	}
+	exporter, _ := NewJSONLinesStateExporter(args)
+
+	err := exporter.ExportAll(1)
+	assert.Nil(t, err)
+}
+
+func TestJSONLinesStateExport_ExportAllShouldWriteRecords(t *testing.T) {
+	t.Parallel()
+
+	exportFolder := "testFilesJSONLinesExport"
+	defer func() {
+		_ = os.RemoveAll(exportFolder)
+	}()
+
+	marshalizer := &mock.MarshalizerMock{}
+	address := []byte("address")
+	account := state.NewEmptyUserAccount()
+	account.Nonce = 3
+	account.Balance = big.NewInt(100)
+	account.RootHash = []byte("data root hash")
+	accountBytes, _ := marshalizer.Marshal(account)
+
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-abcdef")
+	esdtBytes, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(37)})
+	esdtValue := append(append(esdtBytes, esdtKey...), address...)
+
+	dataTrieKey := AddRootHashToIdentifier(CreateTrieIdentifier(0, DataTrie), string(account.RootHash))
+	args := createMockArgsNewJSONLinesStateExporter(exportFolder)
+	args.StateSyncer = &mock.SyncStateStub{
+		GetAllTriesCalled: func() (map[string]data.Trie, error) {
+			return map[string]data.Trie{
+				CreateTrieIdentifier(0, UserAccount): createLeavesTrieStub(keyValStorage.NewKeyValStorage(address, accountBytes)),
+				dataTrieKey:                          createLeavesTrieStub(keyValStorage.NewKeyValStorage(esdtKey, esdtValue)),
+			}, nil
+		},
+		GetAllMiniBlocksCalled: func() (map[string]*block.MiniBlock, error) {
+			return map[string]*block.MiniBlock{
+				"mb": {SenderShardID: core.MetachainShardId, ReceiverShardID: 0, TxHashes: [][]byte{[]byte("tx")}},
+			}, nil
+		},
+	}
+	exporter, _ := NewJSONLinesStateExporter(args)
+
+	err := exporter.ExportAll(1)
+	require.Nil(t, err)
+
+	accounts := readJSONLinesFile(t, filepath.Join(exportFolder, jsonlines.FileName(jsonlines.AccountsRecords, 0)))
+	require.Equal(t, 1, len(accounts))
+	assert.Equal(t, "address", accounts[0]["address"])
+	assert.Equal(t, "100", accounts[0]["balance"])
+
+	esdtBalances := readJSONLinesFile(t, filepath.Join(exportFolder, jsonlines.FileName(jsonlines.ESDTBalancesRecords, 0)))
+	require.Equal(t, 1, len(esdtBalances))
+	assert.Equal(t, "TKN-abcdef", esdtBalances[0]["token"])
+	assert.Equal(t, "37", esdtBalances[0]["balance"])
+
+	miniBlocks := readJSONLinesFile(t, filepath.Join(exportFolder, jsonlines.FileName(jsonlines.PendingMiniBlocksRecords, 0)))
+	assert.Equal(t, 1, len(miniBlocks))
+}
+
+func TestJSONLinesStateExport_ExportAllMissingDataTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	exportFolder := "testFilesJSONLinesExportMissingDataTrie"
+	defer func() {
+		_ = os.RemoveAll(exportFolder)
+	}()
+
+	account := state.NewEmptyUserAccount()
+	account.RootHash = []byte("data root hash")
+	accountBytes, _ := (&mock.MarshalizerMock{}).Marshal(account)
+
+	args := createMockArgsNewJSONLinesStateExporter(exportFolder)
+	args.StateSyncer = &mock.SyncStateStub{
+		GetAllTriesCalled: func() (map[string]data.Trie, error) {
+			return map[string]data.Trie{
+				CreateTrieIdentifier(0, UserAccount): createLeavesTrieStub(keyValStorage.NewKeyValStorage([]byte("address"), accountBytes)),
+			}, nil
+		},
+	}
+	exporter, _ := NewJSONLinesStateExporter(args)
+
+	err := exporter.ExportAll(1)
+	assert.True(t, errors.Is(err, update.ErrNotSynced))
+}
+
+func TestNewExportHandlersChain(t *testing.T) {
+	t.Parallel()
+
+	chain, err := NewExportHandlersChain(nil)
+	assert.True(t, check.IfNil(chain))
+	assert.Equal(t, update.ErrNilExportHandler, err)
+
+	chain, err = NewExportHandlersChain(&mock.ExportHandlerStub{}, nil)
+	assert.True(t, check.IfNil(chain))
+	assert.Equal(t, update.ErrNilExportHandler, err)
+
+	chain, err = NewExportHandlersChain(&mock.ExportHandlerStub{})
+	assert.False(t, check.IfNil(chain))
+	assert.Nil(t, err)
+}
+
+func TestExportHandlersChain_ExportAllShouldOnlyLogOptionalHandlersErrors(t *testing.T) {
+	t.Parallel()
+
+	calls := make([]string, 0)
+	chain, err := NewExportHandlersChain(
+		&mock.ExportHandlerStub{
+			ExportAllCalled: func(_ uint32) error {
+				calls = append(calls, "main")
+				return nil
+			},
+		},
+		&mock.ExportHandlerStub{
+			ExportAllCalled: func(_ uint32) error {
+				calls = append(calls, "first optional")
+				return errors.New("expected error")
+			},
+		},
+		&mock.ExportHandlerStub{
+			ExportAllCalled: func(_ uint32) error {
+				calls = append(calls, "second optional")
+				return nil
+			},
+		},
+	)
+	require.Nil(t, err)
+
+	err = chain.ExportAll(1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"main", "first optional", "second optional"}, calls)
+}
+
+func TestExportHandlersChain_ExportAllMainHandlerErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	chain, _ := NewExportHandlersChain(
+		&mock.ExportHandlerStub{
+			ExportAllCalled: func(_ uint32) error {
+				return expectedErr
+			},
+		},
+		&mock.ExportHandlerStub{
+			ExportAllCalled: func(_ uint32) error {
+				assert.Fail(t, "should have not called the optional export handler")
+				return nil
+			},
+		},
+	)
+
+	err := chain.ExportAll(1)
+	assert.Equal(t, expectedErr, err)
+}
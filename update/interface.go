@@ -7,8 +7,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/update/jsonlines"
 )
 
 // StateSyncer interface defines the methods needed to sync and get all states
@@ -234,6 +236,12 @@ type HardforkStorer interface {
 	IsInterfaceNil() bool
 }
 
+// JSONLinesWriter writes the exported records as JSON Lines files, one file for each record type and shard
+type JSONLinesWriter interface {
+	Write(recordType jsonlines.RecordType, shardID uint32, record jsonlines.Record) error
+	Close() error
+}
+
 // GenesisNodesSetupHandler returns the genesis nodes info
 type GenesisNodesSetupHandler interface {
 	InitialNodesInfoForShard(shardId uint32) ([]sharding.GenesisNodeInfoHandler, []sharding.GenesisNodeInfoHandler, error)
@@ -259,3 +267,9 @@ type RoundHandler interface {
 	TimeStamp() time.Time
 	IsInterfaceNil() bool
 }
+
+// LocalStorageReader reads the blocks and the transactions saved in the local databases of the nodes
+type LocalStorageReader interface {
+	Get(unitType dataRetriever.UnitType, key []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
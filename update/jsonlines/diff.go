@@ -0,0 +1,236 @@
+package jsonlines
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ChangedRecord holds the two versions of a record found, under the same key, in both exports
+type ChangedRecord struct {
+	Key   string          `json:"key"`
+	Left  json.RawMessage `json:"left"`
+	Right json.RawMessage `json:"right"`
+}
+
+// FileDiff holds the differences between the two versions of an export file
+type FileDiff struct {
+	FileName        string            `json:"fileName"`
+	NumLeftRecords  int               `json:"numLeftRecords"`
+	NumRightRecords int               `json:"numRightRecords"`
+	OnlyInLeft      []json.RawMessage `json:"onlyInLeft"`
+	OnlyInRight     []json.RawMessage `json:"onlyInRight"`
+	Changed         []*ChangedRecord  `json:"changed"`
+}
+
+// HasDifferences returns true if the two versions of the file do not hold the same records
+func (fd *FileDiff) HasDifferences() bool {
+	return len(fd.OnlyInLeft)+len(fd.OnlyInRight)+len(fd.Changed) > 0
+}
+
+// ExportsDiff holds the differences between two exports, one entry for each file present in at least one of them
+type ExportsDiff struct {
+	Files []*FileDiff `json:"files"`
+}
+
+// HasDifferences returns true if any of the export files differs
+func (ed *ExportsDiff) HasDifferences() bool {
+	for _, fileDiff := range ed.Files {
+		if fileDiff.HasDifferences() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DiffFolders compares, record by record, the export files found in the two folders. A file missing from one of
+// the folders is treated as an empty file. The records of a file are fully loaded in memory while it is compared
+func DiffFolders(leftFolder string, rightFolder string) (*ExportsDiff, error) {
+	leftFiles, err := listExportFiles(leftFolder)
+	if err != nil {
+		return nil, err
+	}
+	rightFiles, err := listExportFiles(rightFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	fileNamesMap := make(map[string]struct{}, len(leftFiles)+len(rightFiles))
+	for _, fileName := range append(leftFiles, rightFiles...) {
+		fileNamesMap[fileName] = struct{}{}
+	}
+	fileNames := make([]string, 0, len(fileNamesMap))
+	for fileName := range fileNamesMap {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	exportsDiff := &ExportsDiff{
+		Files: make([]*FileDiff, 0, len(fileNames)),
+	}
+	for _, fileName := range fileNames {
+		fileDiff, errDiff := DiffFiles(filepath.Join(leftFolder, fileName), filepath.Join(rightFolder, fileName))
+		if errDiff != nil {
+			return nil, errDiff
+		}
+
+		exportsDiff.Files = append(exportsDiff.Files, fileDiff)
+	}
+
+	return exportsDiff, nil
+}
+
+// DiffFiles compares, record by record, the two versions of an export file. The record type is deduced from the file
+// name, which has to be the same for both versions
+func DiffFiles(leftFilePath string, rightFilePath string) (*FileDiff, error) {
+	fileName := filepath.Base(leftFilePath)
+	if fileName != filepath.Base(rightFilePath) {
+		return nil, fmt.Errorf("%w: %s and %s are not versions of the same file", ErrInvalidFileName, leftFilePath, rightFilePath)
+	}
+
+	recordType, err := RecordTypeFromFileName(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	leftRecords, err := readRecords(leftFilePath, recordType)
+	if err != nil {
+		return nil, err
+	}
+	rightRecords, err := readRecords(rightFilePath, recordType)
+	if err != nil {
+		return nil, err
+	}
+
+	fileDiff := &FileDiff{
+		FileName:        fileName,
+		NumLeftRecords:  len(leftRecords),
+		NumRightRecords: len(rightRecords),
+		OnlyInLeft:      make([]json.RawMessage, 0),
+		OnlyInRight:     make([]json.RawMessage, 0),
+		Changed:         make([]*ChangedRecord, 0),
+	}
+	for _, key := range sortedKeys(leftRecords) {
+		rightRecord, found := rightRecords[key]
+		if !found {
+			fileDiff.OnlyInLeft = append(fileDiff.OnlyInLeft, leftRecords[key])
+			continue
+		}
+		if !bytes.Equal(leftRecords[key], rightRecord) {
+			fileDiff.Changed = append(fileDiff.Changed, &ChangedRecord{
+				Key:   key,
+				Left:  leftRecords[key],
+				Right: rightRecord,
+			})
+		}
+	}
+	for _, key := range sortedKeys(rightRecords) {
+		_, found := leftRecords[key]
+		if !found {
+			fileDiff.OnlyInRight = append(fileDiff.OnlyInRight, rightRecords[key])
+		}
+	}
+
+	return fileDiff, nil
+}
+
+func listExportFiles(folder string) ([]string, error) {
+	fileInfos, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	fileNames := make([]string, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() || filepath.Ext(fileInfo.Name()) != FileExtension {
+			continue
+		}
+
+		fileNames = append(fileNames, fileInfo.Name())
+	}
+
+	return fileNames, nil
+}
+
+// readRecords returns the records of the file, normalized by a decode-encode roundtrip, mapped by their keys. A
+// missing file holds no records
+func readRecords(filePath string, recordType RecordType) (map[string]json.RawMessage, error) {
+	records := make(map[string]json.RawMessage)
+
+	file, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, errRead := reader.ReadBytes('\n')
+		if errRead != nil && errRead != io.EOF {
+			return nil, errRead
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			errAdd := addRecord(records, recordType, line)
+			if errAdd != nil {
+				return nil, fmt.Errorf("%w in %s at line %d", errAdd, filePath, lineNumber)
+			}
+		}
+
+		if errRead == io.EOF {
+			return records, nil
+		}
+	}
+}
+
+func addRecord(records map[string]json.RawMessage, recordType RecordType, line []byte) error {
+	record, err := newRecord(recordType)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(line, record)
+	if err != nil {
+		return err
+	}
+
+	key := record.Key()
+	if len(key) == 0 {
+		return ErrEmptyRecordKey
+	}
+	_, found := records[key]
+	if found {
+		return fmt.Errorf("%w: %s", ErrDuplicatedRecordKey, key)
+	}
+
+	normalizedRecord, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	records[key] = normalizedRecord
+
+	return nil
+}
+
+func sortedKeys(records map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(records))
+	for key := range records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
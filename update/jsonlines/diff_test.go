@@ -0,0 +1,115 @@
+package jsonlines
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeExport(t *testing.T, records map[RecordType][]Record) string {
+	folder, err := ioutil.TempDir("", "jsonlines")
+	require.Nil(t, err)
+
+	writer, err := NewFilesWriter(folder)
+	require.Nil(t, err)
+	for recordType, recordsOfType := range records {
+		for _, record := range recordsOfType {
+			require.Nil(t, writer.Write(recordType, 0, record))
+		}
+	}
+	require.Nil(t, writer.Close())
+
+	return folder
+}
+
+func TestDiffFolders_SameExportsShouldNotDiffer(t *testing.T) {
+	t.Parallel()
+
+	records := map[RecordType][]Record{
+		AccountsRecords:     {&AccountRecord{Address: "addr0", Balance: "10"}, &AccountRecord{Address: "addr1"}},
+		ESDTBalancesRecords: {&ESDTBalanceRecord{Address: "addr0", Token: "TKN", Balance: "5"}},
+	}
+	left := writeExport(t, records)
+	right := writeExport(t, records)
+	defer func() {
+		_ = os.RemoveAll(left)
+		_ = os.RemoveAll(right)
+	}()
+
+	exportsDiff, err := DiffFolders(left, right)
+	require.Nil(t, err)
+	assert.False(t, exportsDiff.HasDifferences())
+	require.Equal(t, 2, len(exportsDiff.Files))
+	assert.Equal(t, FileName(AccountsRecords, 0), exportsDiff.Files[0].FileName)
+	assert.Equal(t, 2, exportsDiff.Files[0].NumLeftRecords)
+}
+
+func TestDiffFolders_ShouldReportAddedRemovedAndChangedRecords(t *testing.T) {
+	t.Parallel()
+
+	left := writeExport(t, map[RecordType][]Record{
+		AccountsRecords: {
+			&AccountRecord{Address: "addr0", Balance: "10"},
+			&AccountRecord{Address: "addr1", Balance: "1"},
+		},
+		SystemSCStorageRecords: {&SystemSCStorageRecord{Address: "sc", StorageKey: "aa", Value: "bb"}},
+	})
+	right := writeExport(t, map[RecordType][]Record{
+		AccountsRecords: {
+			&AccountRecord{Address: "addr0", Balance: "11"},
+			&AccountRecord{Address: "addr2", Balance: "1"},
+		},
+	})
+	defer func() {
+		_ = os.RemoveAll(left)
+		_ = os.RemoveAll(right)
+	}()
+
+	exportsDiff, err := DiffFolders(left, right)
+	require.Nil(t, err)
+	require.True(t, exportsDiff.HasDifferences())
+	require.Equal(t, 2, len(exportsDiff.Files))
+
+	accountsDiff := exportsDiff.Files[0]
+	require.Equal(t, 1, len(accountsDiff.Changed))
+	assert.Equal(t, "addr0", accountsDiff.Changed[0].Key)
+	require.Equal(t, 1, len(accountsDiff.OnlyInLeft))
+	assert.Contains(t, string(accountsDiff.OnlyInLeft[0]), "addr1")
+	require.Equal(t, 1, len(accountsDiff.OnlyInRight))
+	assert.Contains(t, string(accountsDiff.OnlyInRight[0]), "addr2")
+
+	systemSCDiff := exportsDiff.Files[1]
+	assert.Equal(t, FileName(SystemSCStorageRecords, 0), systemSCDiff.FileName)
+	assert.Equal(t, 1, len(systemSCDiff.OnlyInLeft))
+	assert.Equal(t, 0, systemSCDiff.NumRightRecords)
+}
+
+func TestDiffFiles_DuplicatedKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	folder, err := ioutil.TempDir("", "jsonlines")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+	filePath := filepath.Join(folder, FileName(AccountsRecords, 0))
+	content := `{"address":"addr0","balance":"1"}` + "\n" + `{"address":"addr0","balance":"2"}` + "\n"
+	require.Nil(t, ioutil.WriteFile(filePath, []byte(content), os.ModePerm))
+
+	fileDiff, err := DiffFiles(filePath, filePath)
+	assert.Nil(t, fileDiff)
+	assert.True(t, errors.Is(err, ErrDuplicatedRecordKey))
+}
+
+func TestDiffFiles_DifferentFileNamesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fileDiff, err := DiffFiles(FileName(AccountsRecords, 0), FileName(AccountsRecords, 1))
+	assert.Nil(t, fileDiff)
+	assert.True(t, errors.Is(err, ErrInvalidFileName))
+}
@@ -0,0 +1,24 @@
+package jsonlines
+
+import "errors"
+
+// ErrEmptyExportFolder signals that an empty export folder path was provided
+var ErrEmptyExportFolder = errors.New("empty export folder path")
+
+// ErrNilRecord signals that a nil record was provided
+var ErrNilRecord = errors.New("nil record")
+
+// ErrWriterClosed signals that the writer was already closed
+var ErrWriterClosed = errors.New("writer closed")
+
+// ErrInvalidFileName signals that the file name does not follow the export files naming
+var ErrInvalidFileName = errors.New("invalid export file name")
+
+// ErrUnknownRecordType signals that the record type is unknown
+var ErrUnknownRecordType = errors.New("unknown record type")
+
+// ErrDuplicatedRecordKey signals that an export file holds more records with the same key
+var ErrDuplicatedRecordKey = errors.New("duplicated record key")
+
+// ErrEmptyRecordKey signals that a record does not have a key
+var ErrEmptyRecordKey = errors.New("empty record key")
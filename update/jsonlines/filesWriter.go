@@ -0,0 +1,151 @@
+package jsonlines
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var log = logger.GetOrCreate("update/jsonlines")
+
+type exportFile struct {
+	file       *os.File
+	writer     *bufio.Writer
+	numRecords uint64
+}
+
+type filesWriter struct {
+	folder string
+
+	mut    sync.Mutex
+	files  map[string]*exportFile
+	closed bool
+}
+
+// NewFilesWriter creates a writer of JSON Lines export files, one file for each record type and shard, all of them
+// placed in the given folder. The files are created when their first record is written
+func NewFilesWriter(folder string) (*filesWriter, error) {
+	if len(folder) == 0 {
+		return nil, ErrEmptyExportFolder
+	}
+
+	err := os.MkdirAll(folder, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filesWriter{
+		folder: folder,
+		files:  make(map[string]*exportFile),
+	}, nil
+}
+
+// Write appends the JSON encoding of the record, on its own line, to the file of the given record type and shard
+func (fw *filesWriter) Write(recordType RecordType, shardID uint32, record Record) error {
+	if check.IfNilReflect(record) {
+		return ErrNilRecord
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	fw.mut.Lock()
+	defer fw.mut.Unlock()
+
+	if fw.closed {
+		return ErrWriterClosed
+	}
+
+	file, err := fw.getOrCreateFile(FileName(recordType, shardID))
+	if err != nil {
+		return err
+	}
+
+	_, err = file.writer.Write(append(line, '\n'))
+	if err != nil {
+		return err
+	}
+	file.numRecords++
+
+	return nil
+}
+
+func (fw *filesWriter) getOrCreateFile(fileName string) (*exportFile, error) {
+	file, ok := fw.files[fileName]
+	if ok {
+		return file, nil
+	}
+
+	osFile, err := os.Create(filepath.Join(fw.folder, fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	file = &exportFile{
+		file:   osFile,
+		writer: bufio.NewWriter(osFile),
+	}
+	fw.files[fileName] = file
+
+	return file, nil
+}
+
+// NumRecords returns the number of records written in each of the files
+func (fw *filesWriter) NumRecords() map[string]uint64 {
+	fw.mut.Lock()
+	defer fw.mut.Unlock()
+
+	numRecords := make(map[string]uint64, len(fw.files))
+	for fileName, file := range fw.files {
+		numRecords[fileName] = file.numRecords
+	}
+
+	return numRecords
+}
+
+// Close flushes and closes all the files. It returns the first error encountered, after trying to close all of them
+func (fw *filesWriter) Close() error {
+	fw.mut.Lock()
+	defer fw.mut.Unlock()
+
+	if fw.closed {
+		return nil
+	}
+	fw.closed = true
+
+	fileNames := make([]string, 0, len(fw.files))
+	for fileName := range fw.files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	var firstErr error
+	for _, fileName := range fileNames {
+		file := fw.files[fileName]
+		err := file.writer.Flush()
+		if err == nil {
+			err = file.file.Close()
+		} else {
+			_ = file.file.Close()
+		}
+		if err != nil {
+			log.Warn("filesWriter.Close", "file", fileName, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		log.Debug("exported file", "file", fileName, "num records", file.numRecords)
+	}
+
+	return firstErr
+}
@@ -0,0 +1,76 @@
+package jsonlines
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFilesWriter_EmptyFolderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	writer, err := NewFilesWriter("")
+	assert.Nil(t, writer)
+	assert.Equal(t, ErrEmptyExportFolder, err)
+}
+
+func TestFilesWriter_WriteShouldCreateOneFilePerTypeAndShard(t *testing.T) {
+	t.Parallel()
+
+	folder, err := ioutil.TempDir("", "jsonlines")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+
+	writer, err := NewFilesWriter(folder)
+	require.Nil(t, err)
+
+	require.Nil(t, writer.Write(AccountsRecords, 0, &AccountRecord{Address: "addr0", Balance: "10"}))
+	require.Nil(t, writer.Write(AccountsRecords, 0, &AccountRecord{Address: "addr1", Balance: "20"}))
+	require.Nil(t, writer.Write(AccountsRecords, core.MetachainShardId, &AccountRecord{Address: "addr2"}))
+	require.Nil(t, writer.Write(PendingMiniBlocksRecords, 1, &PendingMiniBlockRecord{Hash: "aa"}))
+	assert.Equal(t, ErrNilRecord, writer.Write(AccountsRecords, 0, nil))
+
+	numRecords := writer.NumRecords()
+	assert.Equal(t, uint64(2), numRecords["accounts_shard_0.jsonl"])
+	assert.Equal(t, uint64(1), numRecords["accounts_shard_metachain.jsonl"])
+	assert.Equal(t, uint64(1), numRecords["pending-miniblocks_shard_1.jsonl"])
+
+	require.Nil(t, writer.Close())
+	assert.Nil(t, writer.Close())
+	assert.Equal(t, ErrWriterClosed, writer.Write(AccountsRecords, 0, &AccountRecord{Address: "addr3"}))
+
+	content, err := ioutil.ReadFile(filepath.Join(folder, FileName(AccountsRecords, 0)))
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Equal(t, 2, len(lines))
+	assert.True(t, strings.Contains(lines[0], `"address":"addr0"`))
+	assert.True(t, strings.Contains(lines[1], `"balance":"20"`))
+}
+
+func TestRecordTypeFromFileName(t *testing.T) {
+	t.Parallel()
+
+	for _, recordType := range AllRecordTypes() {
+		parsedType, err := RecordTypeFromFileName(FileName(recordType, 2))
+		assert.Nil(t, err)
+		assert.Equal(t, recordType, parsedType)
+	}
+
+	_, err := RecordTypeFromFileName("accounts_shard_0.json")
+	assert.True(t, errors.Is(err, ErrInvalidFileName))
+
+	_, err = RecordTypeFromFileName("accounts.jsonl")
+	assert.True(t, errors.Is(err, ErrInvalidFileName))
+
+	_, err = RecordTypeFromFileName("unknown_shard_0.jsonl")
+	assert.True(t, errors.Is(err, ErrUnknownRecordType))
+}
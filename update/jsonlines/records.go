@@ -0,0 +1,142 @@
+package jsonlines
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// RecordType defines the type of the records held by an export file
+type RecordType string
+
+const (
+	// AccountsRecords is the type of the files holding the user accounts
+	AccountsRecords RecordType = "accounts"
+	// ESDTBalancesRecords is the type of the files holding the ESDT balances of the user accounts
+	ESDTBalancesRecords RecordType = "esdt-balances"
+	// SystemSCStorageRecords is the type of the files holding the storage of the system smart contracts, like the
+	// staking, validator and delegation contracts
+	SystemSCStorageRecords RecordType = "system-sc-storage"
+	// PendingMiniBlocksRecords is the type of the files holding the pending miniblocks
+	PendingMiniBlocksRecords RecordType = "pending-miniblocks"
+)
+
+// FileExtension is the extension of the JSON Lines export files
+const FileExtension = ".jsonl"
+
+const shardSeparator = "_shard_"
+
+// AllRecordTypes returns all the record types, in the order they are exported
+func AllRecordTypes() []RecordType {
+	return []RecordType{
+		AccountsRecords,
+		ESDTBalancesRecords,
+		SystemSCStorageRecords,
+		PendingMiniBlocksRecords,
+	}
+}
+
+// Record defines an exported record, uniquely identified inside its file by a key
+type Record interface {
+	Key() string
+}
+
+// AccountRecord is the exported form of a user account
+type AccountRecord struct {
+	Address         string `json:"address"`
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+	DeveloperReward string `json:"developerReward"`
+	OwnerAddress    string `json:"ownerAddress,omitempty"`
+	Username        string `json:"username,omitempty"`
+	CodeHash        string `json:"codeHash,omitempty"`
+	CodeMetadata    string `json:"codeMetadata,omitempty"`
+	RootHash        string `json:"rootHash,omitempty"`
+}
+
+// Key returns the address of the account
+func (ar *AccountRecord) Key() string {
+	return ar.Address
+}
+
+// ESDTBalanceRecord is the exported form of the ESDT balance of an account
+type ESDTBalanceRecord struct {
+	Address    string `json:"address"`
+	Token      string `json:"token"`
+	Balance    string `json:"balance"`
+	Properties string `json:"properties,omitempty"`
+}
+
+// Key returns the address of the account together with the token identifier
+func (er *ESDTBalanceRecord) Key() string {
+	return er.Address + "/" + er.Token
+}
+
+// SystemSCStorageRecord is the exported form of a key-value pair from the storage of a system smart contract. The
+// key and the value are hex encoded
+type SystemSCStorageRecord struct {
+	Address    string `json:"address"`
+	StorageKey string `json:"key"`
+	Value      string `json:"value"`
+}
+
+// Key returns the address of the system smart contract together with the storage key
+func (sr *SystemSCStorageRecord) Key() string {
+	return sr.Address + "/" + sr.StorageKey
+}
+
+// PendingMiniBlockRecord is the exported form of a pending miniblock
+type PendingMiniBlockRecord struct {
+	Hash            string   `json:"hash"`
+	Type            string   `json:"type"`
+	SenderShardID   uint32   `json:"senderShardID"`
+	ReceiverShardID uint32   `json:"receiverShardID"`
+	TxHashes        []string `json:"txHashes"`
+}
+
+// Key returns the hash of the miniblock
+func (mr *PendingMiniBlockRecord) Key() string {
+	return mr.Hash
+}
+
+// FileName returns the name of the file holding the records of the given type, exported for the given shard
+func FileName(recordType RecordType, shardID uint32) string {
+	return fmt.Sprintf("%s%s%s%s", recordType, shardSeparator, core.GetShardIDString(shardID), FileExtension)
+}
+
+// RecordTypeFromFileName returns the type of the records held by the file having the given name
+func RecordTypeFromFileName(fileName string) (RecordType, error) {
+	if !strings.HasSuffix(fileName, FileExtension) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidFileName, fileName)
+	}
+
+	index := strings.LastIndex(fileName, shardSeparator)
+	if index < 0 {
+		return "", fmt.Errorf("%w: %s", ErrInvalidFileName, fileName)
+	}
+
+	recordType := RecordType(fileName[:index])
+	for _, knownType := range AllRecordTypes() {
+		if recordType == knownType {
+			return recordType, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnknownRecordType, recordType)
+}
+
+func newRecord(recordType RecordType) (Record, error) {
+	switch recordType {
+	case AccountsRecords:
+		return &AccountRecord{}, nil
+	case ESDTBalancesRecords:
+		return &ESDTBalanceRecord{}, nil
+	case SystemSCStorageRecords:
+		return &SystemSCStorageRecord{}, nil
+	case PendingMiniBlocksRecords:
+		return &PendingMiniBlockRecord{}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownRecordType, recordType)
+}
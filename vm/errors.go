@@ -229,3 +229,9 @@ var ErrOwnerCannotUnDelegate = errors.New("owner cannot undelegate, contract sti
 
 // ErrNotEnoughInitialOwnerFunds signals that not enough initial owner funds has been provided
 var ErrNotEnoughInitialOwnerFunds = errors.New("not enough initial owner funds")
+
+// ErrInvalidSpecialRole signals that the given special role is not valid for the token
+var ErrInvalidSpecialRole = errors.New("invalid special role")
+
+// ErrNFTCreateRoleAlreadyExists signals that the NFT create role was already given to another address
+var ErrNFTCreateRoleAlreadyExists = errors.New("NFT create role already exists")
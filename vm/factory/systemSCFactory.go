@@ -17,31 +17,37 @@ import (
 var log = logger.GetOrCreate("vm/factory")
 
 type systemSCFactory struct {
-	systemEI               vm.ContextHandler
-	economics              vm.EconomicsHandler
-	nodesConfigProvider    vm.NodesConfigProvider
-	sigVerifier            vm.MessageSignVerifier
-	gasCost                vm.GasCost
-	marshalizer            marshal.Marshalizer
-	hasher                 hashing.Hasher
-	systemSCConfig         *config.SystemSmartContractsConfig
-	epochNotifier          vm.EpochNotifier
-	systemSCsContainer     vm.SystemSCContainer
-	addressPubKeyConverter core.PubkeyConverter
+	systemEI                      vm.ContextHandler
+	economics                     vm.EconomicsHandler
+	nodesConfigProvider           vm.NodesConfigProvider
+	sigVerifier                   vm.MessageSignVerifier
+	gasCost                       vm.GasCost
+	marshalizer                   marshal.Marshalizer
+	hasher                        hashing.Hasher
+	systemSCConfig                *config.SystemSmartContractsConfig
+	epochNotifier                 vm.EpochNotifier
+	systemSCsContainer            vm.SystemSCContainer
+	addressPubKeyConverter        core.PubkeyConverter
+	esdtNFTEnableEpoch            uint32
+	esdtSpecialRolesEnableEpoch   uint32
+	esdtSupplyTrackingEnableEpoch uint32
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
 type ArgsNewSystemSCFactory struct {
-	SystemEI               vm.ContextHandler
-	Economics              vm.EconomicsHandler
-	NodesConfigProvider    vm.NodesConfigProvider
-	SigVerifier            vm.MessageSignVerifier
-	GasSchedule            core.GasScheduleNotifier
-	Marshalizer            marshal.Marshalizer
-	Hasher                 hashing.Hasher
-	SystemSCConfig         *config.SystemSmartContractsConfig
-	EpochNotifier          vm.EpochNotifier
-	AddressPubKeyConverter core.PubkeyConverter
+	SystemEI                      vm.ContextHandler
+	Economics                     vm.EconomicsHandler
+	NodesConfigProvider           vm.NodesConfigProvider
+	SigVerifier                   vm.MessageSignVerifier
+	GasSchedule                   core.GasScheduleNotifier
+	Marshalizer                   marshal.Marshalizer
+	Hasher                        hashing.Hasher
+	SystemSCConfig                *config.SystemSmartContractsConfig
+	EpochNotifier                 vm.EpochNotifier
+	AddressPubKeyConverter        core.PubkeyConverter
+	ESDTNFTEnableEpoch            uint32
+	ESDTSpecialRolesEnableEpoch   uint32
+	ESDTSupplyTrackingEnableEpoch uint32
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -75,15 +81,18 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 	}
 
 	scf := &systemSCFactory{
-		systemEI:               args.SystemEI,
-		sigVerifier:            args.SigVerifier,
-		nodesConfigProvider:    args.NodesConfigProvider,
-		marshalizer:            args.Marshalizer,
-		hasher:                 args.Hasher,
-		systemSCConfig:         args.SystemSCConfig,
-		economics:              args.Economics,
-		epochNotifier:          args.EpochNotifier,
-		addressPubKeyConverter: args.AddressPubKeyConverter,
+		systemEI:                      args.SystemEI,
+		sigVerifier:                   args.SigVerifier,
+		nodesConfigProvider:           args.NodesConfigProvider,
+		marshalizer:                   args.Marshalizer,
+		hasher:                        args.Hasher,
+		systemSCConfig:                args.SystemSCConfig,
+		economics:                     args.Economics,
+		epochNotifier:                 args.EpochNotifier,
+		addressPubKeyConverter:        args.AddressPubKeyConverter,
+		esdtNFTEnableEpoch:            args.ESDTNFTEnableEpoch,
+		esdtSpecialRolesEnableEpoch:   args.ESDTSpecialRolesEnableEpoch,
+		esdtSupplyTrackingEnableEpoch: args.ESDTSupplyTrackingEnableEpoch,
 	}
 
 	err := scf.createGasConfig(args.GasSchedule.LatestGasSchedule())
@@ -193,14 +202,17 @@ func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, e
 
 func (scf *systemSCFactory) createESDTContract() (vm.SystemSmartContract, error) {
 	argsESDT := systemSmartContracts.ArgsNewESDTSmartContract{
-		Eei:                    scf.systemEI,
-		GasCost:                scf.gasCost,
-		ESDTSCAddress:          vm.ESDTSCAddress,
-		Marshalizer:            scf.marshalizer,
-		Hasher:                 scf.hasher,
-		ESDTSCConfig:           scf.systemSCConfig.ESDTSystemSCConfig,
-		EpochNotifier:          scf.epochNotifier,
-		AddressPubKeyConverter: scf.addressPubKeyConverter,
+		Eei:                           scf.systemEI,
+		GasCost:                       scf.gasCost,
+		ESDTSCAddress:                 vm.ESDTSCAddress,
+		Marshalizer:                   scf.marshalizer,
+		Hasher:                        scf.hasher,
+		ESDTSCConfig:                  scf.systemSCConfig.ESDTSystemSCConfig,
+		EpochNotifier:                 scf.epochNotifier,
+		AddressPubKeyConverter:        scf.addressPubKeyConverter,
+		ESDTNFTEnableEpoch:            scf.esdtNFTEnableEpoch,
+		ESDTSpecialRolesEnableEpoch:   scf.esdtSpecialRolesEnableEpoch,
+		ESDTSupplyTrackingEnableEpoch: scf.esdtSupplyTrackingEnableEpoch,
 	}
 	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
 	return esdt, err
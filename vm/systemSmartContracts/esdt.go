@@ -31,6 +31,7 @@ const minNumberOfDecimals = 0
 const maxNumberOfDecimals = 18
 const configKeyPrefix = "esdtConfig"
 const allIssuedTokens = "allIssuedTokens"
+const pendingWipeKeyPrefix = "pendingWipe"
 const burnable = "canBurn"
 const mintable = "canMint"
 const canPause = "canPause"
@@ -42,31 +43,40 @@ const upgradable = "canUpgrade"
 const conversionBase = 10
 
 type esdt struct {
-	eei                    vm.SystemEI
-	gasCost                vm.GasCost
-	baseIssuingCost        *big.Int
-	ownerAddress           []byte
-	eSDTSCAddress          []byte
-	endOfEpochSCAddress    []byte
-	marshalizer            marshal.Marshalizer
-	hasher                 hashing.Hasher
-	enabledEpoch           uint32
-	flagEnabled            atomic.Flag
-	mutExecution           sync.RWMutex
-	addressPubKeyConverter core.PubkeyConverter
+	eei                           vm.SystemEI
+	gasCost                       vm.GasCost
+	baseIssuingCost               *big.Int
+	ownerAddress                  []byte
+	eSDTSCAddress                 []byte
+	endOfEpochSCAddress           []byte
+	marshalizer                   marshal.Marshalizer
+	hasher                        hashing.Hasher
+	enabledEpoch                  uint32
+	flagEnabled                   atomic.Flag
+	esdtNFTEnableEpoch            uint32
+	flagESDTNFT                   atomic.Flag
+	esdtSpecialRolesEnableEpoch   uint32
+	flagESDTSpecialRoles          atomic.Flag
+	esdtSupplyTrackingEnableEpoch uint32
+	flagESDTSupplyTracking        atomic.Flag
+	mutExecution                  sync.RWMutex
+	addressPubKeyConverter        core.PubkeyConverter
 }
 
 // ArgsNewESDTSmartContract defines the arguments needed for the esdt contract
 type ArgsNewESDTSmartContract struct {
-	Eei                    vm.SystemEI
-	GasCost                vm.GasCost
-	ESDTSCConfig           config.ESDTSystemSCConfig
-	ESDTSCAddress          []byte
-	Marshalizer            marshal.Marshalizer
-	Hasher                 hashing.Hasher
-	EpochNotifier          vm.EpochNotifier
-	EndOfEpochSCAddress    []byte
-	AddressPubKeyConverter core.PubkeyConverter
+	Eei                           vm.SystemEI
+	GasCost                       vm.GasCost
+	ESDTSCConfig                  config.ESDTSystemSCConfig
+	ESDTSCAddress                 []byte
+	Marshalizer                   marshal.Marshalizer
+	Hasher                        hashing.Hasher
+	EpochNotifier                 vm.EpochNotifier
+	EndOfEpochSCAddress           []byte
+	AddressPubKeyConverter        core.PubkeyConverter
+	ESDTNFTEnableEpoch            uint32
+	ESDTSpecialRolesEnableEpoch   uint32
+	ESDTSupplyTrackingEnableEpoch uint32
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -93,16 +103,19 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 	}
 
 	e := &esdt{
-		eei:                    args.Eei,
-		gasCost:                args.GasCost,
-		baseIssuingCost:        baseIssuingCost,
-		ownerAddress:           []byte(args.ESDTSCConfig.OwnerAddress),
-		eSDTSCAddress:          args.ESDTSCAddress,
-		hasher:                 args.Hasher,
-		marshalizer:            args.Marshalizer,
-		enabledEpoch:           args.ESDTSCConfig.EnabledEpoch,
-		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
-		addressPubKeyConverter: args.AddressPubKeyConverter,
+		eei:                           args.Eei,
+		gasCost:                       args.GasCost,
+		baseIssuingCost:               baseIssuingCost,
+		ownerAddress:                  []byte(args.ESDTSCConfig.OwnerAddress),
+		eSDTSCAddress:                 args.ESDTSCAddress,
+		hasher:                        args.Hasher,
+		marshalizer:                   args.Marshalizer,
+		enabledEpoch:                  args.ESDTSCConfig.EnabledEpoch,
+		endOfEpochSCAddress:           args.EndOfEpochSCAddress,
+		addressPubKeyConverter:        args.AddressPubKeyConverter,
+		esdtNFTEnableEpoch:            args.ESDTNFTEnableEpoch,
+		esdtSpecialRolesEnableEpoch:   args.ESDTSpecialRolesEnableEpoch,
+		esdtSupplyTrackingEnableEpoch: args.ESDTSupplyTrackingEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(e)
 
@@ -130,6 +143,10 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	switch args.Function {
 	case "issue":
 		return e.issue(args)
+	case "issueNonFungible":
+		return e.issueNonFungible(args)
+	case "issueSemiFungible":
+		return e.issueSemiFungible(args)
 	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
@@ -156,6 +173,16 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.getAllESDTTokens(args)
 	case "getTokenProperties":
 		return e.getTokenProperties(args)
+	case "setSpecialRole":
+		return e.setSpecialRole(args)
+	case "unSetSpecialRole":
+		return e.unSetSpecialRole(args)
+	case core.BuiltInFunctionESDTWipe:
+		return e.wipeSupplyChange(args)
+	case core.BuiltInFunctionESDTLocalMint:
+		return e.localMintSupplyChange(args)
+	case core.BuiltInFunctionESDTLocalBurn:
+		return e.localBurnSupplyChange(args)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -182,6 +209,58 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("not enough arguments")
 		return vmcommon.FunctionWrongSignature
 	}
+	returnCode := e.checkIssueCostAndTokenName(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	err := e.issueToken(args.CallerAddr, args.Arguments)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) issueNonFungible(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	roles := []string{core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn}
+	return e.issueNonFungibleOrSemiFungible(args, core.NonFungibleESDT, roles)
+}
+
+func (e *esdt) issueSemiFungible(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	roles := []string{core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity, core.ESDTRoleNFTBurn}
+	return e.issueNonFungibleOrSemiFungible(args, core.SemiFungibleESDT, roles)
+}
+
+func (e *esdt) issueNonFungibleOrSemiFungible(
+	args *vmcommon.ContractCallInput,
+	tokenType string,
+	ownerRoles []string,
+) vmcommon.ReturnCode {
+	if !e.flagESDTNFT.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 2 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	returnCode := e.checkIssueCostAndTokenName(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	err := e.issueNonFungibleToken(args.CallerAddr, args.Arguments, tokenType, ownerRoles)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) checkIssueCostAndTokenName(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
 	if err != nil {
 		e.eei.AddReturnMessage("not enough gas")
@@ -202,12 +281,6 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return vmcommon.OutOfFunds
 	}
 
-	err = e.issueToken(args.CallerAddr, args.Arguments)
-	if err != nil {
-		e.eei.AddReturnMessage(err.Error())
-		return vmcommon.UserError
-	}
-
 	return vmcommon.Ok
 }
 
@@ -304,6 +377,9 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 		BurntValue:   big.NewInt(0),
 		Upgradable:   true,
 	}
+	if e.flagESDTNFT.IsSet() {
+		newESDTToken.TokenType = []byte(core.FungibleESDT)
+	}
 	err = upgradeProperties(newESDTToken, arguments[4:])
 	if err != nil {
 		return err
@@ -324,6 +400,70 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 	return nil
 }
 
+// format: issueNonFungible@tokenName@ticker@optional-list-of-properties
+// the tokens are created afterwards by the owner, through the NFT create built-in function, on its own shard
+func (e *esdt) issueNonFungibleToken(owner []byte, arguments [][]byte, tokenType string, ownerRoles []string) error {
+	tokenName := arguments[0]
+	if !isTokenNameHumanReadable(tokenName) {
+		return vm.ErrTokenNameNotHumanReadable
+	}
+
+	tickerName := arguments[1]
+	if !isTickerValid(tickerName) {
+		return vm.ErrTickerNameNotValid
+	}
+
+	tokenIdentifier, err := e.createNewTokenIdentifier(owner, tickerName)
+	if err != nil {
+		return err
+	}
+
+	ownerSpecialRoles := &ESDTRoles{
+		Address: owner,
+		Roles:   make([][]byte, 0, len(ownerRoles)),
+	}
+	for _, role := range ownerRoles {
+		ownerSpecialRoles.Roles = append(ownerSpecialRoles.Roles, []byte(role))
+	}
+
+	newESDTToken := &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		TickerName:   tickerName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		Upgradable:   true,
+		TokenType:    []byte(tokenType),
+		SpecialRoles: []*ESDTRoles{ownerSpecialRoles},
+	}
+	err = upgradeProperties(newESDTToken, arguments[2:])
+	if err != nil {
+		return err
+	}
+	err = e.saveToken(tokenIdentifier, newESDTToken)
+	if err != nil {
+		return err
+	}
+
+	esdtSetRoleData := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenIdentifier)
+	for _, role := range ownerRoles {
+		esdtSetRoleData += "@" + hex.EncodeToString([]byte(role))
+	}
+	esdtSetRoleData += "@" + hex.EncodeToString([]byte(tokenType))
+	err = e.eei.Transfer(owner, e.eSDTSCAddress, big.NewInt(0), []byte(esdtSetRoleData), 0)
+	if err != nil {
+		return err
+	}
+
+	e.addToIssuedTokens(string(tokenIdentifier))
+
+	return nil
+}
+
+func isFungibleToken(token *ESDTData) bool {
+	return len(token.TokenType) == 0 || bytes.Equal(token.TokenType, []byte(core.FungibleESDT))
+}
+
 func upgradeProperties(token *ESDTData, args [][]byte) error {
 	if len(args) == 0 {
 		return nil
@@ -444,6 +584,10 @@ func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("token is not mintable")
 		return vmcommon.UserError
 	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("only fungible tokens can be minted, use the NFT create function instead")
+		return vmcommon.UserError
+	}
 
 	token.MintedValue.Add(token.MintedValue, mintValue)
 	err := e.saveToken(args.Arguments[0], token)
@@ -513,6 +657,10 @@ func (e *esdt) wipe(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("cannot wipe")
 		return vmcommon.UserError
 	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("cannot wipe non fungible or semi fungible tokens")
+		return vmcommon.UserError
+	}
 	if !e.isAddressValid(args.Arguments[1]) {
 		e.eei.AddReturnMessage("invalid address to wipe")
 		return vmcommon.UserError
@@ -524,6 +672,9 @@ func (e *esdt) wipe(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
+	if e.flagESDTSupplyTracking.IsSet() {
+		e.addPendingWipe(args.Arguments[0], args.Arguments[1])
+	}
 
 	return vmcommon.Ok
 }
@@ -705,6 +856,326 @@ func (e *esdt) getTokenProperties(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+// format: setSpecialRole@tokenIdentifier@address@role1@role2...
+// the roles are kept in the token data and written, through a built-in function, to the account of the given address
+func (e *esdt) setSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[1]
+	esdtRoles, isNew := getRolesForAddress(token, address)
+	for _, role := range args.Arguments[2:] {
+		if doesRoleExist(esdtRoles.Roles, role) {
+			e.eei.AddReturnMessage("special role already exists for given address")
+			return vmcommon.UserError
+		}
+
+		err := checkSpecialRoleAccordingToTokenType(role, token, address)
+		if err != nil {
+			e.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+
+		esdtRoles.Roles = append(esdtRoles.Roles, role)
+	}
+	if isNew {
+		token.SpecialRoles = append(token.SpecialRoles, esdtRoles)
+	}
+
+	return e.saveTokenAndSendRoles(args.Arguments[0], token, address, args.Arguments[2:], core.BuiltInFunctionESDTSetRole)
+}
+
+// format: unSetSpecialRole@tokenIdentifier@address@role1@role2...
+func (e *esdt) unSetSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[1]
+	esdtRoles, isNew := getRolesForAddress(token, address)
+	if isNew {
+		e.eei.AddReturnMessage("address does not have special roles")
+		return vmcommon.UserError
+	}
+
+	for _, role := range args.Arguments[2:] {
+		index, exists := getRoleIndex(esdtRoles.Roles, role)
+		if !exists {
+			e.eei.AddReturnMessage("special role does not exist for given address")
+			return vmcommon.UserError
+		}
+
+		esdtRoles.Roles = append(esdtRoles.Roles[:index], esdtRoles.Roles[index+1:]...)
+	}
+	if len(esdtRoles.Roles) == 0 {
+		deleteRolesForAddress(token, address)
+	}
+
+	return e.saveTokenAndSendRoles(args.Arguments[0], token, address, args.Arguments[2:], core.BuiltInFunctionESDTUnSetRole)
+}
+
+func (e *esdt) checkSpecialRoleArguments(args *vmcommon.ContractCallInput) (*ESDTData, vmcommon.ReturnCode) {
+	if !e.flagESDTSpecialRoles.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return nil, vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 3 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+	if !e.isAddressValid(args.Arguments[1]) {
+		e.eei.AddReturnMessage("invalid address argument")
+		return nil, vmcommon.UserError
+	}
+
+	return token, vmcommon.Ok
+}
+
+func (e *esdt) saveTokenAndSendRoles(
+	tokenIdentifier []byte,
+	token *ESDTData,
+	address []byte,
+	roles [][]byte,
+	builtInFunc string,
+) vmcommon.ReturnCode {
+	err := e.saveToken(tokenIdentifier, token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	esdtRoleData := builtInFunc + "@" + hex.EncodeToString(tokenIdentifier)
+	for _, role := range roles {
+		esdtRoleData += "@" + hex.EncodeToString(role)
+	}
+	if builtInFunc == core.BuiltInFunctionESDTSetRole && !isFungibleToken(token) {
+		// the NFT create function reads the token type from the roles of the creator
+		esdtRoleData += "@" + hex.EncodeToString(token.TokenType)
+	}
+	err = e.eei.Transfer(address, e.eSDTSCAddress, big.NewInt(0), []byte(esdtRoleData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func checkSpecialRoleAccordingToTokenType(role []byte, token *ESDTData, address []byte) error {
+	switch string(role) {
+	case core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn:
+		if !isFungibleToken(token) {
+			return vm.ErrInvalidSpecialRole
+		}
+	case core.ESDTRoleNFTCreate:
+		if isFungibleToken(token) {
+			return vm.ErrInvalidSpecialRole
+		}
+		// the created tokens are identified by a nonce kept in the account of the creator, so the create role
+		// cannot be shared by multiple addresses
+		for _, esdtRoles := range token.SpecialRoles {
+			if !bytes.Equal(esdtRoles.Address, address) && doesRoleExist(esdtRoles.Roles, role) {
+				return vm.ErrNFTCreateRoleAlreadyExists
+			}
+		}
+	case core.ESDTRoleNFTBurn:
+		if isFungibleToken(token) {
+			return vm.ErrInvalidSpecialRole
+		}
+	case core.ESDTRoleNFTAddQuantity:
+		if !bytes.Equal(token.TokenType, []byte(core.SemiFungibleESDT)) {
+			return vm.ErrInvalidSpecialRole
+		}
+	default:
+		return vm.ErrInvalidSpecialRole
+	}
+
+	return nil
+}
+
+// format: ESDTWipe@tokenIdentifier@wipedValue
+// sent by the shard of the wiped account after the wipe built-in function was executed. The wiped value is only known
+// on that shard, so it is accepted only from an account with a pending wipe for the given token, consuming that wipe.
+func (e *esdt) wipeSupplyChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagESDTSupplyTracking.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	token, value, returnCode := e.checkSupplyChangeArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !token.CanWipe {
+		e.eei.AddReturnMessage("cannot wipe")
+		return vmcommon.UserError
+	}
+	if !e.consumePendingWipe(args.Arguments[0], args.CallerAddr) {
+		e.eei.AddReturnMessage("no pending wipe for the given token and address")
+		return vmcommon.UserError
+	}
+
+	wipedValue := big.NewInt(0).SetBytes(token.WipedValue)
+	token.WipedValue = wipedValue.Add(wipedValue, value).Bytes()
+
+	return e.saveTokenAfterSupplyChange(args.Arguments[0], token)
+}
+
+func (e *esdt) addPendingWipe(tokenIdentifier []byte, address []byte) {
+	key := createPendingWipeKey(tokenIdentifier, address)
+	numPending := big.NewInt(0).SetBytes(e.eei.GetStorage(key))
+	e.eei.SetStorage(key, numPending.Add(numPending, big.NewInt(1)).Bytes())
+}
+
+func (e *esdt) consumePendingWipe(tokenIdentifier []byte, address []byte) bool {
+	key := createPendingWipeKey(tokenIdentifier, address)
+	numPending := big.NewInt(0).SetBytes(e.eei.GetStorage(key))
+	if numPending.Cmp(zero) <= 0 {
+		return false
+	}
+
+	e.eei.SetStorage(key, numPending.Sub(numPending, big.NewInt(1)).Bytes())
+	return true
+}
+
+func createPendingWipeKey(tokenIdentifier []byte, address []byte) []byte {
+	key := append([]byte(pendingWipeKeyPrefix), tokenIdentifier...)
+	return append(key, address...)
+}
+
+// format: ESDTLocalMint@tokenIdentifier@mintedValue
+// sent by the shard of the caller after the local mint built-in function was executed
+func (e *esdt) localMintSupplyChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagESDTSpecialRoles.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	token, value, returnCode := e.checkSupplyChangeArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !e.hasSpecialRole(token, args.CallerAddr, core.ESDTRoleLocalMint) {
+		return vmcommon.UserError
+	}
+
+	token.MintedValue.Add(token.MintedValue, value)
+
+	return e.saveTokenAfterSupplyChange(args.Arguments[0], token)
+}
+
+// format: ESDTLocalBurn@tokenIdentifier@burntValue
+// sent by the shard of the caller after the local burn built-in function was executed
+func (e *esdt) localBurnSupplyChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagESDTSpecialRoles.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	token, value, returnCode := e.checkSupplyChangeArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !e.hasSpecialRole(token, args.CallerAddr, core.ESDTRoleLocalBurn) {
+		return vmcommon.UserError
+	}
+
+	token.BurntValue.Add(token.BurntValue, value)
+
+	return e.saveTokenAfterSupplyChange(args.Arguments[0], token)
+}
+
+func (e *esdt) checkSupplyChangeArguments(args *vmcommon.ContractCallInput) (*ESDTData, *big.Int, vmcommon.ReturnCode) {
+	// only the built-in functions mark their results with this call type, the calls of users, relayers and smart
+	// contracts, including the ones disguised as smart contract results, are direct or asynchronous calls
+	if args.CallType != vmcommon.ESDTSupplyChangeCall {
+		e.eei.AddReturnMessage("supply changes can only be sent by the built-in functions")
+		return nil, nil, vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return nil, nil, vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("number of arguments must be equal with 2")
+		return nil, nil, vmcommon.FunctionWrongSignature
+	}
+	value := big.NewInt(0).SetBytes(args.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("negative or 0 value of supply change")
+		return nil, nil, vmcommon.UserError
+	}
+	token, err := e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return nil, nil, vmcommon.UserError
+	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("supply changes are tracked only for fungible tokens")
+		return nil, nil, vmcommon.UserError
+	}
+
+	return token, value, vmcommon.Ok
+}
+
+func (e *esdt) hasSpecialRole(token *ESDTData, address []byte, role string) bool {
+	rolesForAddress, _ := getRolesForAddress(token, address)
+	if !doesRoleExist(rolesForAddress.Roles, []byte(role)) {
+		e.eei.AddReturnMessage("caller does not have the special role " + role)
+		return false
+	}
+
+	return true
+}
+
+func (e *esdt) saveTokenAfterSupplyChange(tokenIdentifier []byte, token *ESDTData) vmcommon.ReturnCode {
+	err := e.saveToken(tokenIdentifier, token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func getRolesForAddress(token *ESDTData, address []byte) (*ESDTRoles, bool) {
+	for _, esdtRoles := range token.SpecialRoles {
+		if bytes.Equal(esdtRoles.Address, address) {
+			return esdtRoles, false
+		}
+	}
+
+	return &ESDTRoles{Address: address}, true
+}
+
+func deleteRolesForAddress(token *ESDTData, address []byte) {
+	for i, esdtRoles := range token.SpecialRoles {
+		if bytes.Equal(esdtRoles.Address, address) {
+			token.SpecialRoles = append(token.SpecialRoles[:i], token.SpecialRoles[i+1:]...)
+			return
+		}
+	}
+}
+
+func getRoleIndex(roles [][]byte, role []byte) (int, bool) {
+	for i, currentRole := range roles {
+		if bytes.Equal(currentRole, role) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func doesRoleExist(roles [][]byte, role []byte) bool {
+	_, exists := getRoleIndex(roles, role)
+	return exists
+}
+
 func (e *esdt) addToIssuedTokens(newToken string) {
 	allTokens := e.eei.GetStorage([]byte(allIssuedTokens))
 	if len(allTokens) == 0 {
@@ -846,6 +1317,15 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagESDTNFT.Toggle(epoch >= e.esdtNFTEnableEpoch)
+	log.Debug("esdt contract: non-fungible tokens", "enabled", e.flagESDTNFT.IsSet())
+
+	e.flagESDTSpecialRoles.Toggle(epoch >= e.esdtSpecialRolesEnableEpoch)
+	log.Debug("esdt contract: special roles", "enabled", e.flagESDTSpecialRoles.IsSet())
+
+	e.flagESDTSupplyTracking.Toggle(epoch >= e.esdtSupplyTrackingEnableEpoch)
+	log.Debug("esdt contract: supply tracking", "enabled", e.flagESDTSupplyTracking.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
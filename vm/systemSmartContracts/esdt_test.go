@@ -209,6 +209,132 @@ func TestEsdt_IssueInvalidNumberOfDecimals(t *testing.T) {
 	assert.Equal(t, vmcommon.UserError, output)
 }
 
+func TestEsdt_ExecuteIssueNonFungibleShouldSetRolesForOwner(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("issueNonFungible", [][]byte{[]byte("name")})
+	vmInput.CallerAddr = owner
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = append(vmInput.Arguments, []byte("TICKER"))
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc, accCreated := vmOutput.OutputAccounts[string(owner)]
+	assert.True(t, accCreated)
+	assert.Equal(t, 1, len(destAcc.OutputTransfers))
+
+	tokenIdentifier := eei.GetStorage([]byte(allIssuedTokens))
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenIdentifier))
+	assert.Equal(t, []byte(core.NonFungibleESDT), esdtData.TokenType)
+
+	expectedInput := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenIdentifier) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTCreate)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTBurn)) +
+		"@" + hex.EncodeToString([]byte(core.NonFungibleESDT))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[0].Data)
+}
+
+func TestEsdt_ExecuteIssueSemiFungibleShouldSetRolesForOwner(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("issueSemiFungible", [][]byte{[]byte("name"), []byte("TICKER")})
+	vmInput.CallerAddr = owner
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.OutOfFunds, output)
+
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	tokenIdentifier := eei.GetStorage([]byte(allIssuedTokens))
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenIdentifier))
+	assert.Equal(t, []byte(core.SemiFungibleESDT), esdtData.TokenType)
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc := vmOutput.OutputAccounts[string(owner)]
+	expectedInput := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenIdentifier) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTCreate)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTAddQuantity)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTBurn)) +
+		"@" + hex.EncodeToString([]byte(core.SemiFungibleESDT))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[0].Data)
+}
+
+func TestEsdt_ExecuteIssueNonFungibleBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.ESDTNFTEnableEpoch = 1
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("issueNonFungible", [][]byte{[]byte("name"), []byte("TICKER")})
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	eei.gasRemaining = vmInput.GasProvided
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	vmInput.Function = "issueSemiFungible"
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	vmInput.Function = "issue"
+	vmInput.Arguments = [][]byte{[]byte("name"), []byte("TICKER"), big.NewInt(100).Bytes(), big.NewInt(0).Bytes()}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	tokenIdentifier := eei.GetStorage([]byte(allIssuedTokens))
+	esdtData := &ESDTData{}
+	_ = args.Marshalizer.Unmarshal(esdtData, eei.GetStorage(tokenIdentifier))
+	assert.Equal(t, 0, len(esdtData.TokenType))
+
+	e.EpochConfirmed(1)
+	vmInput.Function = "issueNonFungible"
+	vmInput.Arguments = [][]byte{[]byte("name"), []byte("TICKER")}
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
 func TestEsdt_ExecuteNilArgsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -607,6 +733,38 @@ func TestEsdt_ExecuteMintNonMintableTokenShouldFail(t *testing.T) {
 	assert.True(t, strings.Contains(eei.returnMessage, "token is not mintable"))
 }
 
+func TestEsdt_ExecuteMintNonFungibleTokenShouldFail(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	tokensMap := map[string][]byte{}
+	marshalizedData, _ := args.Marshalizer.Marshal(ESDTData{
+		OwnerAddress: []byte("owner"),
+		Mintable:     true,
+		MintedValue:  big.NewInt(0),
+		TokenType:    []byte(core.NonFungibleESDT),
+	})
+	tokensMap[string(tokenName)] = marshalizedData
+	eei.storageUpdate[string(eei.scAddress)] = tokensMap
+	args.Eei = eei
+
+	e, _ := NewESDTSmartContract(args)
+	vmInput := getDefaultVmInputForFunc("mint", [][]byte{tokenName, {200}})
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only fungible tokens can be minted"))
+}
+
 func TestEsdt_ExecuteMintSavesTokenWithMintedTokensAdded(t *testing.T) {
 	t.Parallel()
 
@@ -2262,8 +2420,397 @@ func TestEsdt_ExecuteClaim(t *testing.T) {
 	assert.True(t, receiver.BalanceDelta.Cmp(big.NewInt(100)) == 0)
 }
 
+func createESDTWithStoredToken(t *testing.T, tokenName []byte, token *ESDTData) (*esdt, *vmContext) {
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+
+	marshalizedData, err := args.Marshalizer.Marshal(token)
+	assert.Nil(t, err)
+	eei.storageUpdate[string(eei.scAddress)] = map[string][]byte{string(tokenName): marshalizedData}
+
+	e, _ := NewESDTSmartContract(args)
+	return e, eei
+}
+
+func getStoredToken(e *esdt, eei *vmContext, tokenName []byte) *ESDTData {
+	token := &ESDTData{}
+	_ = e.marshalizer.Unmarshal(token, eei.GetStorage(tokenName))
+	return token
+}
+
+func TestEsdt_ExecuteSpecialRolesBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := getAddress()
+	tokenName := []byte("esdtToken")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{{Address: address, Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}},
+	})
+	e.esdtSpecialRolesEnableEpoch = 1
+	e.EpochConfirmed(0)
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, getAddress(), []byte(core.ESDTRoleLocalBurn)})
+	vmInput.CallerAddr = []byte("owner")
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	vmInput.Function = "unSetSpecialRole"
+	vmInput.Arguments = [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	vmInput = getSupplyChangeVmInput(core.BuiltInFunctionESDTLocalMint, [][]byte{tokenName, big.NewInt(10).Bytes()}, address)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	vmInput.Function = core.BuiltInFunctionESDTLocalBurn
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	e.EpochConfirmed(1)
+	vmInput.Function = core.BuiltInFunctionESDTLocalMint
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(10), getStoredToken(e, eei, tokenName).MintedValue)
+}
+
+func TestEsdt_ExecuteSetSpecialRoleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := getAddress()
+	tokenName := []byte("esdtToken")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+	})
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmInput.Arguments = [][]byte{tokenName, address, []byte(core.ESDTRoleLocalBurn)}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token := getStoredToken(e, eei, tokenName)
+	assert.Equal(t, 1, len(token.SpecialRoles))
+	assert.Equal(t, address, token.SpecialRoles[0].Address)
+	assert.Equal(t, [][]byte{[]byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)}, token.SpecialRoles[0].Roles)
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc := vmOutput.OutputAccounts[string(address)]
+	assert.Equal(t, 2, len(destAcc.OutputTransfers))
+	expectedInput := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalBurn))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[1].Data)
+
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "already exists"))
+}
+
+func TestEsdt_ExecuteSetSpecialRoleErrors(t *testing.T) {
+	t.Parallel()
+
+	address := getAddress()
+	tokenName := []byte("esdtToken")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+	})
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)}
+	vmInput.CallerAddr = []byte("not owner")
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.CallerAddr = []byte("owner")
+	vmInput.Arguments = [][]byte{tokenName, []byte("invalid address"), []byte(core.ESDTRoleLocalMint)}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.Arguments = [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate)}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidSpecialRole.Error()))
+}
+
+func TestEsdt_ExecuteSetSpecialRoleNFTCreateToSecondAddressShouldFail(t *testing.T) {
+	t.Parallel()
+
+	owner := getAddress()
+	address := getAddress()
+	tokenName := []byte("esdtToken")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: owner,
+		TokenType:    []byte(core.NonFungibleESDT),
+		SpecialRoles: []*ESDTRoles{{Address: owner, Roles: [][]byte{[]byte(core.ESDTRoleNFTCreate)}}},
+	})
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{tokenName, address, []byte(core.ESDTRoleNFTCreate)})
+	vmInput.CallerAddr = owner
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrNFTCreateRoleAlreadyExists.Error(), eei.returnMessage)
+
+	vmInput.Arguments = [][]byte{tokenName, address, []byte(core.ESDTRoleNFTBurn)}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token := getStoredToken(e, eei, tokenName)
+	assert.Equal(t, 2, len(token.SpecialRoles))
+}
+
+func TestEsdt_ExecuteUnSetSpecialRoleShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := getAddress()
+	tokenName := []byte("esdtToken")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		SpecialRoles: []*ESDTRoles{{Address: address, Roles: [][]byte{[]byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)}}},
+	})
+
+	vmInput := getDefaultVmInputForFunc("unSetSpecialRole", [][]byte{tokenName, getAddress(), []byte(core.ESDTRoleLocalMint)})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.Arguments = [][]byte{tokenName, address, []byte(core.ESDTRoleLocalMint)}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, [][]byte{[]byte(core.ESDTRoleLocalBurn)}, getStoredToken(e, eei, tokenName).SpecialRoles[0].Roles)
+
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.Arguments = [][]byte{tokenName, address, []byte(core.ESDTRoleLocalBurn)}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, 0, len(getStoredToken(e, eei, tokenName).SpecialRoles))
+
+	vmOutput := eei.CreateVMOutput()
+	destAcc := vmOutput.OutputAccounts[string(address)]
+	expectedInput := core.BuiltInFunctionESDTUnSetRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalBurn))
+	assert.Equal(t, []byte(expectedInput), destAcc.OutputTransfers[len(destAcc.OutputTransfers)-1].Data)
+}
+
 func getAddress() []byte {
 	key := make([]byte, 32)
 	_, _ = rand.Read(key)
 	return key
 }
+
+func getSupplyChangeVmInput(funcName string, args [][]byte, caller []byte) *vmcommon.ContractCallInput {
+	vmInput := getDefaultVmInputForFunc(funcName, args)
+	vmInput.CallerAddr = caller
+	vmInput.PrevTxHash = []byte("hash of the built-in function call")
+	vmInput.CurrentTxHash = []byte("hash of the supply change")
+	vmInput.CallType = vmcommon.ESDTSupplyChangeCall
+
+	return vmInput
+}
+
+func TestEsdt_ExecuteWipeSupplyChange(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	wipedAddress := getAddress()
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+	})
+
+	vmInput := getSupplyChangeVmInput(core.BuiltInFunctionESDTWipe, [][]byte{tokenName, big.NewInt(10).Bytes()}, wipedAddress)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "cannot wipe"))
+
+	token := getStoredToken(e, eei, tokenName)
+	token.CanWipe = true
+	_ = e.saveToken(tokenName, token)
+
+	eei.returnMessage = ""
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "no pending wipe"))
+
+	wipeInput := getDefaultVmInputForFunc("wipe", [][]byte{tokenName, wipedAddress})
+	output = e.Execute(wipeInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	output = e.Execute(wipeInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	otherAddressInput := getSupplyChangeVmInput(core.BuiltInFunctionESDTWipe, [][]byte{tokenName, big.NewInt(10).Bytes()}, getAddress())
+	output = e.Execute(otherAddressInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	eei.returnMessage = ""
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "no pending wipe"))
+
+	token = getStoredToken(e, eei, tokenName)
+	assert.Equal(t, big.NewInt(20).Bytes(), token.WipedValue)
+	assert.Equal(t, big.NewInt(100), token.MintedValue)
+}
+
+func TestEsdt_ExecuteWipeSupplyChangeBeforeEnableEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	wipedAddress := getAddress()
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+		CanWipe:      true,
+	})
+	e.esdtSupplyTrackingEnableEpoch = 1
+	e.EpochConfirmed(0)
+
+	output := e.Execute(getDefaultVmInputForFunc("wipe", [][]byte{tokenName, wipedAddress}))
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, 0, len(eei.GetStorage(createPendingWipeKey(tokenName, wipedAddress))))
+
+	vmInput := getSupplyChangeVmInput(core.BuiltInFunctionESDTWipe, [][]byte{tokenName, big.NewInt(10).Bytes()}, wipedAddress)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+}
+
+func TestEsdt_ExecuteLocalMintAndBurnSupplyChange(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	minter := []byte("minter")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{{Address: minter, Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}},
+	})
+
+	vmInput := getSupplyChangeVmInput(core.BuiltInFunctionESDTLocalMint, [][]byte{tokenName, big.NewInt(50).Bytes()}, minter)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	vmInput = getSupplyChangeVmInput(core.BuiltInFunctionESDTLocalBurn, [][]byte{tokenName, big.NewInt(30).Bytes()}, minter)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, core.ESDTRoleLocalBurn))
+
+	token := getStoredToken(e, eei, tokenName)
+	assert.Equal(t, big.NewInt(150), token.MintedValue)
+	assert.Equal(t, big.NewInt(0), token.BurntValue)
+}
+
+func TestEsdt_ExecuteLocalMintSupplyChangeFromSmartContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	scAddress := make([]byte, 32)
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{{Address: scAddress, Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}},
+	})
+
+	// a smart contract holding the role transfers the supply change data itself, as a direct or an asynchronous call
+	for _, callType := range []vmcommon.CallType{vmcommon.DirectCall, vmcommon.AsynchronousCall} {
+		vmInput := getSupplyChangeVmInput(core.BuiltInFunctionESDTLocalMint, [][]byte{tokenName, big.NewInt(50).Bytes()}, scAddress)
+		vmInput.CallType = callType
+
+		eei.returnMessage = ""
+		output := e.Execute(vmInput)
+		assert.Equal(t, vmcommon.UserError, output)
+		assert.True(t, strings.Contains(eei.returnMessage, "can only be sent by the built-in functions"))
+	}
+
+	assert.Equal(t, big.NewInt(100), getStoredToken(e, eei, tokenName).MintedValue)
+}
+
+func TestEsdt_ExecuteLocalBurnSupplyChangeFromRelayedTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	burner := []byte("burner")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{{Address: burner, Roles: [][]byte{[]byte(core.ESDTRoleLocalBurn)}}},
+	})
+
+	// the inner transaction of a relayed transaction reaches the metachain as a smart contract result
+	vmInput := getSupplyChangeVmInput(core.BuiltInFunctionESDTLocalBurn, [][]byte{tokenName, big.NewInt(30).Bytes()}, burner)
+	vmInput.PrevTxHash = []byte("hash of the relayed transaction")
+	vmInput.CallType = vmcommon.DirectCall
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can only be sent by the built-in functions"))
+	assert.Equal(t, big.NewInt(0), getStoredToken(e, eei, tokenName).BurntValue)
+}
+
+func TestEsdt_ExecuteSupplyChangeErrors(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("esdtToken")
+	e, eei := createESDTWithStoredToken(t, tokenName, &ESDTData{
+		TokenName:    tokenName,
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.NonFungibleESDT),
+		CanWipe:      true,
+	})
+
+	vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTLocalMint, [][]byte{tokenName, big.NewInt(10).Bytes()})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can only be sent by the built-in functions"))
+
+	vmInput = getSupplyChangeVmInput(core.BuiltInFunctionESDTWipe, [][]byte{tokenName}, []byte("owner"))
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = [][]byte{tokenName, big.NewInt(0).Bytes()}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.Arguments = [][]byte{[]byte("unknownToken"), big.NewInt(10).Bytes()}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrNoTickerWithGivenName.Error()))
+
+	vmInput.Arguments = [][]byte{tokenName, big.NewInt(10).Bytes()}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only for fungible tokens"))
+}